@@ -6,6 +6,7 @@ import (
 	"strconv"
 )
 
+// Bootstrap crea un Service que firma con HS256 y un secret compartido.
 func Bootstrap(secret string, accessExpirationMinutes, refreshExpirationMinutes int) (Service, error) {
 	if secret == "" {
 		secret = os.Getenv("JWT_SECRET_KEY")
@@ -31,3 +32,34 @@ func Bootstrap(secret string, accessExpirationMinutes, refreshExpirationMinutes
 	// Crear el servicio JWT
 	return newService(config)
 }
+
+// BootstrapWithKeys crea un Service que firma con la clave activa del KeySet
+// (RS256 o ES256) e incluye su kid en el header. Si JWT_SECRET_KEY está definido
+// se siguen aceptando los tokens HS256 emitidos antes del cambio.
+func BootstrapWithKeys(keys *KeySet, accessExpirationMinutes, refreshExpirationMinutes int) (Service, error) {
+	if keys == nil {
+		return nil, fmt.Errorf("invalid JWT configuration: key set is required")
+	}
+	if accessExpirationMinutes == 0 {
+		accessExpirationMinutes, _ = strconv.Atoi(os.Getenv("JWT_DEFAULT_ACCESS_EXPIRATION_MINUTES"))
+	}
+	if refreshExpirationMinutes == 0 {
+		refreshExpirationMinutes, _ = strconv.Atoi(os.Getenv("JWT_DEFAULT_REFRESH_EXPIRATION_MINUTES"))
+	}
+
+	cfg := &config{
+		secret:                   os.Getenv("JWT_SECRET_KEY"),
+		accessExpirationMinutes:  accessExpirationMinutes,
+		refreshExpirationMinutes: refreshExpirationMinutes,
+	}
+	cfg.warnExpirations()
+
+	svc := &service{
+		config:            cfg,
+		secret:            []byte(cfg.secret),
+		keys:              keys,
+		accessExpiration:  cfg.GetAccessExpiration(),
+		refreshExpiration: cfg.GetRefreshExpiration(),
+	}
+	return svc, nil
+}
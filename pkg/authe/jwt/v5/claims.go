@@ -9,12 +9,19 @@ import (
 // Claims representa las claims personalizadas para el token JWT.
 type Claims struct {
 	Subject string `json:"sub"`
+	// Type es "access" o "refresh" (pkgutils.TokenTypeClaim).
+	Type string `json:"typ,omitempty"`
+	// Session es la sesión del token, para revocarlo junto con ella.
+	Session string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // TokenClaims representa las claims extraídas de un token validado.
 type TokenClaims struct {
+	ID        string
 	Subject   string
+	Type      string
+	Session   string
 	ExpiresAt time.Time
 	IssuedAt  time.Time
 }
@@ -23,10 +30,11 @@ type TokenClaims struct {
 type Token struct {
 	AccessToken      string
 	RefreshToken     string
+	AccessTokenID    string
+	RefreshTokenID   string
 	AccessExpiresAt  time.Time
 	RefreshExpiresAt time.Time
 	IssuedAt         time.Time
 	Subject          string
 	TokenType        string
 }
-
@@ -40,12 +40,16 @@ func (c *config) Validate() error {
 	if c.secret == "" {
 		return fmt.Errorf("JWT secret not configured")
 	}
+	c.warnExpirations()
+	return nil
+}
+
+// warnExpirations avisa si faltan las expiraciones por defecto.
+func (c *config) warnExpirations() {
 	if c.accessExpirationMinutes <= 0 {
 		log.Printf("WARNING: Default JWT access expiration not configured or must be greater than 0")
 	}
 	if c.refreshExpirationMinutes <= 0 {
 		log.Printf("WARNING: Default JWT refresh expiration not configuredo or must be greater than 0")
 	}
-
-	return nil
 }
@@ -0,0 +1,363 @@
+package pkgjwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+// Algoritmos de firma soportados. HS256 usa el secret compartido; RS256 y ES256
+// usan un KeySet con kid.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+// refreshInterval limita cuántas veces se relee el KeyStore al ver un kid desconocido.
+const refreshInterval = 10 * time.Second
+
+// Key es una clave de firma del KeySet.
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+}
+
+// StoredKey es la forma serializada de una Key.
+type StoredKey struct {
+	ID         string    `json:"kid"`
+	Algorithm  string    `json:"alg"`
+	PrivatePEM string    `json:"private_pem"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// KeyStore persiste las claves para que todas las réplicas firmen y verifiquen
+// con las mismas.
+type KeyStore interface {
+	LoadKeys(ctx context.Context) ([]StoredKey, error)
+	AddKey(ctx context.Context, key StoredKey) error
+}
+
+// KeySetOptions configura la rotación de claves.
+type KeySetOptions struct {
+	// Rotation es cada cuánto se genera una clave nueva. Cero desactiva la rotación.
+	Rotation time.Duration
+	// Retention es cuánto sigue siendo válida una clave para verificar después de
+	// ser reemplazada. Debe cubrir la vida del refresh token.
+	Retention time.Duration
+}
+
+// KeySet guarda las claves asimétricas: firma con la más nueva y verifica con
+// cualquiera que no haya vencido su retención.
+type KeySet struct {
+	alg   string
+	store KeyStore
+	opts  KeySetOptions
+
+	mu          sync.RWMutex
+	keys        []*Key // ordenadas por CreatedAt, la última es la activa
+	refreshedAt time.Time
+}
+
+// NewKeySet carga las claves del store y genera una si no hay ninguna vigente.
+// Sin store las claves viven en memoria y no se comparten entre réplicas.
+func NewKeySet(ctx context.Context, alg string, store KeyStore, opts KeySetOptions) (*KeySet, error) {
+	if alg != AlgRS256 && alg != AlgES256 {
+		return nil, fmt.Errorf("unsupported key set algorithm %q", alg)
+	}
+	if store == nil {
+		store = NewMemoryKeyStore()
+	}
+	s := &KeySet{alg: alg, store: store, opts: opts}
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	if s.needsRotation(time.Now()) {
+		if err := s.Rotate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Algorithm devuelve el algoritmo con el que firma el KeySet.
+func (s *KeySet) Algorithm() string {
+	return s.alg
+}
+
+// Current devuelve la clave con la que se firman los tokens nuevos.
+func (s *KeySet) Current() *Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.keys) == 0 {
+		return nil
+	}
+	return s.keys[len(s.keys)-1]
+}
+
+// VerificationKey implementa pkgutils.KeyProvider. Un kid desconocido relee el
+// store, por si otra réplica rotó la clave.
+func (s *KeySet) VerificationKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if key := s.lookup(kid); key != nil {
+		return key.Private.Public(), nil
+	}
+	s.mu.RLock()
+	stale := time.Since(s.refreshedAt) >= refreshInterval
+	s.mu.RUnlock()
+	if stale {
+		if err := s.Refresh(ctx); err != nil {
+			return nil, err
+		}
+		if key := s.lookup(kid); key != nil {
+			return key.Private.Public(), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// JWKS devuelve las claves públicas vigentes, para publicar en /.well-known/jwks.json.
+func (s *KeySet) JWKS() pkgutils.JWKS {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	set := pkgutils.JWKS{Keys: make([]pkgutils.JWK, 0, len(s.keys))}
+	for _, key := range s.keys {
+		jwk, err := pkgutils.NewJWK(key.ID, key.Algorithm, key.Private.Public())
+		if err != nil {
+			log.Printf("[JWT] skipping key %s in JWKS: %v", key.ID, err)
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// Rotate genera una clave nueva y la vuelve la activa. Las anteriores siguen
+// sirviendo para verificar durante Retention.
+func (s *KeySet) Rotate(ctx context.Context) error {
+	key, err := generateKey(s.alg)
+	if err != nil {
+		return err
+	}
+	stored, err := encodeKey(key)
+	if err != nil {
+		return err
+	}
+	if err := s.store.AddKey(ctx, stored); err != nil {
+		return fmt.Errorf("failed to store signing key: %w", err)
+	}
+	log.Printf("[JWT] rotated signing key, new kid %s", key.ID)
+	return s.Refresh(ctx)
+}
+
+// Refresh relee las claves del store y descarta las que vencieron.
+func (s *KeySet) Refresh(ctx context.Context) error {
+	stored, err := s.store.LoadKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	keys := make([]*Key, 0, len(stored))
+	for _, sk := range stored {
+		if sk.Algorithm != s.alg {
+			continue
+		}
+		key, err := decodeKey(sk)
+		if err != nil {
+			log.Printf("[JWT] ignoring signing key %s: %v", sk.ID, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = s.unexpired(keys, time.Now())
+	s.refreshedAt = time.Now()
+	return nil
+}
+
+// Run rota la clave cada Rotation hasta que se cancele el contexto.
+func (s *KeySet) Run(ctx context.Context) error {
+	if s.opts.Rotation <= 0 {
+		return nil
+	}
+	ticker := time.NewTicker(rotationCheckInterval(s.opts.Rotation))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// Otra réplica pudo haber rotado: primero se relee el store.
+			if err := s.Refresh(ctx); err != nil {
+				log.Printf("[JWT] %v", err)
+				continue
+			}
+			if s.needsRotation(time.Now()) {
+				if err := s.Rotate(ctx); err != nil {
+					log.Printf("[JWT] %v", err)
+				}
+			}
+		}
+	}
+}
+
+func (s *KeySet) lookup(kid string) *Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, key := range s.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+	return nil
+}
+
+func (s *KeySet) needsRotation(now time.Time) bool {
+	current := s.Current()
+	if current == nil {
+		return true
+	}
+	return s.opts.Rotation > 0 && now.Sub(current.CreatedAt) >= s.opts.Rotation
+}
+
+// unexpired descarta las claves reemplazadas hace más de Retention. Una clave
+// queda reemplazada cuando se crea la siguiente.
+func (s *KeySet) unexpired(keys []*Key, now time.Time) []*Key {
+	if s.opts.Retention <= 0 {
+		return keys
+	}
+	result := make([]*Key, 0, len(keys))
+	for i, key := range keys {
+		if i < len(keys)-1 && now.Sub(keys[i+1].CreatedAt) > s.opts.Retention {
+			continue
+		}
+		result = append(result, key)
+	}
+	return result
+}
+
+func rotationCheckInterval(rotation time.Duration) time.Duration {
+	if interval := rotation / 10; interval < time.Minute {
+		return interval
+	}
+	return time.Minute
+}
+
+// signingMethod devuelve el método de golang-jwt para el algoritmo.
+func signingMethod(alg string) jwt.SigningMethod {
+	switch alg {
+	case AlgRS256:
+		return jwt.SigningMethodRS256
+	case AlgES256:
+		return jwt.SigningMethodES256
+	default:
+		return jwt.SigningMethodHS256
+	}
+}
+
+func generateKey(alg string) (*Key, error) {
+	var (
+		private crypto.Signer
+		err     error
+	)
+	switch alg {
+	case AlgRS256:
+		private, err = rsa.GenerateKey(rand.Reader, 2048)
+	case AlgES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported key set algorithm %q", alg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s key: %w", alg, err)
+	}
+	kid, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	return &Key{ID: kid, Algorithm: alg, Private: private, CreatedAt: time.Now()}, nil
+}
+
+func encodeKey(key *Key) (StoredKey, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key.Private)
+	if err != nil {
+		return StoredKey{}, fmt.Errorf("failed to encode signing key: %w", err)
+	}
+	return StoredKey{
+		ID:         key.ID,
+		Algorithm:  key.Algorithm,
+		PrivatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		CreatedAt:  key.CreatedAt,
+	}, nil
+}
+
+func decodeKey(sk StoredKey) (*Key, error) {
+	private, err := ParsePrivateKeyPEM(sk.PrivatePEM)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{ID: sk.ID, Algorithm: sk.Algorithm, Private: private, CreatedAt: sk.CreatedAt}, nil
+}
+
+// ParsePrivateKeyPEM lee una clave privada RSA o ECDSA en PEM (PKCS#8, PKCS#1 o SEC 1).
+func ParsePrivateKeyPEM(pemStr string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse PEM block")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported private key format")
+}
+
+// memoryKeyStore guarda las claves en memoria.
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys []StoredKey
+}
+
+// NewMemoryKeyStore crea un KeyStore en memoria, útil con una sola réplica o en tests.
+func NewMemoryKeyStore() KeyStore {
+	return &memoryKeyStore{}
+}
+
+func (m *memoryKeyStore) LoadKeys(context.Context) ([]StoredKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoredKey(nil), m.keys...), nil
+}
+
+func (m *memoryKeyStore) AddKey(_ context.Context, key StoredKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys = append(m.keys, key)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 type Service interface {
 	GenerateTokens(context.Context, string, time.Duration, time.Duration) (*Token, error)
+	GenerateTokensWithClaims(context.Context, string, map[string]any, time.Duration, time.Duration) (*Token, error)
 	ValidateToken(context.Context, string) (*TokenClaims, error)
 	GetAccessExpiration() time.Duration
 	GetRefreshExpiration() time.Duration
@@ -2,8 +2,10 @@ package pkgjwt
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -11,12 +13,16 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
 )
 
-// service implementa la interfaz Service con un único secret y posibles expiraciones personalizadas.
+// service implementa la interfaz Service con un único secret o, si hay keys, con
+// la clave activa de un KeySet; admite expiraciones personalizadas.
 type service struct {
 	config            Config
 	secret            []byte
+	keys              *KeySet
 	accessExpiration  time.Duration
 	refreshExpiration time.Duration
 }
@@ -36,6 +42,15 @@ func newService(c Config) (Service, error) {
 // sobreescriben las expiraciones por defecto definidas en la configuración.
 func (s *service) GenerateTokens(ctx context.Context, subject string,
 	customAccessExp, customRefreshExp time.Duration) (*Token, error) {
+	return s.GenerateTokensWithClaims(ctx, subject, nil, customAccessExp, customRefreshExp)
+}
+
+// GenerateTokensWithClaims es como GenerateTokens pero agrega claims extra al access
+// token (p. ej. el tenant). Cada token lleva un jti único para poder rotarlo o revocarlo
+// y su tipo (typ), para que el refresh token no se acepte como access token. El
+// refresh token sólo copia la sesión (sid) de los claims extra.
+func (s *service) GenerateTokensWithClaims(ctx context.Context, subject string, extra map[string]any,
+	customAccessExp, customRefreshExp time.Duration) (*Token, error) {
 
 	now := time.Now()
 
@@ -53,38 +68,51 @@ func (s *service) GenerateTokens(ctx context.Context, subject string,
 	refreshTokenExpiresAt := now.Add(refreshExp)
 
 	// Generar el access token
-	accessClaims := Claims{
-		Subject: subject,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(accessTokenExpiresAt),
-			IssuedAt:  jwt.NewNumericDate(now),
-		},
+	accessID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	accessClaims := jwt.MapClaims{}
+	for k, v := range extra {
+		accessClaims[k] = v
 	}
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	signedAccessToken, err := accessToken.SignedString(s.secret)
+	accessClaims["sub"] = subject
+	accessClaims[pkgutils.TokenTypeClaim] = pkgutils.AccessTokenType
+	accessClaims["jti"] = accessID
+	accessClaims["exp"] = jwt.NewNumericDate(accessTokenExpiresAt)
+	accessClaims["iat"] = jwt.NewNumericDate(now)
+	signedAccessToken, err := s.sign(accessClaims)
 	if err != nil {
 		return nil, fmt.Errorf("error signing the access token: %w", err)
 	}
 
 	// Generar el refresh token
+	refreshID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	session, _ := extra[pkgutils.SessionClaim].(string)
 	refreshClaims := Claims{
 		Subject: subject,
+		Type:    pkgutils.RefreshTokenType,
+		Session: session,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        refreshID,
 			ExpiresAt: jwt.NewNumericDate(refreshTokenExpiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
-	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	signedRefreshToken, err := refreshToken.SignedString(s.secret)
+	signedRefreshToken, err := s.sign(refreshClaims)
 	if err != nil {
 		return nil, fmt.Errorf("error signing the refresh token: %w", err)
 	}
 
 	// Retornar los tokens generados
-
 	return &Token{
 		AccessToken:      signedAccessToken,
 		RefreshToken:     signedRefreshToken,
+		AccessTokenID:    accessID,
+		RefreshTokenID:   refreshID,
 		AccessExpiresAt:  accessTokenExpiresAt,
 		RefreshExpiresAt: refreshTokenExpiresAt,
 		IssuedAt:         now,
@@ -93,16 +121,48 @@ func (s *service) GenerateTokens(ctx context.Context, subject string,
 	}, nil
 }
 
-// ValidateToken valida un token y retorna las claims extraídas, usando el único secret.
-func (s *service) ValidateToken(ctx context.Context, tokenString string) (*TokenClaims, error) {
-	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
-		// Verificamos que sea un método de firma esperado (HMAC)
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+// sign firma las claims con la clave activa del KeySet (con su kid en el header)
+// o, sin KeySet, con el secret HS256.
+func (s *service) sign(claims jwt.Claims) (string, error) {
+	if s.keys == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	}
+	key := s.keys.Current()
+	if key == nil {
+		return "", fmt.Errorf("no signing key available")
+	}
+	token := jwt.NewWithClaims(signingMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
+
+// keyFunc elige la clave de verificación: por kid en el KeySet, o el secret para
+// los tokens HS256 sin kid.
+func (s *service) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		if _, hasKid := token.Header["kid"]; hasKid && s.keys != nil {
+			return pkgutils.KeyIDFunc(ctx, s.keys)(token)
+		}
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || len(s.secret) == 0 {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return s.secret, nil
-	})
+	}
+}
+
+// newTokenID genera un identificador aleatorio para el claim jti.
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating the token id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ValidateToken valida un token y retorna las claims extraídas.
+func (s *service) ValidateToken(ctx context.Context, tokenString string) (*TokenClaims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keyFunc(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("error validating the token: %w", err)
 	}
@@ -111,12 +171,7 @@ func (s *service) ValidateToken(ctx context.Context, tokenString string) (*Token
 		return nil, fmt.Errorf("invalid token")
 	}
 
-	tokenClaims := &TokenClaims{
-		Subject:   claims.Subject,
-		ExpiresAt: claims.ExpiresAt.Time,
-		IssuedAt:  claims.IssuedAt.Time,
-	}
-	return tokenClaims, nil
+	return tokenClaimsFrom(claims), nil
 }
 
 // ValidateTokenAllowExpired valida el token pero permite que esté expirado.
@@ -124,22 +179,13 @@ func (s *service) ValidateToken(ctx context.Context, tokenString string) (*Token
 // incluso si ocurrió el error de expiración.
 func (s *service) ValidateTokenAllowExpired(ctx context.Context, tokenString string) (*TokenClaims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return s.secret, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keyFunc(ctx))
 
 	if err != nil {
 		// Verificamos si el error se debe únicamente a expiración
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			// Devolvemos las claims aunque el token esté expirado
-			return &TokenClaims{
-				Subject:   claims.Subject,
-				ExpiresAt: claims.ExpiresAt.Time,
-				IssuedAt:  claims.IssuedAt.Time,
-			}, nil
+			return tokenClaimsFrom(claims), nil
 		}
 		return nil, fmt.Errorf("error validating the token: %w", err)
 	}
@@ -148,11 +194,23 @@ func (s *service) ValidateTokenAllowExpired(ctx context.Context, tokenString str
 		return nil, fmt.Errorf("invalid token")
 	}
 
-	return &TokenClaims{
-		Subject:   claims.Subject,
-		ExpiresAt: claims.ExpiresAt.Time,
-		IssuedAt:  claims.IssuedAt.Time,
-	}, nil
+	return tokenClaimsFrom(claims), nil
+}
+
+func tokenClaimsFrom(claims *Claims) *TokenClaims {
+	tokenClaims := &TokenClaims{
+		ID:      claims.ID,
+		Subject: claims.Subject,
+		Type:    claims.Type,
+		Session: claims.Session,
+	}
+	if claims.ExpiresAt != nil {
+		tokenClaims.ExpiresAt = claims.ExpiresAt.Time
+	}
+	if claims.IssuedAt != nil {
+		tokenClaims.IssuedAt = claims.IssuedAt.Time
+	}
+	return tokenClaims
 }
 
 // GetAccessExpiration expone la expiración del access token desde la configuración.
@@ -27,6 +27,7 @@ func Bootstrap(domain, clientID, clientSecret, audience string, timeoutSeconds i
 	if audience == "" {
 		audience = os.Getenv("AUTH0_AUDIENCE")
 	}
+	redirectURL := os.Getenv("AUTH0_REDIRECT_URL")
 
 	// 2) Timeout
 	if timeoutSeconds <= 0 {
@@ -46,8 +47,8 @@ func Bootstrap(domain, clientID, clientSecret, audience string, timeoutSeconds i
 			// Aunque en Auth0 se calculan, los dejamos para cumplir la interfaz base
 			AuthURL:     "https://" + domain + "/authorize",
 			TokenURL:    "https://" + domain + "/oauth/token",
-			RedirectURL: "",                            // si no se usa Authorization Code, podría quedar vacío
-			Scopes:      []string{"openid", "profile"}, // o lo que necesites
+			RedirectURL: redirectURL,                            // callback del Authorization Code Flow
+			Scopes:      []string{"openid", "profile", "email"}, // email para vincular usuarios
 			TimeoutSec:  timeoutSeconds,
 		},
 		Domain:   domain,
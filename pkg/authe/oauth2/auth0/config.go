@@ -41,6 +41,10 @@ func (c *Config) GetTokenURL() string {
 	return "https://" + c.Domain + "/oauth/token"
 }
 
+func (c *Config) GetUserInfoURL() string {
+	return "https://" + c.Domain + "/userinfo"
+}
+
 func (c *Config) GetTimeout() time.Duration {
 	if c.TimeoutSec <= 0 {
 		return 10 * time.Second
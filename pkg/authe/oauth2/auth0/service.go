@@ -8,12 +8,14 @@ import (
 
 	pkgoauth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2"
 	"github.com/auth0-community/go-auth0"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	"gopkg.in/square/go-jose.v2"
 )
 
 type service struct {
 	cfg          *Config
+	oauth2Cfg    *oauth2.Config
 	jwkValidator *auth0.JWTValidator
 	httpClient   *http.Client
 }
@@ -41,23 +43,61 @@ func NewService(cfg *Config) (pkgoauth2.Service, error) {
 	)
 	validator := auth0.NewValidator(configuration, nil)
 
+	oCfg := &oauth2.Config{
+		ClientID:     cfg.GetClientID(),
+		ClientSecret: cfg.GetClientSecret(),
+		Scopes:       cfg.GetScopes(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  cfg.GetAuthURL(),
+			TokenURL: cfg.GetTokenURL(),
+		},
+		RedirectURL: cfg.GetRedirectURL(),
+	}
+
 	return &service{
 		cfg:          cfg,
+		oauth2Cfg:    oCfg,
 		jwkValidator: validator,
 		httpClient:   &http.Client{Timeout: cfg.GetTimeout()},
 	}, nil
 }
 
-// GetAuthCodeURL no es tan común en Auth0 + Client Credentials
-// pero podríamos implementarlo si usamos Authorization Code Flow
-func (s *service) GetAuthCodeURL(state string) string {
-	// AuthURL se obtiene de s.cfg.GetAuthURL() si implementamos el flow
-	return "not_implemented"
+// GetAuthCodeURL arma la URL de /authorize del Authorization Code Flow. El
+// audience hace que Auth0 emita un access token para nuestra API.
+func (s *service) GetAuthCodeURL(state string, opts ...pkgoauth2.AuthCodeOption) string {
+	params := pkgoauth2.ApplyAuthCodeOptions(opts...)
+	options := []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("audience", s.cfg.Audience)}
+	if params.CodeVerifier != "" {
+		options = append(options, oauth2.S256ChallengeOption(params.CodeVerifier))
+	}
+	return s.oauth2Cfg.AuthCodeURL(state, options...)
+}
+
+// ExchangeCode intercambia el código de autorización en /oauth/token.
+func (s *service) ExchangeCode(ctx context.Context, code string, opts ...pkgoauth2.AuthCodeOption) (*pkgoauth2.OAuth2Token, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.GetTimeout())
+	defer cancel()
+
+	params := pkgoauth2.ApplyAuthCodeOptions(opts...)
+	var options []oauth2.AuthCodeOption
+	if params.CodeVerifier != "" {
+		options = append(options, oauth2.VerifierOption(params.CodeVerifier))
+	}
+	token, err := s.oauth2Cfg.Exchange(ctx, code, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+	return &pkgoauth2.OAuth2Token{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Expiry:       token.Expiry,
+	}, nil
 }
 
-// ExchangeCode intercambia un código (no implementado en este ejemplo)
-func (s *service) ExchangeCode(ctx context.Context, code string) (*pkgoauth2.OAuth2Token, error) {
-	return nil, fmt.Errorf("ExchangeCode not implemented for Auth0 example")
+// GetUserInfo consulta /userinfo de Auth0.
+func (s *service) GetUserInfo(ctx context.Context, accessToken string) (*pkgoauth2.UserInfo, error) {
+	return pkgoauth2.FetchUserInfo(ctx, s.httpClient, s.cfg.GetUserInfoURL(), accessToken)
 }
 
 // RefreshToken no implementado en este ejemplo
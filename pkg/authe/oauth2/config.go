@@ -10,6 +10,7 @@ type BaseConfig struct {
 	AuthURL      string
 	TokenURL     string
 	RedirectURL  string
+	UserInfoURL  string // opcional: sin él no se puede consultar la identidad del usuario
 	Scopes       []string
 	TimeoutSec   int
 }
@@ -42,6 +43,7 @@ func (c *BaseConfig) GetClientSecret() string { return c.ClientSecret }
 func (c *BaseConfig) GetAuthURL() string      { return c.AuthURL }
 func (c *BaseConfig) GetTokenURL() string     { return c.TokenURL }
 func (c *BaseConfig) GetRedirectURL() string  { return c.RedirectURL }
+func (c *BaseConfig) GetUserInfoURL() string  { return c.UserInfoURL }
 func (c *BaseConfig) GetScopes() []string     { return c.Scopes }
 
 func (c *BaseConfig) GetTimeout() time.Duration {
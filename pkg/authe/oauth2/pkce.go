@@ -0,0 +1,34 @@
+package pkgoauth2
+
+import (
+	"golang.org/x/oauth2"
+)
+
+// AuthCodeOption agrega parámetros al Authorization Code Flow.
+type AuthCodeOption func(*AuthCodeParams)
+
+// AuthCodeParams son los parámetros opcionales del Authorization Code Flow.
+type AuthCodeParams struct {
+	// CodeVerifier activa PKCE (RFC 7636, método S256).
+	CodeVerifier string
+}
+
+// WithPKCE usa el verifier para derivar el code_challenge en GetAuthCodeURL y lo
+// envía como code_verifier en ExchangeCode.
+func WithPKCE(verifier string) AuthCodeOption {
+	return func(p *AuthCodeParams) { p.CodeVerifier = verifier }
+}
+
+// GenerateVerifier genera un code_verifier aleatorio para PKCE.
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+// ApplyAuthCodeOptions resuelve las opciones a parámetros.
+func ApplyAuthCodeOptions(opts ...AuthCodeOption) AuthCodeParams {
+	var p AuthCodeParams
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return p
+}
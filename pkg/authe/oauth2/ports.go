@@ -13,6 +13,7 @@ type Config interface {
 	GetAuthURL() string
 	GetTokenURL() string
 	GetRedirectURL() string
+	GetUserInfoURL() string
 	GetScopes() []string
 	GetTimeout() time.Duration
 }
@@ -20,14 +21,18 @@ type Config interface {
 // Service define la interfaz para un servicio OAuth2 genérico.
 type Service interface {
 	// Construye la URL para obtener el código de autorización (Authorization Code Flow).
-	GetAuthCodeURL(state string) string
+	GetAuthCodeURL(state string, opts ...AuthCodeOption) string
 
-	// Intercambia un código de autorización por un token.
-	ExchangeCode(ctx context.Context, code string) (*OAuth2Token, error)
+	// Intercambia un código de autorización por un token. Con PKCE se debe pasar
+	// el mismo verifier usado en GetAuthCodeURL.
+	ExchangeCode(ctx context.Context, code string, opts ...AuthCodeOption) (*OAuth2Token, error)
 
 	// Usa el refresh token para obtener un nuevo access token.
 	RefreshToken(ctx context.Context, refreshToken string) (*OAuth2Token, error)
 
 	// Valida un token (depende del proveedor).
 	ValidateToken(ctx context.Context, tokenStr string) (*TokenClaims, error)
+
+	// Consulta el endpoint userinfo del proveedor con el access token.
+	GetUserInfo(ctx context.Context, accessToken string) (*UserInfo, error)
 }
@@ -17,3 +17,11 @@ type TokenClaims struct {
 	Subject string `json:"sub,omitempty"`
 	// Otros campos como email, nombre, roles, etc.
 }
+
+// UserInfo es la identidad del usuario según el proveedor (claims estándar de OIDC).
+type UserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
@@ -0,0 +1,42 @@
+package pkgoauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// FetchUserInfo consulta un endpoint userinfo de OIDC con el access token.
+func FetchUserInfo(ctx context.Context, client *http.Client, userInfoURL, accessToken string) (*UserInfo, error) {
+	if userInfoURL == "" {
+		return nil, fmt.Errorf("userinfo url is not configured")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userInfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build userinfo request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("userinfo returned %d: %s", resp.StatusCode, body)
+	}
+
+	var info UserInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode userinfo: %w", err)
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("userinfo has no subject")
+	}
+	return &info, nil
+}
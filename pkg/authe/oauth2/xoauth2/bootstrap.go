@@ -61,6 +61,7 @@ func BootstrapStd(
 			AuthURL:      authURL,
 			TokenURL:     tokenURL,
 			RedirectURL:  redirectURL,
+			UserInfoURL:  os.Getenv("OAUTH2_USERINFO_URL"),
 			Scopes:       scopes,
 			TimeoutSec:   timeoutSeconds,
 		},
@@ -3,6 +3,7 @@ package pkgxaouth2
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -11,9 +12,10 @@ import (
 )
 
 type service struct {
-	cfg       *Config
-	oauth2Cfg *oauth2.Config
-	timeout   time.Duration
+	cfg        *Config
+	oauth2Cfg  *oauth2.Config
+	timeout    time.Duration
+	httpClient *http.Client
 }
 
 func NewService(cfg *Config) (pkgoauth2.Service, error) {
@@ -33,21 +35,32 @@ func NewService(cfg *Config) (pkgoauth2.Service, error) {
 	}
 
 	return &service{
-		cfg:       cfg,
-		oauth2Cfg: oCfg,
-		timeout:   cfg.GetTimeout(),
+		cfg:        cfg,
+		oauth2Cfg:  oCfg,
+		timeout:    cfg.GetTimeout(),
+		httpClient: &http.Client{Timeout: cfg.GetTimeout()},
 	}, nil
 }
 
-func (s *service) GetAuthCodeURL(state string) string {
-	return s.oauth2Cfg.AuthCodeURL(state, oauth2.AccessTypeOffline)
+func (s *service) GetAuthCodeURL(state string, opts ...pkgoauth2.AuthCodeOption) string {
+	params := pkgoauth2.ApplyAuthCodeOptions(opts...)
+	options := []oauth2.AuthCodeOption{oauth2.AccessTypeOffline}
+	if params.CodeVerifier != "" {
+		options = append(options, oauth2.S256ChallengeOption(params.CodeVerifier))
+	}
+	return s.oauth2Cfg.AuthCodeURL(state, options...)
 }
 
-func (s *service) ExchangeCode(ctx context.Context, code string) (*pkgoauth2.OAuth2Token, error) {
+func (s *service) ExchangeCode(ctx context.Context, code string, opts ...pkgoauth2.AuthCodeOption) (*pkgoauth2.OAuth2Token, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	token, err := s.oauth2Cfg.Exchange(ctx, code)
+	params := pkgoauth2.ApplyAuthCodeOptions(opts...)
+	var options []oauth2.AuthCodeOption
+	if params.CodeVerifier != "" {
+		options = append(options, oauth2.VerifierOption(params.CodeVerifier))
+	}
+	token, err := s.oauth2Cfg.Exchange(ctx, code, options...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code: %w", err)
 	}
@@ -82,3 +95,7 @@ func (s *service) ValidateToken(ctx context.Context, tokenStr string) (*pkgoauth
 	// Aquí podrías hacer introspección, decodificar JWT, etc.
 	return nil, fmt.Errorf("ValidateToken not implemented for pkgxaouth2")
 }
+
+func (s *service) GetUserInfo(ctx context.Context, accessToken string) (*pkgoauth2.UserInfo, error) {
+	return pkgoauth2.FetchUserInfo(ctx, s.httpClient, s.cfg.GetUserInfoURL(), accessToken)
+}
@@ -0,0 +1,6 @@
+package pkginmemory
+
+// Bootstrap crea un nuevo broker en memoria. No usa singleton para que cada test tenga el suyo.
+func Bootstrap(opts ...Option) (Broker, error) {
+	return newBroker(opts...), nil
+}
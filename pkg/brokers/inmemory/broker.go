@@ -0,0 +1,93 @@
+package pkginmemory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// broker entrega los mensajes de forma síncrona a los suscriptores del topic.
+// Con WithRecording guarda además una copia de todo lo publicado.
+type broker struct {
+	mu          sync.RWMutex
+	subscribers map[string][]Handler
+	messages    map[string][]Message
+	record      bool
+	closed      bool
+}
+
+// Option configura el broker en memoria.
+type Option func(*broker)
+
+// WithRecording guarda los mensajes publicados para consultarlos con Messages.
+// Es para tests: la copia crece sin límite mientras viva el broker.
+func WithRecording() Option {
+	return func(b *broker) {
+		b.record = true
+	}
+}
+
+func newBroker(opts ...Option) *broker {
+	b := &broker{
+		subscribers: make(map[string][]Handler),
+		messages:    make(map[string][]Message),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Publish entrega el mensaje a cada suscriptor. Si algún suscriptor falla el
+// error se devuelve al publicador, igual que un nack.
+func (b *broker) Publish(ctx context.Context, topic string, key, value []byte) error {
+	if topic == "" {
+		return errors.New("topic cannot be empty")
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return errors.New("broker is closed")
+	}
+	msg := Message{
+		Topic: topic,
+		Key:   append([]byte(nil), key...),
+		Value: append([]byte(nil), value...),
+	}
+	if b.record {
+		b.messages[topic] = append(b.messages[topic], msg)
+	}
+	handlers := append([]Handler(nil), b.subscribers[topic]...)
+	b.mu.Unlock()
+
+	for _, h := range handlers {
+		if err := h(ctx, msg); err != nil {
+			return fmt.Errorf("subscriber failed on topic %s: %w", topic, err)
+		}
+	}
+	return nil
+}
+
+// Subscribe registra un handler para el topic indicado.
+func (b *broker) Subscribe(topic string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[topic] = append(b.subscribers[topic], handler)
+}
+
+// Messages devuelve una copia de los mensajes publicados en el topic. Sin
+// WithRecording siempre está vacía.
+func (b *broker) Messages(topic string) []Message {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]Message(nil), b.messages[topic]...)
+}
+
+// Close impide nuevas publicaciones.
+func (b *broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+}
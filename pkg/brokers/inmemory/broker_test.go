@@ -0,0 +1,39 @@
+package pkginmemory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerRecording(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want int
+	}{
+		{name: "messages are not kept by default", want: 0},
+		{name: "messages are kept with WithRecording", opts: []Option{WithRecording()}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker, err := Bootstrap(tt.opts...)
+			require.NoError(t, err)
+			defer broker.Close()
+
+			var delivered int
+			broker.Subscribe("events", func(context.Context, Message) error {
+				delivered++
+				return nil
+			})
+			require.NoError(t, broker.Publish(context.Background(), "events", []byte("k"), []byte("1")))
+			require.NoError(t, broker.Publish(context.Background(), "events", []byte("k"), []byte("2")))
+
+			assert.Equal(t, 2, delivered)
+			assert.Len(t, broker.Messages("events"), tt.want)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package pkginmemory
+
+import "context"
+
+// Message es un mensaje publicado en el broker en memoria.
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Handler procesa un mensaje entregado por el broker.
+type Handler func(context.Context, Message) error
+
+// Broker es un broker en proceso, pensado para tests y entornos locales.
+type Broker interface {
+	Publish(ctx context.Context, topic string, key, value []byte) error
+	Subscribe(topic string, handler Handler)
+	Messages(topic string) []Message
+	Close()
+}
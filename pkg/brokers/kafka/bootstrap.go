@@ -1,13 +1,28 @@
 package pkgafka
 
 import (
+	"os"
+	"strings"
+
 	"github.com/spf13/viper"
 )
 
 func Bootstrap(brokersKey, groupIDKey string) (Service, error) {
+	brokers := viper.GetStringSlice(brokersKey)
+	if len(brokers) == 0 {
+		// Si viper no tiene la clave, se lee una lista separada por comas del entorno.
+		if raw := os.Getenv(brokersKey); raw != "" {
+			brokers = strings.Split(raw, ",")
+		}
+	}
+	groupID := viper.GetString(groupIDKey)
+	if groupID == "" {
+		groupID = os.Getenv(groupIDKey)
+	}
+
 	config := newConfig(
-		viper.GetStringSlice(brokersKey),
-		viper.GetString(groupIDKey),
+		brokers,
+		groupID,
 	)
 
 	if err := config.Validate(); err != nil {
@@ -8,7 +8,9 @@ type Config interface {
 	Validate() error
 }
 
+// Service publica y consume mensajes. La traza del contexto viaja en los headers
+// del mensaje; el handler de Consume recibe un contexto que la continúa.
 type Service interface {
 	Publish(context.Context, string, []byte, []byte) error
-	Consume(context.Context, []string, func([]byte, []byte) error) error
+	Consume(context.Context, []string, func(context.Context, []byte, []byte) error) error
 }
@@ -6,6 +6,11 @@ import (
 	"sync"
 
 	"github.com/segmentio/kafka-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 type service struct {
@@ -25,23 +30,47 @@ func newService(c Config) (Service, error) {
 		GroupID: c.GetGroupID(),
 	})
 
-	return &service{
+	s := &service{
 		config: c,
 		writer: writer,
 		reader: reader,
-	}, nil
+	}
+	pkghealth.Register("kafka", s.ping, pkghealth.NonCritical())
+	return s, nil
+}
+
+// ping conecta con el primer broker que responda, para /readyz.
+func (s *service) ping(ctx context.Context) error {
+	var err error
+	for _, broker := range s.config.GetBrokers() {
+		var conn *kafka.Conn
+		conn, err = kafka.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+	}
+	return fmt.Errorf("no kafka broker reachable: %w", err)
 }
 
 func (s *service) Publish(ctx context.Context, topic string, key, value []byte) error {
+	ctx, span := pkgtracing.Tracer().Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingDestinationName(topic)),
+	)
 	msg := kafka.Message{
 		Topic: topic,
 		Key:   key,
 		Value: value,
 	}
-	return s.writer.WriteMessages(ctx, msg)
+	for k, v := range pkgtracing.Inject(ctx) {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	err := s.writer.WriteMessages(ctx, msg)
+	pkgtracing.End(span, err)
+	return err
 }
 
-func (s *service) Consume(ctx context.Context, topics []string, handler func(key, value []byte) error) error {
+func (s *service) Consume(ctx context.Context, topics []string, handler func(ctx context.Context, key, value []byte) error) error {
 	var wg sync.WaitGroup
 	errorsCh := make(chan error, len(topics))
 
@@ -63,7 +92,7 @@ func (s *service) Consume(ctx context.Context, topics []string, handler func(key
 					return
 				}
 
-				if err := handler(m.Key, m.Value); err != nil {
+				if err := s.handle(ctx, m, handler); err != nil {
 					errorsCh <- fmt.Errorf("error handling message from topic %s: %w", topic, err)
 					return
 				}
@@ -90,3 +119,18 @@ func (s *service) Consume(ctx context.Context, topics []string, handler func(key
 
 	return nil
 }
+
+// handle procesa un mensaje dentro de un span que continúa la traza del productor.
+func (s *service) handle(ctx context.Context, m kafka.Message, handler func(context.Context, []byte, []byte) error) error {
+	headers := make(map[string]string, len(m.Headers))
+	for _, h := range m.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	ctx, span := pkgtracing.Tracer().Start(pkgtracing.Extract(ctx, headers), m.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingDestinationName(m.Topic)),
+	)
+	err := handler(ctx, m.Key, m.Value)
+	pkgtracing.End(span, err)
+	return err
+}
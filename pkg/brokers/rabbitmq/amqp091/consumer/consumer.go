@@ -7,6 +7,10 @@ import (
 	"sync"
 
 	"github.com/rabbitmq/amqp091-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 
@@ -82,7 +86,7 @@ func (c *consumer) reconnect() error {
 
 // Consume inicia el consumo de mensajes de la cola indicada.
 // Si el canal se cierra, intenta reconectarse y re-registrar el consumidor.
-func (c *consumer) Consume(ctx context.Context, queueName, consumerTag string, handler func(amqp091.Delivery) error) error {
+func (c *consumer) Consume(ctx context.Context, queueName, consumerTag string, handler func(context.Context, amqp091.Delivery) error) error {
 	msgs, err := c.channel.Consume(
 		queueName,
 		consumerTag,
@@ -115,7 +119,7 @@ func (c *consumer) Consume(ctx context.Context, queueName, consumerTag string, h
 				continue
 			}
 			// Procesar el mensaje usando el handler proporcionado.
-			if err := handler(d); err != nil {
+			if err := c.handle(ctx, queueName, d, handler); err != nil {
 				c.logger.Printf("Error processing message: %v", err)
 				// Rechazar el mensaje y pedir reenvío.
 				d.Nack(false, true)
@@ -126,6 +130,23 @@ func (c *consumer) Consume(ctx context.Context, queueName, consumerTag string, h
 	}
 }
 
+// handle procesa un mensaje dentro de un span que continúa la traza del productor.
+func (c *consumer) handle(ctx context.Context, queueName string, d amqp091.Delivery, handler func(context.Context, amqp091.Delivery) error) error {
+	headers := make(map[string]string, len(d.Headers))
+	for k, v := range d.Headers {
+		if s, ok := v.(string); ok {
+			headers[k] = s
+		}
+	}
+	ctx, span := pkgtracing.Tracer().Start(pkgtracing.Extract(ctx, headers), queueName+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(semconv.MessagingSystemRabbitmq, semconv.MessagingDestinationName(queueName)),
+	)
+	err := handler(ctx, d)
+	pkgtracing.End(span, err)
+	return err
+}
+
 // Close cierra el canal y la conexión del consumidor.
 func (c *consumer) Close() error {
 	var errs []error
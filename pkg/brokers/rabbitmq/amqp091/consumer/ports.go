@@ -50,7 +50,8 @@ type Config interface {
 // Consumer define la interfaz para un consumidor de RabbitMQ.
 type Consumer interface {
 	// Consume inicia la recepción de mensajes de la cola indicada y ejecuta el handler para cada mensaje.
-	Consume(ctx context.Context, queueName, consumerTag string, handler func(amqp091.Delivery) error) error
+	// El contexto del handler continúa la traza recibida en los headers del mensaje.
+	Consume(ctx context.Context, queueName, consumerTag string, handler func(context.Context, amqp091.Delivery) error) error
 	// Close cierra el canal y la conexión del consumidor.
 	Close() error
 }
@@ -10,6 +10,11 @@ import (
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 // producer implementa la interfaz Producer para RabbitMQ.
@@ -38,6 +43,7 @@ func newProducer(config Config, logger Logger) (Producer, error) {
 	if err != nil {
 		return nil, err
 	}
+	pkghealth.Register("rabbitmq", p.ping, pkghealth.NonCritical())
 	return p, nil
 }
 
@@ -127,7 +133,7 @@ func (p *producer) reconnect() error {
 }
 
 // Produce envía un mensaje a la cola especificada. Se protege el acceso concurrente al canal.
-func (p *producer) Produce(ctx context.Context, queueName, replyTo, corrID string, message any) (string, error) {
+func (p *producer) Produce(ctx context.Context, queueName, replyTo, corrID string, message any) (_ string, err error) {
 	// Convertir el mensaje a JSON.
 	body, err := json.Marshal(message)
 	if err != nil {
@@ -139,6 +145,17 @@ func (p *producer) Produce(ctx context.Context, queueName, replyTo, corrID strin
 		corrID = fmt.Sprintf("%d", time.Now().UnixNano())
 	}
 
+	// La traza viaja en los headers del mensaje.
+	ctx, span := pkgtracing.Tracer().Start(ctx, queueName+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemRabbitmq, semconv.MessagingDestinationName(queueName)),
+	)
+	defer func() { pkgtracing.End(span, err) }()
+	headers := amqp091.Table{}
+	for k, v := range pkgtracing.Inject(ctx) {
+		headers[k] = v
+	}
+
 	// Proteger la publicación.
 	p.publishMu.Lock()
 	err = p.channel.PublishWithContext(ctx,
@@ -151,6 +168,7 @@ func (p *producer) Produce(ctx context.Context, queueName, replyTo, corrID strin
 			Body:          body,
 			CorrelationId: corrID,
 			ReplyTo:       replyTo,
+			Headers:       headers,
 		})
 	p.publishMu.Unlock()
 
@@ -169,6 +187,7 @@ func (p *producer) Produce(ctx context.Context, queueName, replyTo, corrID strin
 				Body:          body,
 				CorrelationId: corrID,
 				ReplyTo:       replyTo,
+				Headers:       headers,
 			})
 		p.publishMu.Unlock()
 
@@ -242,6 +261,19 @@ func (p *producer) Close() error {
 	return nil
 }
 
+// ping informa si la conexión y el canal siguen abiertos, para /readyz.
+func (p *producer) ping(context.Context) error {
+	p.reconnMu.Lock()
+	defer p.reconnMu.Unlock()
+	if p.conn == nil || p.conn.IsClosed() {
+		return fmt.Errorf("RabbitMQ connection is closed")
+	}
+	if p.channel == nil || p.channel.IsClosed() {
+		return fmt.Errorf("RabbitMQ channel is closed")
+	}
+	return nil
+}
+
 // GetConnection devuelve la conexión actual a RabbitMQ.
 func (p *producer) GetConnection() *amqp091.Connection {
 	return p.conn
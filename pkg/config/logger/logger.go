@@ -1,7 +1,9 @@
 package pkglogger
 
 import (
+	"fmt"
 	"log"
+	"log/slog"
 
 	"go-micro.dev/v4/logger"
 )
@@ -19,16 +21,29 @@ func applyColor(color, format string) string {
 	return color + format + reset
 }
 
-// Standard log functions using the default log package
+// Standard log functions. Con un logger instalado por Setup salen sin colores,
+// como registros de slog.
 func Info(format string, v ...any) {
+	if structured() {
+		slog.Info(fmt.Sprintf(format, v...))
+		return
+	}
 	log.Printf(applyColor(blue, format), v...)
 }
 
 func Warn(format string, v ...any) {
+	if structured() {
+		slog.Warn(fmt.Sprintf(format, v...))
+		return
+	}
 	log.Printf(applyColor(yellow, format), v...)
 }
 
 func Error(format string, v ...any) {
+	if structured() {
+		slog.Error(fmt.Sprintf(format, v...))
+		return
+	}
 	log.Printf(applyColor(red, format), v...)
 }
 
@@ -44,3 +59,9 @@ func GmWarn(format string, v ...any) {
 func GmError(format string, v ...any) {
 	logger.Errorf(applyColor(red, format), v...)
 }
+
+// structured indica si Setup instaló el logger de slog como default.
+func structured() bool {
+	_, ok := slog.Default().Handler().(*contextHandler)
+	return ok
+}
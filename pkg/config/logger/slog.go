@@ -0,0 +1,121 @@
+package pkglogger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// RequestIDKey es el nombre del atributo con el ID de la request.
+const RequestIDKey = "request_id"
+
+// Options configura el logger estructurado.
+type Options struct {
+	// Level es debug, info, warn o error. Por defecto info.
+	Level string
+	// Format es json (por defecto) o text.
+	Format string
+	// Output es donde se escribe; os.Stdout si es nil.
+	Output io.Writer
+	// AddSource agrega archivo y línea de cada log.
+	AddSource bool
+}
+
+// OptionsFromEnv lee LOG_LEVEL, LOG_FORMAT y LOG_ADD_SOURCE.
+func OptionsFromEnv() Options {
+	return Options{
+		Level:     os.Getenv("LOG_LEVEL"),
+		Format:    os.Getenv("LOG_FORMAT"),
+		AddSource: os.Getenv("LOG_ADD_SOURCE") == "true",
+	}
+}
+
+// New crea un *slog.Logger que agrega a cada registro los atributos guardados
+// en el contexto con WithAttrs (usar las variantes *Context de slog).
+func New(opts Options) *slog.Logger {
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	handlerOpts := &slog.HandlerOptions{Level: ParseLevel(opts.Level), AddSource: opts.AddSource}
+
+	var handler slog.Handler
+	if strings.EqualFold(opts.Format, "text") {
+		handler = slog.NewTextHandler(out, handlerOpts)
+	} else {
+		handler = slog.NewJSONHandler(out, handlerOpts)
+	}
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+// Setup instala el logger como default de slog. El paquete log estándar también
+// pasa a escribir a través de él, así los log.Printf existentes salen en el mismo formato.
+func Setup(opts Options) *slog.Logger {
+	logger := New(opts)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// ParseLevel convierte el nombre de un nivel; los desconocidos son info.
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+type attrsKey struct{}
+
+// WithAttrs devuelve un contexto cuyos logs llevan además los atributos dados.
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+	current, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(current)+len(attrs))
+	merged = append(merged, current...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// WithRequestID guarda el ID de la request en el contexto y en sus logs.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	return WithAttrs(ctx, slog.String(RequestIDKey, requestID))
+}
+
+type requestIDKey struct{}
+
+// RequestIDFromContext retorna el ID guardado por WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// contextHandler agrega los atributos del contexto a cada registro.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
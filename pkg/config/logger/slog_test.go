@@ -0,0 +1,76 @@
+package pkglogger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAddsContextAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(Options{Level: "debug", Output: &buf})
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	ctx = WithAttrs(ctx, slog.Int64("tenant_id", 7))
+	logger.With("component", "test").InfoContext(ctx, "hello", "key", "value")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "hello", record["msg"])
+	assert.Equal(t, "req-1", record[RequestIDKey])
+	assert.Equal(t, float64(7), record["tenant_id"])
+	assert.Equal(t, "test", record["component"])
+	assert.Equal(t, "value", record["key"])
+
+	id, ok := RequestIDFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "req-1", id)
+}
+
+func TestNewWithoutContextAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	New(Options{Format: "text", Output: &buf}).InfoContext(context.Background(), "hello")
+
+	assert.Contains(t, buf.String(), "msg=hello")
+	assert.NotContains(t, buf.String(), RequestIDKey)
+
+	_, ok := RequestIDFromContext(context.Background())
+	assert.False(t, ok)
+}
+
+func TestNewRespectsLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(Options{Level: "warn", Output: &buf})
+
+	logger.Info("dropped")
+	assert.Empty(t, buf.String())
+	logger.Warn("kept")
+	assert.Contains(t, buf.String(), "kept")
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in   string
+		want slog.Level
+	}{
+		{in: "debug", want: slog.LevelDebug},
+		{in: "DEBUG", want: slog.LevelDebug},
+		{in: "info", want: slog.LevelInfo},
+		{in: "warn", want: slog.LevelWarn},
+		{in: "Warning", want: slog.LevelWarn},
+		{in: "error", want: slog.LevelError},
+		{in: "", want: slog.LevelInfo},
+		{in: "verbose", want: slog.LevelInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseLevel(tt.in))
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 )
 
 var (
@@ -31,6 +33,11 @@ func NewCache(c Config) (Cache, error) {
 			defaultExpiration: c.GetDefaultExpiration(),
 		}
 		err = instance.connect(c)
+		if err == nil {
+			// Crítica: sin Redis no se arranca (connect falla), así que tampoco
+			// se debe recibir tráfico si se cae después.
+			pkghealth.Register("redis", instance.ping)
+		}
 	})
 
 	if err != nil {
@@ -56,6 +63,11 @@ func (ch *cache) connect(c Config) error {
 	return nil
 }
 
+// ping verifica la conexión para /readyz.
+func (ch *cache) ping(ctx context.Context) error {
+	return ch.client.Ping(ctx).Err()
+}
+
 // Set almacena un valor en Redis con una clave y un tiempo de expiración opcional
 func (ch *cache) Set(ctx context.Context, key string, value any, expiration ...time.Duration) error {
 	if key == "" {
@@ -70,6 +82,19 @@ func (ch *cache) Set(ctx context.Context, key string, value any, expiration ...t
 	return ch.client.Set(ctx, key, value, exp).Err()
 }
 
+// SetNX almacena un valor solo si la clave no existe. Retorna true si la clave fue creada.
+func (ch *cache) SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	if key == "" {
+		return false, errors.New("key cannot be empty")
+	}
+
+	ok, err := ch.client.SetNX(ctx, key, value, expiration).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to set key: %w", err)
+	}
+	return ok, nil
+}
+
 // Get recupera un valor de Redis usando una clave
 func (ch *cache) Get(ctx context.Context, key string) (string, error) {
 	if key == "" {
@@ -10,6 +10,7 @@ import (
 // Cache define los métodos para interactuar con Redis
 type Cache interface {
 	Set(ctx context.Context, key string, value any, expiration ...time.Duration) error
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key string) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
@@ -0,0 +1,94 @@
+package pkggorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// defaultSlowThreshold es el tiempo a partir del cual una query se loggea como lenta.
+const defaultSlowThreshold = 200 * time.Millisecond
+
+// slogLogger envía los logs de GORM a slog. Las queries se loggean con el
+// contexto de la request (usar db.WithContext), así llevan su request_id.
+type slogLogger struct {
+	level         gormlogger.LogLevel
+	slowThreshold time.Duration
+	logValues     bool
+}
+
+// NewSlogLogger crea un logger de GORM sobre slog. Cada query se loggea en debug,
+// las lentas en warn y las fallidas en error; record not found no es un error.
+// Con logValues en false el SQL se loggea con sus placeholders ($1, ?) y sin los
+// valores, que pueden traer emails, hashes o tokens.
+func NewSlogLogger(slowThreshold time.Duration, logValues bool) gormlogger.Interface {
+	if slowThreshold <= 0 {
+		slowThreshold = defaultSlowThreshold
+	}
+	return &slogLogger{level: gormlogger.Info, slowThreshold: slowThreshold, logValues: logValues}
+}
+
+// newGormConfig arma la configuración de GORM con el logger de slog; el umbral de
+// queries lentas se lee de GORM_SLOW_THRESHOLD (ej. "500ms"). Los valores de las
+// queries solo se loggean con APP_ENV=dev.
+func newGormConfig() *gorm.Config {
+	threshold, _ := time.ParseDuration(os.Getenv("GORM_SLOW_THRESHOLD"))
+	return &gorm.Config{Logger: NewSlogLogger(threshold, os.Getenv("APP_ENV") == "dev")}
+}
+
+// ParamsFilter implementa gorm.ParamsFilter: sin logValues descarta los valores
+// para que fc() devuelva el SQL parametrizado.
+func (l *slogLogger) ParamsFilter(_ context.Context, sql string, params ...any) (string, []any) {
+	if l.logValues {
+		return sql, params
+	}
+	return sql, nil
+}
+
+func (l *slogLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *slogLogger) Info(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Info {
+		slog.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Warn(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Warn {
+		slog.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Error(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Error {
+		slog.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gormlogger.ErrRecordNotFound):
+		sql, rows := fc()
+		slog.ErrorContext(ctx, "query failed", "sql", sql, "rows", rows, "elapsed", elapsed, "error", err)
+	case elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		slog.WarnContext(ctx, "slow query", "sql", sql, "rows", rows, "elapsed", elapsed, "threshold", l.slowThreshold)
+	case l.level >= gormlogger.Info && slog.Default().Enabled(ctx, slog.LevelDebug):
+		sql, rows := fc()
+		slog.DebugContext(ctx, "query", "sql", sql, "rows", rows, "elapsed", elapsed)
+	}
+}
@@ -0,0 +1,59 @@
+package pkggorm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type account struct {
+	ID    int64
+	Email string
+}
+
+func TestSlogLoggerBoundValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		logValues bool
+		contains  string
+		excludes  string
+	}{
+		{name: "parameterized by default", logValues: false, contains: "$1", excludes: "secret@example.com"},
+		{name: "values inlined when enabled", logValues: true, contains: "secret@example.com", excludes: "$1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			previous := slog.Default()
+			slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+			t.Cleanup(func() { slog.SetDefault(previous) })
+
+			sqlDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = sqlDB.Close() })
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+				SkipDefaultTransaction: true,
+				Logger:                 NewSlogLogger(0, tt.logValues),
+			})
+			require.NoError(t, err)
+
+			mock.ExpectQuery(`SELECT \* FROM "accounts"`).WillReturnError(errors.New("boom"))
+			err = db.WithContext(context.Background()).Where("email = ?", "secret@example.com").First(&account{}).Error
+			require.Error(t, err)
+
+			logged := buf.String()
+			assert.Contains(t, logged, "query failed")
+			assert.Contains(t, logged, tt.contains)
+			assert.NotContains(t, logged, tt.excludes)
+		})
+	}
+}
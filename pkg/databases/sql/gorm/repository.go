@@ -1,6 +1,7 @@
 package pkggorm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -10,6 +11,8 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 )
 
 // repository es la implementación de Repository
@@ -27,9 +30,19 @@ func newRepository(c Config) (Repository, error) {
 	if err := repo.Connect(c); err != nil {
 		return nil, fmt.Errorf("failed to initialize repository: %w", err)
 	}
+	pkghealth.Register("gorm", repo.ping)
 	return repo, nil
 }
 
+// ping verifica la conexión para /readyz.
+func (r *repository) ping(ctx context.Context) error {
+	sqlDB, err := r.client.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 // Connect establece la conexión con la base de datos según el tipo
 func (r *repository) Connect(config Config) error {
 	// Crear la base de datos si no existe
@@ -44,7 +57,7 @@ func (r *repository) Connect(config Config) error {
 	case Postgres:
 		dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
 			config.GetHost(), config.GetUser(), config.GetPassword(), config.GetDBName(), config.GetPort())
-		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(postgres.Open(dsn), newGormConfig())
 		if err != nil {
 			return fmt.Errorf("failed to connect to PostgreSQL: %w", err)
 		}
@@ -52,14 +65,14 @@ func (r *repository) Connect(config Config) error {
 	case MySQL:
 		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 			config.GetUser(), config.GetPassword(), config.GetHost(), config.GetPort(), config.GetDBName())
-		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(mysql.Open(dsn), newGormConfig())
 		if err != nil {
 			return fmt.Errorf("failed to connect to MySQL: %w", err)
 		}
 
 	case SQLite:
 		dsn := config.GetSQLitePath()
-		db, err = gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(sqlite.Open(dsn), newGormConfig())
 		if err != nil {
 			return fmt.Errorf("failed to connect to SQLite: %w", err)
 		}
@@ -106,7 +119,7 @@ func (r *repository) createDatabaseIfNotExists(config Config) error {
 		// Conecta al servidor usando la base de datos predeterminada "postgres"
 		dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=postgres port=%d sslmode=disable",
 			config.GetHost(), config.GetUser(), config.GetPassword(), config.GetPort())
-		db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+		db, err := gorm.Open(postgres.Open(dsn), newGormConfig())
 		if err != nil {
 			return fmt.Errorf("failed to connect to PostgreSQL server: %w", err)
 		}
@@ -128,7 +141,7 @@ func (r *repository) createDatabaseIfNotExists(config Config) error {
 		// Conecta al servidor sin especificar la base de datos
 		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/?charset=utf8mb4&parseTime=True&loc=Local",
 			config.GetUser(), config.GetPassword(), config.GetHost(), config.GetPort())
-		db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		db, err := gorm.Open(mysql.Open(dsn), newGormConfig())
 		if err != nil {
 			return fmt.Errorf("failed to connect to MySQL server: %w", err)
 		}
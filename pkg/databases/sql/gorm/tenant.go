@@ -0,0 +1,155 @@
+package pkggorm
+
+import (
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// TenantColumn es la columna que identifica al tenant en los modelos aislados.
+const TenantColumn = "tenant_id"
+
+// TenantScoped se embebe en los modelos cuyos datos pertenecen a una organización.
+// Las filas creadas antes de existir los tenants quedan en la organización 1.
+type TenantScoped struct {
+	TenantID int64 `gorm:"not null;default:1;index;column:tenant_id"`
+}
+
+// RegisterTenantScope registra callbacks que filtran por tenant todas las lecturas,
+// actualizaciones y borrados de modelos con columna tenant_id, y que completan el
+// tenant en las altas. El tenant se toma del contexto (pkgtypes.WithTenantID); si
+// falta, la operación falla con ErrAuthorization.
+func RegisterTenantScope(db *gorm.DB) error {
+	cb := db.Callback()
+	if err := cb.Create().Before("gorm:create").Register("tenant:stamp", stampTenant); err != nil {
+		return fmt.Errorf("register tenant create callback: %w", err)
+	}
+	if err := cb.Query().Before("gorm:query").Register("tenant:scope", scopeTenant); err != nil {
+		return fmt.Errorf("register tenant query callback: %w", err)
+	}
+	if err := cb.Update().Before("gorm:update").Register("tenant:scope", scopeTenant); err != nil {
+		return fmt.Errorf("register tenant update callback: %w", err)
+	}
+	if err := cb.Delete().Before("gorm:delete").Register("tenant:scope", scopeTenant); err != nil {
+		return fmt.Errorf("register tenant delete callback: %w", err)
+	}
+	if err := cb.Row().Before("gorm:row").Register("tenant:scope", scopeTenant); err != nil {
+		return fmt.Errorf("register tenant row callback: %w", err)
+	}
+	return nil
+}
+
+// CrossTenantError devuelve un ErrAuthorization si la fila con ese id existe pero
+// pertenece a otro tenant. Se usa después de un "not found" para distinguir un
+// registro inexistente de un intento de acceso a datos de otra organización.
+func CrossTenantError(db *gorm.DB, model any, id any) error {
+	ctx := db.Statement.Context
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	var owners []int64
+	err := db.Session(&gorm.Session{NewDB: true}).
+		WithContext(pkgtypes.WithoutTenantScope(ctx)).
+		Model(model).
+		Where("id = ?", id).
+		Limit(1).
+		Pluck(TenantColumn, &owners).Error
+	if err != nil || len(owners) == 0 || owners[0] == tenantID {
+		return nil
+	}
+	return pkgtypes.NewError(pkgtypes.ErrAuthorization, "resource belongs to another organization", nil)
+}
+
+// EnsureSameTenant devuelve un ErrAuthorization si alguno de los ids de la tabla
+// pertenece a otro tenant. Sirve para validar referencias (claves foráneas o tablas
+// pivote) que se escriben sin pasar por los callbacks, p. ej. con SQL crudo.
+func EnsureSameTenant(db *gorm.DB, table string, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tenantID, ok := pkgtypes.TenantIDFromContext(db.Statement.Context)
+	if !ok {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required", nil)
+	}
+	var foreign int64
+	err := db.Session(&gorm.Session{NewDB: true}).
+		Table(table).
+		Where("id IN ? AND "+TenantColumn+" <> ?", ids, tenantID).
+		Count(&foreign).Error
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to check %s ownership", table), err)
+	}
+	if foreign > 0 {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, fmt.Sprintf("%s belong to another organization", table), nil)
+	}
+	return nil
+}
+
+func tenantField(db *gorm.DB) *schema.Field {
+	if db.Statement.Schema == nil {
+		return nil
+	}
+	return db.Statement.Schema.LookUpField(TenantColumn)
+}
+
+func tenantFromStatement(db *gorm.DB) (int64, bool) {
+	tenantID, ok := pkgtypes.TenantIDFromContext(db.Statement.Context)
+	if !ok {
+		_ = db.AddError(pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required", nil))
+	}
+	return tenantID, ok
+}
+
+func scopeTenant(db *gorm.DB) {
+	if db.Error != nil || tenantField(db) == nil || pkgtypes.TenantScopeSkipped(db.Statement.Context) {
+		return
+	}
+	tenantID, ok := tenantFromStatement(db)
+	if !ok {
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: TenantColumn}, Value: tenantID},
+	}})
+}
+
+func stampTenant(db *gorm.DB) {
+	field := tenantField(db)
+	if db.Error != nil || field == nil || pkgtypes.TenantScopeSkipped(db.Statement.Context) {
+		return
+	}
+	tenantID, ok := tenantFromStatement(db)
+	if !ok {
+		return
+	}
+
+	ctx := db.Statement.Context
+	stamp := func(rv reflect.Value) {
+		current, isZero := field.ValueOf(ctx, rv)
+		if !isZero {
+			if owner, _ := current.(int64); owner != tenantID {
+				_ = db.AddError(pkgtypes.NewError(pkgtypes.ErrAuthorization, "cannot create resources for another organization", nil))
+			}
+			return
+		}
+		if err := field.Set(ctx, rv, tenantID); err != nil {
+			_ = db.AddError(err)
+		}
+	}
+
+	rv := db.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			stamp(reflect.Indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		stamp(rv)
+	}
+}
@@ -0,0 +1,165 @@
+package pkggorm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+type lot struct {
+	ID   int64
+	Name string
+	TenantScoped
+}
+
+func newTenantDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	require.NoError(t, RegisterTenantScope(db))
+	return db, mock
+}
+
+func TestTenantScope(t *testing.T) {
+	tenant7 := pkgtypes.WithTenantID(context.Background(), 7)
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "reads are filtered by the tenant in the context",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "lots" WHERE id = \$1 AND "lots"."tenant_id" = \$2`).
+					WithArgs(5, 7).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}).AddRow(5, "north", 7))
+
+				var got []lot
+				require.NoError(t, db.WithContext(tenant7).Where("id = ?", 5).Find(&got).Error)
+				assert.Len(t, got, 1)
+			},
+		},
+		{
+			name: "a row of another tenant is reported as cross-tenant",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "lots" WHERE id = \$1 AND "lots"."tenant_id" = \$2 ORDER BY "lots"."id" LIMIT \$3`).
+					WithArgs(5, 7, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}))
+				mock.ExpectQuery(`SELECT "tenant_id" FROM "lots" WHERE id = \$1 LIMIT \$2`).
+					WithArgs(5, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).AddRow(3))
+
+				var got lot
+				err := db.WithContext(tenant7).Where("id = ?", 5).First(&got).Error
+				require.True(t, errors.Is(err, gorm.ErrRecordNotFound), "unexpected error: %v", err)
+
+				err = CrossTenantError(db.WithContext(tenant7), &lot{}, 5)
+				assert.True(t, pkgtypes.IsAuthorizationError(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "a missing row is not cross-tenant",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT "tenant_id" FROM "lots" WHERE id = \$1 LIMIT \$2`).
+					WithArgs(5, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}))
+
+				assert.NoError(t, CrossTenantError(db.WithContext(tenant7), &lot{}, 5))
+			},
+		},
+		{
+			name: "creates are stamped with the tenant",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO "lots" \("name","tenant_id"\) VALUES \(\$1,\$2\) RETURNING "id"`).
+					WithArgs("north", 7).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				created := lot{Name: "north"}
+				require.NoError(t, db.WithContext(tenant7).Create(&created).Error)
+				assert.Equal(t, int64(7), created.TenantID)
+			},
+		},
+		{
+			name: "creates for another tenant are rejected",
+			run: func(t *testing.T, db *gorm.DB, _ sqlmock.Sqlmock) {
+				err := db.WithContext(tenant7).Create(&[]lot{{Name: "north"}, {Name: "south", TenantScoped: TenantScoped{TenantID: 3}}}).Error
+				assert.True(t, pkgtypes.IsAuthorizationError(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "updates only touch rows of the tenant",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE "lots" SET "name"=\$1 WHERE "lots"."tenant_id" = \$2 AND "id" = \$3`).
+					WithArgs("south", 7, 5).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				result := db.WithContext(tenant7).Model(&lot{ID: 5}).Update("name", "south")
+				require.NoError(t, result.Error)
+				assert.Zero(t, result.RowsAffected)
+			},
+		},
+		{
+			name: "deletes only touch rows of the tenant",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM "lots" WHERE id = \$1 AND "lots"."tenant_id" = \$2`).
+					WithArgs(5, 7).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				require.NoError(t, db.WithContext(tenant7).Where("id = ?", 5).Delete(&lot{}).Error)
+			},
+		},
+		{
+			name: "a missing tenant fails without querying",
+			run: func(t *testing.T, db *gorm.DB, _ sqlmock.Sqlmock) {
+				ctx := context.Background()
+				var got []lot
+				assert.True(t, pkgtypes.IsAuthorizationError(db.WithContext(ctx).Find(&got).Error))
+				assert.True(t, pkgtypes.IsAuthorizationError(db.WithContext(ctx).Create(&lot{Name: "north"}).Error))
+				assert.True(t, pkgtypes.IsAuthorizationError(db.WithContext(ctx).Model(&lot{ID: 5}).Update("name", "south").Error))
+				assert.True(t, pkgtypes.IsAuthorizationError(db.WithContext(ctx).Delete(&lot{}, 5).Error))
+			},
+		},
+		{
+			name: "internal processes can skip the scope",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "lots"$`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}))
+
+				var got []lot
+				assert.NoError(t, db.WithContext(pkgtypes.WithoutTenantScope(context.Background())).Find(&got).Error)
+			},
+		},
+		{
+			name: "references to rows of another tenant are rejected",
+			run: func(t *testing.T, db *gorm.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "lots" WHERE id IN \(\$1,\$2\) AND tenant_id <> \$3`).
+					WithArgs(1, 2, 7).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+				err := EnsureSameTenant(db.WithContext(tenant7), "lots", 1, 2)
+				assert.True(t, pkgtypes.IsAuthorizationError(err), "unexpected error: %v", err)
+				assert.True(t, pkgtypes.IsAuthorizationError(EnsureSameTenant(db.WithContext(context.Background()), "lots", 1)))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newTenantDB(t)
+			tt.run(t, db, mock)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package pkgpostgresql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -12,6 +13,11 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	_ "github.com/jackc/pgx/v4/stdlib"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 var (
@@ -27,13 +33,15 @@ type repository struct {
 
 func newRepository(c Config) (Repository, error) {
 	once.Do(func() {
-		instance = &repository{
+		repo := &repository{
 			config: c,
 		}
-		initError = instance.Connect(c)
+		initError = repo.Connect(c)
 		if initError != nil {
 			instance = nil
 		} else {
+			instance = repo
+			pkghealth.Register("postgres", repo.ping)
 			log.Printf("Postgres successfully connected to database: %s", c.GetDbName())
 		}
 	})
@@ -70,7 +78,14 @@ func (r *repository) Pool() *pgxpool.Pool {
 	return r.pool
 }
 
-func (r *repository) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+// ping verifica el pool para /readyz.
+func (r *repository) ping(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+func (r *repository) SelectContext(ctx context.Context, dest any, query string, args ...any) (err error) {
+	ctx, span := startQuerySpan(ctx, "select", query)
+	defer func() { pkgtracing.End(span, err) }()
 	return pgxscan.Select(ctx, r.pool, dest, query, args...)
 }
 
@@ -120,6 +135,34 @@ func ConnectPool(connString string) (*pgxpool.Pool, error) {
 	return nil, fmt.Errorf("failed to connect after %d attempts: %w", maxRetries, err)
 }
 
+// QueryRowContext ejecuta la query al hacer Scan, así que el span termina ahí.
 func (r *repository) QueryRowContext(ctx context.Context, query string, args ...any) pgx.Row {
-	return r.pool.QueryRow(ctx, query, args...)
+	ctx, span := startQuerySpan(ctx, "query_row", query)
+	return &tracedRow{row: r.pool.QueryRow(ctx, query, args...), span: span}
+}
+
+func startQuerySpan(ctx context.Context, operation, query string) (context.Context, trace.Span) {
+	return pkgtracing.Tracer().Start(ctx, "db."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+type tracedRow struct {
+	row  pgx.Row
+	span trace.Span
+}
+
+func (r *tracedRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		pkgtracing.End(r.span, nil)
+	} else {
+		pkgtracing.End(r.span, err)
+	}
+	return err
 }
@@ -1,15 +1,62 @@
 package ginadapter
 
 import (
+	"net/http"
+
 	gin "github.com/gin-gonic/gin"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
 
 	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
 )
 
-// SetupSwagger configura Swagger en un router Gin
-func SetupSwagger(engine *gin.Engine, service pkgswagger.Service) error {
+// Paths que registra el adapter.
+const (
+	SpecPath = "/swagger.json"
+	UIPath   = "/api-docs"
+)
+
+// swaggerUI carga Swagger UI 5 (el primero que soporta OpenAPI 3.1) desde el CDN;
+// los archivos de swaggo/files son de la 4.x y no renderizan 3.1.
+const swaggerUI = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>API docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({
+        url: "` + SpecPath + `",
+        dom_id: "#swagger-ui",
+        docExpansion: "none",
+        defaultModelsExpandDepth: -1,
+      });
+    };
+  </script>
+</body>
+</html>`
+
+// SetupSwagger genera el documento OpenAPI con las rutas ya registradas en
+// engine y, si Swagger está habilitado, publica el spec y la UI. Debe llamarse
+// después de registrar todas las rutas de la aplicación. Con opts.Strict falla
+// si alguna ruta no está documentada, aunque Swagger esté deshabilitado.
+func SetupSwagger(engine *gin.Engine, service pkgswagger.Service, opts pkgswagger.BuildOptions) error {
+	var routes []pkgswagger.Route
+	for _, route := range engine.Routes() {
+		routes = append(routes, pkgswagger.Route{
+			Method:  route.Method,
+			Path:    route.Path,
+			Handler: route.Handler,
+		})
+	}
+	opts.Ignore = append(opts.Ignore, SpecPath, "/swagger/*", UIPath)
+	if err := service.Build(routes, opts); err != nil {
+		return err
+	}
+
 	// Primero configura las rutas base usando el servicio Swagger
 	addRoute := func(config pkgswagger.HandlerConfig) {
 		handler := gin.WrapH(config.Handler)
@@ -20,12 +67,11 @@ func SetupSwagger(engine *gin.Engine, service pkgswagger.Service) error {
 		return err
 	}
 
-	// Usa una ruta más específica
-	engine.GET("/api-docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
-		ginSwagger.URL("/api-docs/doc.json"),
-		ginSwagger.DefaultModelsExpandDepth(-1),
-		ginSwagger.DocExpansion("none"),
-	))
+	if service.GetConfig().IsEnabled() {
+		engine.GET(UIPath, func(c *gin.Context) {
+			c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUI))
+		})
+	}
 
 	return nil
 }
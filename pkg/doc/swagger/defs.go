@@ -9,10 +9,49 @@ type HandlerConfig struct {
 	Handler http.HandlerFunc
 }
 
+// Route es una ruta registrada en el router. Handler es el nombre de la función
+// (runtime.FuncForPC), el mismo que reporta gin en RoutesInfo.
+type Route struct {
+	Method  string
+	Path    string
+	Handler string
+}
+
+// Operation documenta las rutas de un handler; se asocia con Describe.
+type Operation struct {
+	Summary     string
+	Description string
+	// Tags agrupa la operación en la UI; por defecto, el paquete del handler.
+	Tags []string
+	// Parameters agrega parámetros de query o header y tipa los de path, que
+	// sin declarar se documentan como string.
+	Parameters []Parameter
+	// Request es el DTO del body JSON. POST, PUT y PATCH lo exigen salvo NoBody.
+	Request any
+	NoBody  bool
+	// Responses asocia cada status con su DTO; un DTO nil documenta una
+	// respuesta sin body. Los errores usan Problem (RFC 7807) como respuesta default.
+	Responses map[int]any
+}
+
+// BuildOptions ajusta la generación del documento a la aplicación.
+type BuildOptions struct {
+	// Ignore lista paths que no se documentan (probes, métricas). Un path que
+	// termina en * ignora todo lo que empiece con ese prefijo.
+	Ignore []string
+	// SecuritySchemes se publican en components; Security elige cuáles exige cada ruta.
+	SecuritySchemes map[string]*SecurityScheme
+	Security        func(Route) []string
+	// Strict hace fallar Build si hay rutas sin documentar, en lugar de sólo loguearlas.
+	Strict bool
+}
+
 // Service define las operaciones disponibles para el servicio Swagger
 type Service interface {
 	// Setup configura Swagger en el router proporcionado
 	Setup(AddRoute func(HandlerConfig)) error
+	// Build genera el documento OpenAPI a partir de las rutas registradas.
+	Build(routes []Route, opts BuildOptions) error
 	GetConfig() Config
 }
 
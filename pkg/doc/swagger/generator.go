@@ -0,0 +1,207 @@
+package pkgswagger
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// Generate arma el documento OpenAPI con las rutas que tienen una Operation
+// (Describe). Retorna también las rutas sin documentar, con el motivo.
+func Generate(config Config, routes []Route, opts BuildOptions) (*Document, []string) {
+	doc := &Document{
+		OpenAPI: OpenAPIVersion,
+		Info: Info{
+			Title:       config.GetTitle(),
+			Description: config.GetDescription(),
+			Version:     config.GetVersion(),
+		},
+		Paths: make(map[string]map[string]*OpSpec),
+		Components: Components{
+			SecuritySchemes: opts.SecuritySchemes,
+		},
+	}
+	for _, scheme := range config.GetSchemes() {
+		if scheme = strings.TrimSpace(scheme); scheme != "" {
+			url := scheme + "://" + config.GetHost() + strings.TrimSuffix(config.GetBasePath(), "/")
+			doc.Servers = append(doc.Servers, Server{URL: url})
+		}
+	}
+
+	sorted := append([]Route(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	schemas := newSchemas()
+	errorSchema := schemas.of(pkgtypes.Problem{})
+	operationIDs := make(map[string]int)
+	tags := make(map[string]bool)
+	var undocumented []string
+
+	for _, route := range sorted {
+		if ignored(route.Path, opts.Ignore) {
+			continue
+		}
+		op, ok := lookup(route.Handler)
+		if !ok {
+			undocumented = append(undocumented, fmt.Sprintf("%s %s (%s): not described", route.Method, route.Path, route.Handler))
+			continue
+		}
+		if needsBody(route.Method) && op.Request == nil && !op.NoBody {
+			undocumented = append(undocumented, fmt.Sprintf("%s %s (%s): no request DTO", route.Method, route.Path, route.Handler))
+			continue
+		}
+		if len(op.Responses) == 0 {
+			undocumented = append(undocumented, fmt.Sprintf("%s %s (%s): no response DTO", route.Method, route.Path, route.Handler))
+			continue
+		}
+
+		path, pathParams := openAPIPath(route.Path)
+		spec := &OpSpec{
+			OperationID: operationID(route.Handler, operationIDs),
+			Summary:     op.Summary,
+			Description: op.Description,
+			Tags:        op.Tags,
+			Parameters:  parameters(pathParams, op.Parameters),
+			Responses:   make(map[string]*Response),
+		}
+		if len(spec.Tags) == 0 {
+			spec.Tags = []string{handlerPackage(route.Handler)}
+		}
+		for _, tag := range spec.Tags {
+			tags[tag] = true
+		}
+		if op.Request != nil {
+			spec.RequestBody = &RequestBody{
+				Required: true,
+				Content:  map[string]MediaType{"application/json": {Schema: schemas.of(op.Request)}},
+			}
+		}
+		for status, body := range op.Responses {
+			response := &Response{Description: http.StatusText(status)}
+			if body != nil {
+				response.Content = map[string]MediaType{"application/json": {Schema: schemas.of(body)}}
+			}
+			spec.Responses[strconv.Itoa(status)] = response
+		}
+		spec.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     map[string]MediaType{pkgtypes.ProblemContentType: {Schema: errorSchema}},
+		}
+		if opts.Security != nil {
+			for _, name := range opts.Security(route) {
+				spec.Security = append(spec.Security, map[string][]string{name: {}})
+			}
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*OpSpec)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = spec
+	}
+
+	doc.Components.Schemas = schemas.components
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+	return doc, undocumented
+}
+
+// build genera el documento y aplica Strict sobre las rutas sin documentar.
+func build(config Config, routes []Route, opts BuildOptions) (*Document, error) {
+	doc, undocumented := Generate(config, routes, opts)
+	if len(undocumented) == 0 {
+		return doc, nil
+	}
+	if opts.Strict {
+		return nil, fmt.Errorf("%d routes without OpenAPI documentation:\n  %s", len(undocumented), strings.Join(undocumented, "\n  "))
+	}
+	for _, route := range undocumented {
+		log.Printf("[OpenAPI] route left out of the spec: %s", route)
+	}
+	return doc, nil
+}
+
+func ignored(path string, ignore []string) bool {
+	for _, pattern := range ignore {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(path, prefix) {
+			return true
+		}
+		if pattern == path {
+			return true
+		}
+	}
+	return false
+}
+
+func needsBody(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// openAPIPath convierte /crops/:id y /files/*path al formato {id} de OpenAPI y
+// retorna los nombres de los parámetros.
+func openAPIPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	var params []string
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// parameters documenta los parámetros de path (string salvo que la Operation
+// los tipe) y agrega los de query y header declarados.
+func parameters(pathParams []string, declared []Parameter) []Parameter {
+	var params []Parameter
+	for _, name := range pathParams {
+		param := Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}}
+		for _, d := range declared {
+			if d.In == "path" && d.Name == name {
+				param = d
+			}
+		}
+		params = append(params, param)
+	}
+	for _, d := range declared {
+		if d.In != "path" {
+			params = append(params, d)
+		}
+	}
+	return params
+}
+
+// operationID usa paquete.Método del handler; si el handler atiende varias
+// rutas se numeran para que el ID siga siendo único.
+func operationID(handler string, seen map[string]int) string {
+	id := handlerPackage(handler) + "." + handlerMethod(handler)
+	seen[id]++
+	if n := seen[id]; n > 1 {
+		return id + strconv.Itoa(n)
+	}
+	return id
+}
+
+// handlerPackage extrae "crop" de ".../internal/crop.(*Handler).CreateCrop-fm".
+func handlerPackage(handler string) string {
+	name := handler[strings.LastIndex(handler, "/")+1:]
+	pkg, _, _ := strings.Cut(name, ".")
+	return pkg
+}
+
+func handlerMethod(handler string) string {
+	name := strings.TrimSuffix(handler, "-fm")
+	return name[strings.LastIndex(name, ".")+1:]
+}
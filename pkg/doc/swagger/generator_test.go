@@ -0,0 +1,175 @@
+package pkgswagger
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createLot struct {
+	Name     string  `json:"name"`
+	Hectares float64 `json:"hectares"`
+}
+
+type lot struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type lotHandler struct{}
+
+func (h *lotHandler) CreateLot(http.ResponseWriter, *http.Request) {}
+func (h *lotHandler) GetLot(http.ResponseWriter, *http.Request)    {}
+func (h *lotHandler) DeleteLot(http.ResponseWriter, *http.Request) {}
+func (h *lotHandler) Archive(http.ResponseWriter, *http.Request)   {}
+func (h *lotHandler) Import(http.ResponseWriter, *http.Request)    {}
+func (h *lotHandler) Export(http.ResponseWriter, *http.Request)    {}
+
+func describeLots(h *lotHandler) {
+	Describe(h.CreateLot, Operation{
+		Summary:   "Create a lot",
+		Request:   createLot{},
+		Responses: map[int]any{http.StatusCreated: lot{}},
+	})
+	Describe(h.GetLot, Operation{
+		Summary:    "Get a lot",
+		Parameters: []Parameter{PathParam("id", "integer", "Lot ID"), QueryParam("fields", "string", "", false)},
+		Responses:  map[int]any{http.StatusOK: lot{}},
+	})
+	Describe(h.DeleteLot, Operation{
+		Tags:      []string{"lots"},
+		Responses: map[int]any{http.StatusNoContent: nil},
+	})
+	Describe(h.Archive, Operation{NoBody: true, Responses: map[int]any{http.StatusNoContent: nil}})
+	Describe(h.Import, Operation{Responses: map[int]any{http.StatusAccepted: nil}})
+}
+
+func testConfig() Config {
+	return newConfig("Lots", "", "1.0", "api.example.com", "/", []string{"https"}, true)
+}
+
+func TestGenerate(t *testing.T) {
+	h := &lotHandler{}
+	describeLots(h)
+	routes := []Route{
+		{Method: http.MethodPost, Path: "/lots", Handler: HandlerName(h.CreateLot)},
+		{Method: http.MethodGet, Path: "/lots/:id", Handler: HandlerName(h.GetLot)},
+		{Method: http.MethodDelete, Path: "/lots/:id", Handler: HandlerName(h.DeleteLot)},
+		// The same handler on two routes keeps unique operation IDs.
+		{Method: http.MethodPost, Path: "/lots/:id/archive", Handler: HandlerName(h.Archive)},
+		{Method: http.MethodPost, Path: "/v2/lots/:id/archive", Handler: HandlerName(h.Archive)},
+		{Method: http.MethodGet, Path: "/livez", Handler: HandlerName(h.Export)},
+		{Method: http.MethodGet, Path: "/internal/debug", Handler: HandlerName(h.Export)},
+	}
+
+	doc, undocumented := Generate(testConfig(), routes, BuildOptions{
+		Ignore: []string{"/livez", "/internal/*"},
+		SecuritySchemes: map[string]*SecurityScheme{
+			"bearerAuth": {Type: "http", Scheme: "bearer"},
+		},
+		Security: func(route Route) []string {
+			if route.Method == http.MethodDelete {
+				return []string{"bearerAuth"}
+			}
+			return nil
+		},
+	})
+
+	assert.Empty(t, undocumented)
+	assert.Equal(t, OpenAPIVersion, doc.OpenAPI)
+	assert.Equal(t, []Server{{URL: "https://api.example.com"}}, doc.Servers)
+	assert.ElementsMatch(t, []string{"/lots", "/lots/{id}", "/lots/{id}/archive", "/v2/lots/{id}/archive"}, keys(doc.Paths))
+
+	create := doc.Paths["/lots"]["post"]
+	require.NotNil(t, create)
+	assert.Equal(t, "swagger.CreateLot", create.OperationID)
+	assert.Equal(t, []string{"swagger"}, create.Tags)
+	assert.Equal(t, "#/components/schemas/swagger.createLot", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/swagger.lot", create.Responses["201"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, create.Responses["default"].Content, "application/problem+json")
+	assert.Empty(t, create.Security)
+
+	get := doc.Paths["/lots/{id}"]["get"]
+	require.NotNil(t, get)
+	require.Len(t, get.Parameters, 2)
+	assert.Equal(t, Parameter{Name: "id", In: "path", Description: "Lot ID", Required: true, Schema: &Schema{Type: "integer"}}, get.Parameters[0])
+	assert.Equal(t, "query", get.Parameters[1].In)
+
+	del := doc.Paths["/lots/{id}"]["delete"]
+	require.NotNil(t, del)
+	assert.Equal(t, []string{"lots"}, del.Tags)
+	assert.Nil(t, del.Responses["204"].Content)
+	assert.Equal(t, []map[string][]string{{"bearerAuth": {}}}, del.Security)
+	assert.Equal(t, "string", del.Parameters[0].Schema.Type, "undeclared path params are strings")
+
+	assert.Equal(t, "swagger.Archive", doc.Paths["/lots/{id}/archive"]["post"].OperationID)
+	assert.Equal(t, "swagger.Archive2", doc.Paths["/v2/lots/{id}/archive"]["post"].OperationID)
+
+	assert.Contains(t, doc.Components.Schemas, "swagger.createLot")
+	assert.Contains(t, doc.Components.Schemas, "swagger.lot")
+	assert.Equal(t, []Tag{{Name: "lots"}, {Name: "swagger"}}, doc.Tags)
+}
+
+func TestGenerateReportsUndocumentedRoutes(t *testing.T) {
+	h := &lotHandler{}
+	describeLots(h)
+
+	tests := []struct {
+		name  string
+		route Route
+		want  string
+	}{
+		{name: "not described", route: Route{Method: http.MethodGet, Path: "/lots/export", Handler: HandlerName(h.Export)}, want: "not described"},
+		{name: "write without request DTO", route: Route{Method: http.MethodPost, Path: "/lots/import", Handler: HandlerName(h.Import)}, want: "no request DTO"},
+		{name: "reads need no request DTO", route: Route{Method: http.MethodGet, Path: "/lots/archived", Handler: HandlerName(h.Import)}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, undocumented := Generate(testConfig(), []Route{tt.route}, BuildOptions{})
+			if tt.want == "" {
+				assert.Empty(t, undocumented)
+				assert.Len(t, doc.Paths, 1)
+				return
+			}
+			require.Len(t, undocumented, 1)
+			assert.Contains(t, undocumented[0], tt.route.Path)
+			assert.Contains(t, undocumented[0], tt.want)
+			assert.Empty(t, doc.Paths)
+		})
+	}
+}
+
+func TestGenerateRequiresResponses(t *testing.T) {
+	handler := func(http.ResponseWriter, *http.Request) {}
+	Describe(handler, Operation{Summary: "No responses"})
+
+	_, undocumented := Generate(testConfig(), []Route{{Method: http.MethodGet, Path: "/lots", Handler: HandlerName(handler)}}, BuildOptions{})
+
+	require.Len(t, undocumented, 1)
+	assert.Contains(t, undocumented[0], "no response DTO")
+}
+
+func TestBuildStrict(t *testing.T) {
+	h := &lotHandler{}
+	routes := []Route{{Method: http.MethodGet, Path: "/lots/export", Handler: HandlerName(h.Export)}}
+
+	doc, err := build(testConfig(), routes, BuildOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, doc.Paths)
+
+	_, err = build(testConfig(), routes, BuildOptions{Strict: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 routes without OpenAPI documentation")
+	assert.Contains(t, err.Error(), "GET /lots/export")
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package pkgswagger
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+var (
+	operationsMu sync.RWMutex
+	operations   = make(map[string]Operation)
+)
+
+// Describe documenta las rutas que atiende handler (p. ej. h.CreateCrop). Se
+// asocia por el nombre de la función, así que alcanza con describir el handler
+// una vez aunque se registre en varios grupos.
+func Describe(handler any, op Operation) {
+	operationsMu.Lock()
+	defer operationsMu.Unlock()
+	operations[HandlerName(handler)] = op
+}
+
+// HandlerName retorna el nombre de la función tal como lo reporta gin en RoutesInfo.
+func HandlerName(handler any) string {
+	return runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+}
+
+func lookup(handler string) (Operation, bool) {
+	operationsMu.RLock()
+	defer operationsMu.RUnlock()
+	op, ok := operations[handler]
+	return op, ok
+}
+
+// PathParam tipa un parámetro de path (por defecto string).
+func PathParam(name, typ, description string) Parameter {
+	return Parameter{Name: name, In: "path", Description: description, Required: true, Schema: &Schema{Type: typ}}
+}
+
+// QueryParam documenta un parámetro de query.
+func QueryParam(name, typ, description string, required bool) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Required: required, Schema: &Schema{Type: typ}}
+}
+
+// HeaderParam documenta un header de la request.
+func HeaderParam(name, description string, required bool) Parameter {
+	return Parameter{Name: name, In: "header", Description: description, Required: required, Schema: &Schema{Type: "string"}}
+}
@@ -0,0 +1,281 @@
+package pkgswagger
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemas arma los schemas de los DTOs por reflexión y guarda los structs con
+// nombre en components, referenciados con $ref.
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// of retorna el schema de value; nil si value es nil.
+func (s *schemas) of(value any) *Schema {
+	if value == nil {
+		return nil
+	}
+	return s.schema(reflect.TypeOf(value))
+}
+
+func (s *schemas) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return s.ref(t)
+	default:
+		// interface{} y any aceptan cualquier valor JSON.
+		return &Schema{}
+	}
+}
+
+// ref registra el struct en components (una sola vez) y retorna su $ref.
+func (s *schemas) ref(t reflect.Type) *Schema {
+	name, ok := s.names[t]
+	if !ok {
+		name = s.componentName(t)
+		s.names[t] = name
+		// Se reserva el nombre antes de recorrer los campos por si el tipo es recursivo.
+		s.components[name] = &Schema{}
+		*s.components[name] = *s.object(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName usa el módulo como prefijo, porque todos los paquetes de DTOs
+// se llaman dto y los de entidades domain:
+// .../internal/crop/handler/dto.CreateCrop -> crop.CreateCrop
+// .../internal/crop/usecases/domain.Crop   -> crop.domain.Crop
+func (s *schemas) componentName(t reflect.Type) string {
+	segments := strings.Split(t.PkgPath(), "/")
+	last := len(segments) - 1
+	prefix := segments[last]
+	switch {
+	case prefix == "dto" && last >= 2 && segments[last-1] == "handler":
+		prefix = segments[last-2]
+	case prefix == "domain" && last >= 2 && segments[last-1] == "usecases":
+		prefix = segments[last-2] + ".domain"
+	}
+	name := prefix + "." + t.Name()
+	// Dos tipos distintos con el mismo nombre corto no deben pisarse.
+	for i := 2; ; i++ {
+		if _, taken := s.components[name]; !taken {
+			return name
+		}
+		name = prefix + "." + t.Name() + strconv.Itoa(i)
+	}
+}
+
+// object recorre los campos exportados como lo hace encoding/json: respeta el
+// tag json y aplana los structs embebidos sin tag.
+func (s *schemas) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	s.fields(t, schema)
+	return schema
+}
+
+func (s *schemas) fields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				s.fields(embedded, schema)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := s.schema(field.Type)
+		required := applyBinding(property, field.Tag.Get("binding"))
+		if description := field.Tag.Get("description"); description != "" {
+			property = withDescription(property, description)
+		}
+		if strings.Contains(opts, "string") && property.Ref == "" {
+			property.Type, property.Format = "string", ""
+		}
+		schema.Properties[name] = property
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// withDescription agrega la descripción del tag. En 3.1 $ref admite propiedades
+// hermanas, así que no hace falta envolverlo en allOf.
+func withDescription(schema *Schema, description string) *Schema {
+	clone := *schema
+	clone.Description = description
+	return &clone
+}
+
+// applyBinding traduce las reglas de validación de gin (go-playground/validator)
+// a JSON Schema. Las reglas después de dive aplican a los items. Retorna si el
+// campo es requerido.
+func applyBinding(schema *Schema, binding string) bool {
+	if binding == "" {
+		return false
+	}
+	required := false
+	target := schema
+	for _, rule := range strings.Split(binding, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			if target == schema {
+				required = true
+			}
+		case "dive":
+			if target.Items == nil {
+				return required
+			}
+			target = target.Items
+		case "email":
+			target.Format = "email"
+		case "url", "uri":
+			target.Format = "uri"
+		case "uuid", "uuid4":
+			target.Format = "uuid"
+		case "datetime":
+			target.Format = "date-time"
+		case "oneof":
+			for _, option := range strings.Fields(value) {
+				target.Enum = append(target.Enum, option)
+			}
+		case "min", "gte":
+			setBound(target, value, boundMin)
+		case "max", "lte":
+			setBound(target, value, boundMax)
+		case "gt":
+			setBound(target, value, boundExclusiveMin)
+		case "lt":
+			setBound(target, value, boundExclusiveMax)
+		case "len":
+			setBound(target, value, boundMin)
+			setBound(target, value, boundMax)
+		}
+	}
+	return required
+}
+
+type bound int
+
+const (
+	boundMin bound = iota
+	boundMax
+	boundExclusiveMin
+	boundExclusiveMax
+)
+
+// setBound aplica un límite según el tipo: largo en strings, cantidad de items
+// en arrays y valor en números, igual que validator.
+func setBound(schema *Schema, raw string, kind bound) {
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return
+	}
+	switch schema.Type {
+	case "string":
+		length := int(n)
+		switch kind {
+		case boundMin:
+			schema.MinLength = &length
+		case boundMax:
+			schema.MaxLength = &length
+		case boundExclusiveMin:
+			length++
+			schema.MinLength = &length
+		case boundExclusiveMax:
+			length--
+			schema.MaxLength = &length
+		}
+	case "array":
+		count := int(n)
+		switch kind {
+		case boundMin:
+			schema.MinItems = &count
+		case boundMax:
+			schema.MaxItems = &count
+		case boundExclusiveMin:
+			count++
+			schema.MinItems = &count
+		case boundExclusiveMax:
+			count--
+			schema.MaxItems = &count
+		}
+	case "integer", "number":
+		switch kind {
+		case boundMin:
+			schema.Minimum = &n
+		case boundMax:
+			schema.Maximum = &n
+		case boundExclusiveMin:
+			schema.ExclusiveMinimum = &n
+		case boundExclusiveMax:
+			schema.ExclusiveMaximum = &n
+		}
+	}
+}
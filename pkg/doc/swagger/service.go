@@ -2,6 +2,7 @@ package pkgswagger
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"path"
 	"sync"
@@ -15,6 +16,9 @@ var (
 
 type service struct {
 	config Config
+
+	mu   sync.RWMutex
+	spec []byte
 }
 
 func newService(config Config) (Service, error) {
@@ -56,21 +60,35 @@ func (s *service) Setup(addRoute func(HandlerConfig)) error {
 	return nil
 }
 
+// Build genera el documento una vez, al arrancar, con las rutas ya registradas.
+func (s *service) Build(routes []Route, opts BuildOptions) error {
+	doc, err := build(s.config, routes, opts)
+	if err != nil {
+		return err
+	}
+	spec, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode OpenAPI document: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spec = spec
+	return nil
+}
+
 func (s *service) serveSwaggerSpec(w http.ResponseWriter, r *http.Request) {
-	spec := map[string]any{
-		"swagger": "2.0",
-		"info": map[string]any{
-			"title":       s.config.GetTitle(),
-			"description": s.config.GetDescription(),
-			"version":     s.config.GetVersion(),
-		},
-		"host":     s.config.GetHost(),
-		"basePath": s.config.GetBasePath(),
-		"schemes":  s.config.GetSchemes(),
+	s.mu.RLock()
+	spec := s.spec
+	s.mu.RUnlock()
+
+	// Sin Build se publica un documento válido sin rutas.
+	if spec == nil {
+		doc, _ := Generate(s.config, nil, BuildOptions{})
+		spec, _ = json.Marshal(doc)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(spec)
+	w.Write(spec)
 }
 
 func (s *service) serveSwaggerUI(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,32 @@
+package pkghealth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LivenessHandler responde 200 mientras el proceso atienda requests. No consulta
+// dependencias: una base caída no se arregla reiniciando el pod.
+func LivenessHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status":    StatusOK,
+			"timestamp": time.Now(),
+		})
+	}
+}
+
+// ReadinessHandler responde con el estado de cada dependencia del registry:
+// 503 si falla alguna crítica, 200 si están todas o sólo fallan no críticas.
+func ReadinessHandler(registry *Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := registry.Check(c.Request.Context())
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+		c.JSON(code, report)
+	}
+}
@@ -0,0 +1,237 @@
+package pkghealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Valores por defecto del registry.
+const (
+	DefaultTimeout  = 2 * time.Second
+	DefaultCacheTTL = 2 * time.Second
+)
+
+// Estados de un chequeo y del reporte.
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+)
+
+// Check verifica una dependencia; retorna nil si está disponible.
+type Check func(ctx context.Context) error
+
+// Option configura una dependencia al registrarla.
+type Option func(*dependency)
+
+// NonCritical marca la dependencia como no crítica: si falla, /readyz responde
+// "degraded" pero sigue aceptando tráfico.
+func NonCritical() Option {
+	return func(d *dependency) { d.critical = false }
+}
+
+// WithTimeout reemplaza el timeout del registry para esta dependencia.
+func WithTimeout(timeout time.Duration) Option {
+	return func(d *dependency) {
+		if timeout > 0 {
+			d.timeout = timeout
+		}
+	}
+}
+
+// Result es el estado de una dependencia.
+type Result struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	LatencyMs float64   `json:"latency_ms"`
+	TimeoutMs int64     `json:"timeout_ms"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Report agrupa el resultado de todas las dependencias.
+type Report struct {
+	Status       string    `json:"status"`
+	Timestamp    time.Time `json:"timestamp"`
+	Dependencies []Result  `json:"dependencies"`
+}
+
+// Ready indica si todas las dependencias críticas están disponibles.
+func (r Report) Ready() bool {
+	return r.Status != StatusDown
+}
+
+type dependency struct {
+	name     string
+	check    Check
+	critical bool
+	timeout  time.Duration
+
+	// mu serializa los chequeos: mientras uno corre, el resto espera y reutiliza
+	// su resultado en lugar de golpear la dependencia en paralelo.
+	mu     sync.Mutex
+	result Result
+}
+
+// Registry guarda los chequeos de las dependencias y cachea sus resultados.
+type Registry struct {
+	mu           sync.RWMutex
+	dependencies map[string]*dependency
+	timeout      time.Duration
+	cacheTTL     time.Duration
+}
+
+// NewRegistry crea un registry vacío con los valores por defecto.
+func NewRegistry() *Registry {
+	return &Registry{
+		dependencies: make(map[string]*dependency),
+		timeout:      DefaultTimeout,
+		cacheTTL:     DefaultCacheTTL,
+	}
+}
+
+var defaultRegistry = NewRegistry()
+
+// Default retorna el registry donde se registran los paquetes de pkg.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Register agrega una dependencia al registry por defecto.
+func Register(name string, check Check, opts ...Option) {
+	defaultRegistry.Register(name, check, opts...)
+}
+
+// Register agrega una dependencia crítica, salvo que se indique NonCritical.
+// Registrar otra vez el mismo nombre reemplaza el chequeo anterior.
+func (r *Registry) Register(name string, check Check, opts ...Option) {
+	d := &dependency{name: name, check: check, critical: true}
+	for _, opt := range opts {
+		opt(d)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dependencies[name] = d
+}
+
+// SetTimeout cambia el timeout por defecto de los chequeos; cero mantiene el actual.
+func (r *Registry) SetTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeout = timeout
+}
+
+// SetCacheTTL cambia cuánto se reutiliza un resultado; cero mantiene el actual.
+func (r *Registry) SetCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cacheTTL = ttl
+}
+
+// Check ejecuta en paralelo los chequeos cuyo resultado no esté en cache.
+// El reporte es "down" si falla una dependencia crítica y "degraded" si sólo
+// fallan no críticas.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	dependencies := make([]*dependency, 0, len(r.dependencies))
+	for _, d := range r.dependencies {
+		dependencies = append(dependencies, d)
+	}
+	timeout, cacheTTL := r.timeout, r.cacheTTL
+	r.mu.RUnlock()
+
+	sort.Slice(dependencies, func(i, j int) bool { return dependencies[i].name < dependencies[j].name })
+
+	results := make([]Result, len(dependencies))
+	var wg sync.WaitGroup
+	for i, d := range dependencies {
+		wg.Add(1)
+		go func(i int, d *dependency) {
+			defer wg.Done()
+			results[i] = d.run(ctx, timeout, cacheTTL)
+		}(i, d)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Timestamp: time.Now(), Dependencies: results}
+	for _, result := range results {
+		if result.Status == StatusUp {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusDown
+			break
+		}
+		report.Status = StatusDegraded
+	}
+	return report
+}
+
+func (d *dependency) run(ctx context.Context, timeout, cacheTTL time.Duration) Result {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.result.CheckedAt.IsZero() && time.Since(d.result.CheckedAt) < cacheTTL {
+		return d.result
+	}
+	if d.timeout > 0 {
+		timeout = d.timeout
+	}
+
+	// El resultado queda en cache para otras requests: que el cliente corte la
+	// conexión no debe marcar la dependencia como caída.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := runCheck(ctx, d.check)
+	result := Result{
+		Name:      d.name,
+		Status:    StatusUp,
+		Critical:  d.critical,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		TimeoutMs: timeout.Milliseconds(),
+		CheckedAt: time.Now(),
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+		if errors.Is(err, context.DeadlineExceeded) {
+			result.Error = fmt.Sprintf("timed out after %s", timeout)
+		}
+	}
+	d.result = result
+	return result
+}
+
+// runCheck corta en el timeout aunque el chequeo ignore el contexto, y evita
+// que un panic en el chequeo tire el endpoint.
+func runCheck(ctx context.Context, check Check) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("health check panicked: %v", r)
+			}
+		}()
+		done <- check(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
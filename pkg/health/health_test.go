@@ -0,0 +1,153 @@
+package pkghealth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func up(context.Context) error   { return nil }
+func down(context.Context) error { return errors.New("connection refused") }
+
+func TestRegistryAggregation(t *testing.T) {
+	tests := []struct {
+		name        string
+		critical    Check
+		nonCritical Check
+		wantStatus  string
+		wantReady   bool
+	}{
+		{name: "all up", critical: up, nonCritical: up, wantStatus: StatusOK, wantReady: true},
+		{name: "non-critical down degrades", critical: up, nonCritical: down, wantStatus: StatusDegraded, wantReady: true},
+		{name: "critical down", critical: down, nonCritical: up, wantStatus: StatusDown},
+		{name: "critical down wins over degraded", critical: down, nonCritical: down, wantStatus: StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.Register("postgres", tt.critical)
+			registry.Register("smtp", tt.nonCritical, NonCritical())
+
+			report := registry.Check(context.Background())
+
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Equal(t, tt.wantReady, report.Ready())
+			require.Len(t, report.Dependencies, 2)
+			assert.Equal(t, "postgres", report.Dependencies[0].Name)
+			assert.True(t, report.Dependencies[0].Critical)
+			assert.Equal(t, "smtp", report.Dependencies[1].Name)
+			assert.False(t, report.Dependencies[1].Critical)
+		})
+	}
+}
+
+func TestRegistryTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	// Ignores the context: the registry must give up on its own.
+	stuck := func(context.Context) error {
+		<-release
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		opts        []Option
+		wantTimeout time.Duration
+	}{
+		{name: "registry timeout", wantTimeout: 50 * time.Millisecond},
+		{name: "per dependency timeout", opts: []Option{WithTimeout(20 * time.Millisecond)}, wantTimeout: 20 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.SetTimeout(50 * time.Millisecond)
+			registry.Register("postgres", stuck, tt.opts...)
+
+			start := time.Now()
+			report := registry.Check(context.Background())
+
+			assert.Less(t, time.Since(start), time.Second)
+			assert.Equal(t, StatusDown, report.Status)
+			result := report.Dependencies[0]
+			assert.Equal(t, StatusDown, result.Status)
+			assert.Equal(t, "timed out after "+tt.wantTimeout.String(), result.Error)
+			assert.Equal(t, tt.wantTimeout.Milliseconds(), result.TimeoutMs)
+		})
+	}
+}
+
+func TestRegistryRecoversPanics(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("kafka", func(context.Context) error { panic("nil client") }, NonCritical())
+
+	report := registry.Check(context.Background())
+
+	assert.Equal(t, StatusDegraded, report.Status)
+	assert.Equal(t, "health check panicked: nil client", report.Dependencies[0].Error)
+}
+
+func TestRegistryCachesResults(t *testing.T) {
+	var calls atomic.Int32
+	registry := NewRegistry()
+	registry.SetCacheTTL(time.Hour)
+	registry.Register("postgres", func(context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+
+	registry.Check(context.Background())
+	registry.Check(context.Background())
+	assert.Equal(t, int32(1), calls.Load())
+
+	// A cancelled request does not poison the cached result.
+	registry.Register("redis", up)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report := registry.Check(ctx)
+	assert.Equal(t, StatusOK, report.Status)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		critical   Check
+		wantCode   int
+		wantStatus string
+	}{
+		{name: "ready", critical: up, wantCode: http.StatusOK, wantStatus: StatusDegraded},
+		{name: "not ready", critical: down, wantCode: http.StatusServiceUnavailable, wantStatus: StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.Register("postgres", tt.critical)
+			registry.Register("smtp", down, NonCritical())
+			router := gin.New()
+			router.GET("/readyz", ReadinessHandler(registry))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			var report Report
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Len(t, report.Dependencies, 2)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/go-resty/resty/v2"
+
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 // client implementa la interfaz Client.
@@ -18,6 +20,8 @@ func newClient(config Config) (Client, error) {
 	r := resty.New()
 	r.SetBaseURL(config.GetBaseURL())
 	r.SetTimeout(time.Duration(config.GetTimeout()) * time.Second)
+	// Cada request crea un span y propaga la traza; usar R().SetContext(ctx).
+	r.SetTransport(pkgtracing.NewTransport(nil))
 
 	// Retornamos la nueva instancia del cliente
 	return &client{
@@ -1,8 +1,8 @@
 package pkgmwr
 
 import (
-	"errors"
-	"log"
+	"encoding/json"
+	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,58 +10,67 @@ import (
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 )
 
-// ErrorHandlingMiddleware captures errors added to the context and responds appropriately.
-func ErrorHandlingMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// Log the request method and URL for context.
-		log.Printf("[ErrorHandlingMiddleware] Starting error handling for %s %s", c.Request.Method, c.Request.URL.Path)
+// problemOptionsKey stores the ErrorHandlingMiddleware options in the context so
+// middlewares that abort the request answer the same way.
+const problemOptionsKey = "problem_options"
+
+// ErrorHandlingOptions configures the problem+json error responses.
+type ErrorHandlingOptions struct {
+	// TypeBase prefixes the problem type URIs (default pkgtypes.DefaultProblemTypeBase).
+	TypeBase string
+	// ExposeDetails adds the internal cause of the error to the response. Dev only.
+	ExposeDetails bool
+}
 
+// ErrorHandlingMiddleware captures errors added to the context (c.Error) and
+// responds with an RFC 7807 problem+json body. Handlers should add domain errors
+// (*pkgtypes.Error); any other error is answered as a 500 without its message.
+func ErrorHandlingMiddleware(opts ...ErrorHandlingOptions) gin.HandlerFunc {
+	var options ErrorHandlingOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	problemOptions := pkgtypes.ProblemOptions{
+		TypeBase:      options.TypeBase,
+		ExposeDetails: options.ExposeDetails,
+	}
+
+	return func(c *gin.Context) {
+		c.Set(problemOptionsKey, problemOptions)
 		c.Next() // Process the request.
 
 		// If a response has already been written, do not proceed.
-		if c.Writer.Written() {
+		if c.Writer.Written() || len(c.Errors) == 0 {
 			return
 		}
 
-		// If there are errors in the context, process the first one.
-		if len(c.Errors) > 0 {
-			log.Printf("[ErrorHandlingMiddleware] Found %d error(s)", len(c.Errors))
+		// Take the first error for the response.
+		ginErr := c.Errors[0]
+		slog.WarnContext(c.Request.Context(), "request failed", "error", ginErr.Err, "errors", len(c.Errors))
 
-			// Take the first error for the response.
-			ginErr := c.Errors[0]
-			log.Printf("[ErrorHandlingMiddleware] Error: %v", ginErr.Err)
+		writeProblem(c, pkgtypes.NewProblem(ginErr.Err, c.Request.URL.Path, problemOptions))
+		c.Abort()
+	}
+}
 
-			var status int
-			var response any
+// abortWithError writes err as a problem+json response and stops the chain.
+func abortWithError(c *gin.Context, err error) {
+	writeProblem(c, pkgtypes.NewProblem(err, c.Request.URL.Path, problemOptionsFrom(c)))
+	c.Abort()
+}
 
-			// Check if the error is a domain error (*pkgtypes.Error).
-			var domainErr *pkgtypes.Error
-			if errors.As(ginErr.Err, &domainErr) {
-				apiErr, code := pkgtypes.NewAPIError(domainErr)
-				response = apiErr.ToResponse()
-				status = code
-			} else {
-				// Check if the error is already an API error (*pkgtypes.APIError).
-				var apiErr *pkgtypes.APIError
-				if errors.As(ginErr.Err, &apiErr) {
-					response = apiErr.ToResponse()
-					status = apiErr.Code
-				} else {
-					// For unknown errors, return an internal error with a generic message.
-					response = gin.H{
-						"error":   "INTERNAL_ERROR",
-						"message": "An internal error occurred, please try again later.",
-						"details": ginErr.Err.Error(),
-					}
-					status = http.StatusInternalServerError
-				}
-			}
+func problemOptionsFrom(c *gin.Context) pkgtypes.ProblemOptions {
+	if opts, ok := c.Get(problemOptionsKey); ok {
+		return opts.(pkgtypes.ProblemOptions)
+	}
+	return pkgtypes.ProblemOptions{}
+}
 
-			// Send a JSON response with the appropriate status code.
-			c.JSON(status, response)
-			// Abort further processing.
-			c.Abort()
-			return
-		}
+func writeProblem(c *gin.Context, problem *pkgtypes.Problem) {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
 	}
+	c.Data(problem.Status, pkgtypes.ProblemContentType, body)
 }
@@ -0,0 +1,114 @@
+package pkgmwr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+func TestErrorHandlingMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cause := errors.New("dial tcp 10.0.0.5:5432: connection refused")
+
+	tests := []struct {
+		name       string
+		opts       ErrorHandlingOptions
+		handler    gin.HandlerFunc
+		wantStatus int
+		wantType   string
+		wantDetail string
+		wantDebug  string
+	}{
+		{
+			name:       "domain errors are answered with their status",
+			handler:    func(c *gin.Context) { _ = c.Error(pkgtypes.NewError(pkgtypes.ErrNotFound, "lot not found", cause)) },
+			wantStatus: http.StatusNotFound,
+			wantType:   "/problems/not-found",
+			wantDetail: "lot not found",
+		},
+		{
+			name: "the first error wins",
+			handler: func(c *gin.Context) {
+				_ = c.Error(pkgtypes.NewError(pkgtypes.ErrConflict, "lot already exists", nil))
+				_ = c.Error(pkgtypes.NewError(pkgtypes.ErrInternal, "audit failed", nil))
+			},
+			wantStatus: http.StatusConflict,
+			wantType:   "/problems/conflict",
+			wantDetail: "lot already exists",
+		},
+		{
+			name:       "untyped errors do not leak outside dev",
+			handler:    func(c *gin.Context) { _ = c.Error(cause) },
+			wantStatus: http.StatusInternalServerError,
+			wantType:   "/problems/internal-error",
+			wantDetail: "Internal server error",
+		},
+		{
+			name:       "dev exposes the cause",
+			opts:       ErrorHandlingOptions{ExposeDetails: true},
+			handler:    func(c *gin.Context) { _ = c.Error(cause) },
+			wantStatus: http.StatusInternalServerError,
+			wantType:   "/problems/internal-error",
+			wantDetail: "Internal server error",
+			wantDebug:  cause.Error(),
+		},
+		{
+			name: "middlewares that abort use the configured type base",
+			opts: ErrorHandlingOptions{TypeBase: "https://example.com/problems/"},
+			handler: func(c *gin.Context) {
+				abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthorization, "missing permission lot:write", nil))
+			},
+			wantStatus: http.StatusForbidden,
+			wantType:   "https://example.com/problems/forbidden",
+			wantDetail: "missing permission lot:write",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandlingMiddleware(tt.opts))
+			router.GET("/lots/:id", tt.handler)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/lots/7", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, pkgtypes.ProblemContentType, w.Header().Get("Content-Type"))
+			var problem pkgtypes.Problem
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			assert.Equal(t, tt.wantStatus, problem.Status)
+			assert.Equal(t, tt.wantType, problem.Type)
+			assert.Equal(t, tt.wantDetail, problem.Detail)
+			assert.Equal(t, tt.wantDebug, problem.Debug)
+			assert.Equal(t, "/lots/7", problem.Instance)
+			if tt.wantDebug == "" {
+				assert.NotContains(t, w.Body.String(), "10.0.0.5")
+			}
+		})
+	}
+}
+
+func TestErrorHandlingMiddlewareKeepsWrittenResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandlingMiddleware())
+	router.GET("/lots", func(c *gin.Context) {
+		c.JSON(http.StatusAccepted, gin.H{"queued": true})
+		_ = c.Error(errors.New("notification failed"))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/lots", nil))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.JSONEq(t, `{"queued":true}`, w.Body.String())
+}
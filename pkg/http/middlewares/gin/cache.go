@@ -0,0 +1,179 @@
+package pkgmwr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers used by Cache for conditional requests.
+const (
+	ETagHeader         = "ETag"
+	IfNoneMatchHeader  = "If-None-Match"
+	CacheControlHeader = "Cache-Control"
+)
+
+// CachePolicy is the caching behaviour of a group of routes.
+type CachePolicy struct {
+	// Name identifies the policy in CachePolicies.
+	Name string
+	// CacheControl is set on successful responses that do not set their own.
+	CacheControl string
+	// ETag adds a weak ETag to 200 responses and answers a matching
+	// If-None-Match with 304 Not Modified.
+	ETag bool
+}
+
+// cacheWriter holds the body back so the ETag can be computed before the
+// headers go out. Flush switches it to streaming, without ETag.
+type cacheWriter struct {
+	gin.ResponseWriter
+	body      bytes.Buffer
+	headerNow bool
+	streaming bool
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+func (w *cacheWriter) WriteString(s string) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.body.WriteString(s)
+}
+
+func (w *cacheWriter) WriteHeaderNow() {
+	if w.streaming {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.headerNow = true
+}
+
+func (w *cacheWriter) Written() bool {
+	if w.streaming {
+		return w.ResponseWriter.Written()
+	}
+	return w.headerNow || w.body.Len() > 0
+}
+
+func (w *cacheWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		if w.body.Len() > 0 {
+			_, _ = w.ResponseWriter.Write(w.body.Bytes())
+			w.body.Reset()
+		}
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Cache sets the Cache-Control of policy on successful GET and HEAD responses
+// and, when the policy enables it, tags 200 responses with a weak ETag computed
+// from the body, so clients revalidating an unchanged resource get an empty 304.
+// The ETag is computed before Compression encodes the body, so it is the same
+// for every coding.
+func Cache(policy CachePolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &cacheWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.streaming {
+			return
+		}
+		if !writer.Written() {
+			// Nothing was written: errors added with c.Error are rendered by
+			// ErrorHandlingMiddleware.
+			return
+		}
+
+		status := c.Writer.Status()
+		h := c.Writer.Header()
+		if status >= http.StatusOK && status < http.StatusMultipleChoices && policy.CacheControl != "" && h.Get(CacheControlHeader) == "" {
+			h.Set(CacheControlHeader, policy.CacheControl)
+		}
+		if policy.ETag && status == http.StatusOK {
+			if h.Get(ETagHeader) == "" {
+				h.Set(ETagHeader, weakETag(writer.body.Bytes()))
+			}
+			if etagMatches(c.GetHeader(IfNoneMatchHeader), h.Get(ETagHeader)) {
+				h.Del("Content-Type")
+				h.Del(contentLengthHeader)
+				c.Writer.WriteHeader(http.StatusNotModified)
+				c.Writer.WriteHeaderNow()
+				return
+			}
+		}
+
+		if writer.body.Len() == 0 {
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		_, _ = c.Writer.Write(writer.body.Bytes())
+	}
+}
+
+// weakETag fingerprints body. It is weak because the same representation may be
+// sent with different content codings.
+func weakETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches applies the weak comparison of If-None-Match (RFC 9110 13.1.2).
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	opaque := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == opaque {
+			return true
+		}
+	}
+	return false
+}
+
+// CachePolicies resolves named policies, so route groups pick theirs by name.
+type CachePolicies struct {
+	policies map[string]CachePolicy
+}
+
+// NewCachePolicies creates the registry of cache policies.
+func NewCachePolicies(policies ...CachePolicy) *CachePolicies {
+	p := &CachePolicies{policies: make(map[string]CachePolicy, len(policies))}
+	for _, policy := range policies {
+		p.policies[policy.Name] = policy
+	}
+	return p
+}
+
+// Policy returns the middleware of a named policy. An unknown name is a wiring
+// mistake and panics when the routes are registered.
+func (p *CachePolicies) Policy(name string) gin.HandlerFunc {
+	policy, ok := p.policies[name]
+	if !ok {
+		panic(fmt.Sprintf("unknown cache policy %q", name))
+	}
+	return Cache(policy)
+}
@@ -0,0 +1,114 @@
+package pkgmwr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+func TestCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	policy := CachePolicy{Name: "catalog", CacheControl: "private, max-age=60", ETag: true}
+	body := `{"id":1,"name":"Soja"}`
+	etag := weakETag([]byte(body))
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		ifNoneMatch      string
+		wantStatus       int
+		wantBody         string
+		wantETag         string
+		wantCacheControl string
+	}{
+		{name: "200 is tagged", method: http.MethodGet, path: "/crops/1", wantStatus: http.StatusOK, wantBody: body, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "matching If-None-Match is not modified", method: http.MethodGet, path: "/crops/1", ifNoneMatch: etag, wantStatus: http.StatusNotModified, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "weak comparison ignores the W/ prefix", method: http.MethodGet, path: "/crops/1", ifNoneMatch: etag[2:], wantStatus: http.StatusNotModified, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "any tag of a list matches", method: http.MethodGet, path: "/crops/1", ifNoneMatch: `W/"old", ` + etag, wantStatus: http.StatusNotModified, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "wildcard matches", method: http.MethodGet, path: "/crops/1", ifNoneMatch: "*", wantStatus: http.StatusNotModified, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "stale tag gets the body", method: http.MethodGet, path: "/crops/1", ifNoneMatch: `W/"old"`, wantStatus: http.StatusOK, wantBody: body, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "HEAD is tagged too", method: http.MethodHead, path: "/crops/1", wantStatus: http.StatusOK, wantBody: body, wantETag: etag, wantCacheControl: policy.CacheControl},
+		{name: "non-200 success is not tagged", method: http.MethodGet, path: "/crops/accepted", ifNoneMatch: "*", wantStatus: http.StatusAccepted, wantBody: body, wantCacheControl: policy.CacheControl},
+		{name: "client errors are neither tagged nor cached", method: http.MethodGet, path: "/crops/missing", ifNoneMatch: "*", wantStatus: http.StatusNotFound, wantBody: `{"error":"missing"}`},
+		{name: "handler Cache-Control wins", method: http.MethodGet, path: "/crops/private", wantStatus: http.StatusOK, wantBody: body, wantETag: etag, wantCacheControl: "no-store"},
+		{name: "writes pass through", method: http.MethodPost, path: "/crops/1", wantStatus: http.StatusOK, wantBody: body},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(Cache(policy))
+			ok := func(c *gin.Context) { c.Data(http.StatusOK, "application/json", []byte(body)) }
+			router.GET("/crops/1", ok)
+			router.HEAD("/crops/1", ok)
+			router.POST("/crops/1", ok)
+			router.GET("/crops/accepted", func(c *gin.Context) { c.Data(http.StatusAccepted, "application/json", []byte(body)) })
+			router.GET("/crops/missing", func(c *gin.Context) { c.Data(http.StatusNotFound, "application/json", []byte(`{"error":"missing"}`)) })
+			router.GET("/crops/private", func(c *gin.Context) {
+				c.Header(CacheControlHeader, "no-store")
+				ok(c)
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set(IfNoneMatchHeader, tt.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantBody, w.Body.String())
+			assert.Equal(t, tt.wantETag, w.Header().Get(ETagHeader))
+			assert.Equal(t, tt.wantCacheControl, w.Header().Get(CacheControlHeader))
+			if tt.wantStatus == http.StatusNotModified {
+				assert.Empty(t, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestCacheLeavesErrorsToErrorHandling(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandlingMiddleware(), Cache(CachePolicy{CacheControl: "max-age=60", ETag: true}))
+	router.GET("/crops/1", func(c *gin.Context) {
+		_ = c.Error(pkgtypes.NewError(pkgtypes.ErrNotFound, "crop not found", nil))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crops/1", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, pkgtypes.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Empty(t, w.Header().Get(ETagHeader))
+	assert.Empty(t, w.Header().Get(CacheControlHeader))
+}
+
+func TestEtagMatches(t *testing.T) {
+	tests := []struct {
+		ifNoneMatch string
+		etag        string
+		want        bool
+	}{
+		{ifNoneMatch: `W/"a"`, etag: `W/"a"`, want: true},
+		{ifNoneMatch: `"a"`, etag: `W/"a"`, want: true},
+		{ifNoneMatch: `W/"a"`, etag: `"a"`, want: true},
+		{ifNoneMatch: ` "b" , W/"a" `, etag: `W/"a"`, want: true},
+		{ifNoneMatch: ` * `, etag: `"a"`, want: true},
+		{ifNoneMatch: `"b"`, etag: `W/"a"`},
+		{ifNoneMatch: `"a"`, etag: ``},
+		{ifNoneMatch: ``, etag: `"a"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifNoneMatch+" vs "+tt.etag, func(t *testing.T) {
+			assert.Equal(t, tt.want, etagMatches(tt.ifNoneMatch, tt.etag))
+		})
+	}
+}
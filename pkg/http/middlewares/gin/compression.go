@@ -0,0 +1,282 @@
+package pkgmwr
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Content codings supported by Compression.
+const (
+	EncodingBrotli = "br"
+	EncodingZstd   = "zstd"
+	EncodingGzip   = "gzip"
+)
+
+const (
+	acceptEncodingHeader  = "Accept-Encoding"
+	contentEncodingHeader = "Content-Encoding"
+	contentLengthHeader   = "Content-Length"
+	varyHeader            = "Vary"
+)
+
+// CompressionOptions configures the Compression middleware.
+type CompressionOptions struct {
+	// MinSize is the smallest body, in bytes, worth compressing (default 1024).
+	MinSize int
+	// Encodings lists the accepted codings in server preference order, used to
+	// break ties between codings the client accepts equally (default br, zstd, gzip).
+	Encodings []string
+	// ExcludedPaths are never compressed (exact match).
+	ExcludedPaths []string
+	// ExcludedContentTypes are content type prefixes that are already compressed
+	// or are streamed (default images, audio, video, archives and event streams).
+	ExcludedContentTypes []string
+}
+
+var defaultExcludedContentTypes = []string{
+	"image/",
+	"video/",
+	"audio/",
+	"font/woff",
+	"application/zip",
+	"application/gzip",
+	"application/x-gzip",
+	"application/octet-stream",
+	"text/event-stream",
+}
+
+// encoder is what the three compressors have in common.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// encoderPools reuses compressors between requests; they allocate large windows.
+var encoderPools = map[string]*sync.Pool{
+	EncodingGzip: {New: func() any {
+		w, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return w
+	}},
+	EncodingBrotli: {New: func() any {
+		// Level 4 compresses better than gzip at a similar speed; higher levels
+		// are meant for static assets.
+		return brotli.NewWriterLevel(nil, 4)
+	}},
+	EncodingZstd: {New: func() any {
+		w, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+		return w
+	}},
+}
+
+// Compression compresses response bodies with the best coding the client
+// accepts (Accept-Encoding). Bodies smaller than MinSize, excluded content types,
+// bodiless statuses and responses that already set Content-Encoding are sent
+// as they are.
+func Compression(options CompressionOptions) gin.HandlerFunc {
+	if options.MinSize <= 0 {
+		options.MinSize = 1024
+	}
+	if len(options.Encodings) == 0 {
+		options.Encodings = []string{EncodingBrotli, EncodingZstd, EncodingGzip}
+	}
+	if options.ExcludedContentTypes == nil {
+		options.ExcludedContentTypes = defaultExcludedContentTypes
+	}
+	excluded := make(map[string]struct{}, len(options.ExcludedPaths))
+	for _, path := range options.ExcludedPaths {
+		excluded[path] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := excluded[c.Request.URL.Path]; ok || c.Request.Method == http.MethodHead || c.GetHeader("Upgrade") != "" {
+			c.Next()
+			return
+		}
+
+		// Caches must keep one copy per coding even when this one goes uncompressed.
+		c.Writer.Header().Add(varyHeader, acceptEncodingHeader)
+		encoding := negotiateEncoding(c.GetHeader(acceptEncodingHeader), options.Encodings)
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, options: &options, encoding: encoding}
+		c.Writer = writer
+		defer func() {
+			writer.close()
+			c.Writer = writer.ResponseWriter
+		}()
+
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks the supported coding with the highest q-value in
+// accept; ties go to the first one in supported. It returns "" for identity.
+func negotiateEncoding(accept string, supported []string) string {
+	if accept == "" {
+		return ""
+	}
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(accept, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		qualities[coding] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, coding := range supported {
+		if _, ok := encoderPools[coding]; !ok {
+			continue
+		}
+		q, ok := qualities[coding]
+		if !ok {
+			q = qualities["*"]
+		}
+		if q > bestQ {
+			best, bestQ = coding, q
+		}
+	}
+	return best
+}
+
+// compressWriter holds the first MinSize bytes back to decide whether the
+// response is worth compressing, and from then on streams through the encoder.
+type compressWriter struct {
+	gin.ResponseWriter
+	options  *CompressionOptions
+	encoding string
+
+	buf     []byte
+	decided bool
+	enc     encoder
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		w.buf = append(w.buf, b...)
+		if len(w.buf) < w.options.MinSize {
+			return len(b), nil
+		}
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if w.enc != nil {
+		return w.enc.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow sends the headers, so the decision can no longer wait for the body.
+func (w *compressWriter) WriteHeaderNow() {
+	if !w.decided {
+		_ = w.decide()
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Written reports buffered bytes too, so error handling does not write a second body.
+func (w *compressWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// Flush gives up waiting for MinSize: streamed responses are compressed as they go.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide()
+	}
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide sets the response headers for the chosen coding and writes what was buffered.
+func (w *compressWriter) decide() error {
+	w.decided = true
+	buffered := w.buf
+	w.buf = nil
+
+	if w.shouldCompress(buffered) {
+		h := w.Header()
+		h.Set(contentEncodingHeader, w.encoding)
+		h.Del(contentLengthHeader)
+		// The compressed body is no longer byte-for-byte the one a strong ETag names.
+		if etag := h.Get(ETagHeader); etag != "" && !strings.HasPrefix(etag, "W/") {
+			h.Set(ETagHeader, "W/"+etag)
+		}
+		w.enc = encoderPools[w.encoding].Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+	}
+
+	if len(buffered) == 0 {
+		return nil
+	}
+	var err error
+	if w.enc != nil {
+		_, err = w.enc.Write(buffered)
+	} else {
+		_, err = w.ResponseWriter.Write(buffered)
+	}
+	return err
+}
+
+func (w *compressWriter) shouldCompress(body []byte) bool {
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent ||
+		status == http.StatusPartialContent || status == http.StatusNotModified {
+		return false
+	}
+	if len(body) < w.options.MinSize || w.Header().Get(contentEncodingHeader) != "" {
+		return false
+	}
+	contentType := w.Header().Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+	for _, prefix := range w.options.ExcludedContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// close writes whatever is still buffered and the encoder trailer, and returns
+// the encoder to its pool.
+func (w *compressWriter) close() {
+	if !w.decided {
+		_ = w.decide()
+	}
+	if w.enc == nil {
+		return
+	}
+	_ = w.enc.Close()
+	w.enc.Reset(io.Discard)
+	encoderPools[w.encoding].Put(w.enc)
+	w.enc = nil
+}
@@ -0,0 +1,215 @@
+package pkgmwr
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	all := []string{EncodingBrotli, EncodingZstd, EncodingGzip}
+
+	tests := []struct {
+		accept    string
+		supported []string
+		want      string
+	}{
+		{accept: "", want: ""},
+		{accept: "gzip", want: EncodingGzip},
+		{accept: "GZIP", want: EncodingGzip},
+		{accept: "gzip, br", want: EncodingBrotli},
+		{accept: "gzip, br", supported: []string{EncodingGzip, EncodingBrotli}, want: EncodingGzip},
+		{accept: "br;q=0.5, gzip", want: EncodingGzip},
+		{accept: "br;q=0.8, zstd;q=0.9, gzip;q=0.1", want: EncodingZstd},
+		{accept: "gzip;q=0", want: ""},
+		{accept: "*", want: EncodingBrotli},
+		{accept: "br;q=0, *", want: EncodingZstd},
+		{accept: "*;q=0", want: ""},
+		{accept: "identity", want: ""},
+		{accept: "deflate, compress", want: ""},
+		{accept: "gzip;q=high", want: ""},
+		{accept: "gzip ; q=0.5 , zstd ; q=0.4", want: EncodingGzip},
+		{accept: "deflate", supported: []string{"deflate", EncodingGzip}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			supported := tt.supported
+			if supported == nil {
+				supported = all
+			}
+			assert.Equal(t, tt.want, negotiateEncoding(tt.accept, supported))
+		})
+	}
+}
+
+// decode undoes the content coding of a response body.
+func decode(t *testing.T, encoding string, body []byte) string {
+	t.Helper()
+	var r io.Reader
+	switch encoding {
+	case "":
+		return string(body)
+	case EncodingGzip:
+		gr, err := gzip.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		r = gr
+	case EncodingBrotli:
+		r = brotli.NewReader(bytes.NewReader(body))
+	case EncodingZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+	decoded, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(decoded)
+}
+
+func TestCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := `{"items":"` + strings.Repeat("lote norte ", 200) + `"}`
+	small := `{"id":1}`
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		accept       string
+		wantEncoding string
+		wantBody     string
+		wantVary     bool
+	}{
+		{name: "brotli is preferred", path: "/large", accept: "gzip, deflate, br, zstd", wantEncoding: EncodingBrotli, wantBody: large, wantVary: true},
+		{name: "zstd", path: "/large", accept: "zstd", wantEncoding: EncodingZstd, wantBody: large, wantVary: true},
+		{name: "gzip", path: "/large", accept: "gzip", wantEncoding: EncodingGzip, wantBody: large, wantVary: true},
+		{name: "q-values pick the coding", path: "/large", accept: "br;q=0.2, gzip;q=0.9", wantEncoding: EncodingGzip, wantBody: large, wantVary: true},
+		{name: "below MinSize is sent as is", path: "/small", accept: "gzip", wantBody: small, wantVary: true},
+		{name: "without Accept-Encoding is sent as is", path: "/large", wantBody: large, wantVary: true},
+		{name: "streamed writes are compressed once MinSize is reached", path: "/chunks", accept: "gzip", wantEncoding: EncodingGzip, wantBody: large, wantVary: true},
+		{name: "excluded content types are sent as is", path: "/image", accept: "gzip", wantBody: large, wantVary: true},
+		{name: "responses already encoded are left alone", path: "/encoded", accept: "gzip", wantEncoding: "identity", wantBody: large, wantVary: true},
+		{name: "bodiless statuses are sent as is", path: "/empty", accept: "gzip", wantVary: true},
+		{name: "excluded paths are skipped", path: "/metrics", accept: "gzip", wantBody: large},
+		{name: "HEAD is skipped", method: http.MethodHead, path: "/large", accept: "gzip", wantBody: large},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(Compression(CompressionOptions{MinSize: 256, ExcludedPaths: []string{"/metrics"}}))
+			json := func(body string) gin.HandlerFunc {
+				return func(c *gin.Context) { c.Data(http.StatusOK, "application/json", []byte(body)) }
+			}
+			router.GET("/large", json(large))
+			router.HEAD("/large", json(large))
+			router.GET("/small", json(small))
+			router.GET("/metrics", json(large))
+			router.GET("/chunks", func(c *gin.Context) {
+				c.Header("Content-Type", "application/json")
+				for i := 0; i < len(large); i += 100 {
+					_, _ = c.Writer.WriteString(large[i:min(i+100, len(large))])
+				}
+			})
+			router.GET("/image", func(c *gin.Context) { c.Data(http.StatusOK, "image/png", []byte(large)) })
+			router.GET("/encoded", func(c *gin.Context) {
+				c.Header(contentEncodingHeader, "identity")
+				json(large)(c)
+			})
+			router.GET("/empty", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set(acceptEncodingHeader, tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			encoding := w.Header().Get(contentEncodingHeader)
+			assert.Equal(t, tt.wantEncoding, encoding)
+			if encoding == "identity" {
+				encoding = ""
+			}
+			assert.Equal(t, tt.wantBody, decode(t, encoding, w.Body.Bytes()))
+			if tt.wantEncoding != "" && tt.wantEncoding != "identity" {
+				assert.Less(t, w.Body.Len(), len(tt.wantBody))
+				assert.Empty(t, w.Header().Get(contentLengthHeader))
+			}
+			if tt.wantVary {
+				assert.Equal(t, acceptEncodingHeader, w.Header().Get(varyHeader))
+			} else {
+				assert.Empty(t, w.Header().Get(varyHeader))
+			}
+		})
+	}
+}
+
+func TestCompressionWithCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"items":"` + strings.Repeat("lote sur ", 300) + `"}`
+
+	router := gin.New()
+	router.Use(Compression(CompressionOptions{}), Cache(CachePolicy{ETag: true}))
+	router.GET("/lots", func(c *gin.Context) { c.Data(http.StatusOK, "application/json", []byte(body)) })
+	get := func(accept, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/lots", nil)
+		req.Header.Set(acceptEncodingHeader, accept)
+		if ifNoneMatch != "" {
+			req.Header.Set(IfNoneMatchHeader, ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	gzipped := get(EncodingGzip, "")
+	plain := get("", "")
+	require.Equal(t, http.StatusOK, gzipped.Code)
+	assert.Equal(t, EncodingGzip, gzipped.Header().Get(contentEncodingHeader))
+	assert.Equal(t, body, decode(t, EncodingGzip, gzipped.Body.Bytes()))
+
+	// The ETag names the representation before compression, so it is the same for every coding.
+	etag := gzipped.Header().Get(ETagHeader)
+	assert.True(t, strings.HasPrefix(etag, `W/"`), etag)
+	assert.Equal(t, etag, plain.Header().Get(ETagHeader))
+
+	revalidated := get(EncodingBrotli, etag)
+	assert.Equal(t, http.StatusNotModified, revalidated.Code)
+	assert.Empty(t, revalidated.Body.Bytes())
+	assert.Empty(t, revalidated.Header().Get(contentEncodingHeader))
+}
+
+func TestCompressionWeakensStrongETags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := strings.Repeat("a", 2048)
+
+	router := gin.New()
+	router.Use(Compression(CompressionOptions{}))
+	router.GET("/file", func(c *gin.Context) {
+		c.Header(ETagHeader, `"v1"`)
+		c.Data(http.StatusOK, "text/plain", []byte(body))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/file", nil)
+	req.Header.Set(acceptEncodingHeader, EncodingGzip)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, EncodingGzip, w.Header().Get(contentEncodingHeader))
+	assert.Equal(t, `W/"v1"`, w.Header().Get(ETagHeader))
+}
@@ -0,0 +1,196 @@
+package pkgmwr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client generated key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from the store.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyInFlight  = "in_flight"
+	idempotencyCompleted = "completed"
+)
+
+// IdempotencyOptions configures the Idempotency middleware.
+type IdempotencyOptions struct {
+	TTL       time.Duration // How long a completed response is kept for replay.
+	LockTTL   time.Duration // How long an in-flight request holds the key.
+	KeyPrefix string        // Prefix for the keys stored in Redis.
+}
+
+// idempotencyRecord is what gets stored in Redis for each key.
+type idempotencyRecord struct {
+	State       string `json:"state"`
+	RequestHash string `json:"request_hash"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// bodyCaptureWriter copies everything written to the response so it can be stored.
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency honours the Idempotency-Key header. The first request with a given key
+// is processed and its response stored; retries with the same key and payload get the
+// stored response replayed, and retries arriving while the first one is still being
+// processed get a 409. Reusing a key with a different payload gets a 422, and failed
+// requests release the key so they can be retried. Requests without the header pass
+// through untouched.
+func Idempotency(cache pkgredis.Cache, options IdempotencyOptions) gin.HandlerFunc {
+	if options.TTL <= 0 {
+		options.TTL = 24 * time.Hour
+	}
+	if options.LockTTL <= 0 {
+		options.LockTTL = time.Minute
+	}
+	if options.KeyPrefix == "" {
+		options.KeyPrefix = "idempotency"
+	}
+
+	return func(c *gin.Context) {
+		idemKey := c.GetHeader(IdempotencyKeyHeader)
+		if idemKey == "" {
+			c.Next()
+			return
+		}
+
+		bodyBytes, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrInvalidInput, "failed to read request body", err))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
+		ctx := c.Request.Context()
+		key := options.KeyPrefix + ":" + c.Request.Method + ":" + c.FullPath() + ":" + idemKey
+		if tenantID, ok := pkgtypes.TenantIDFromContext(ctx); ok {
+			// Keys are scoped per organization so tenants never replay each other's responses.
+			key = options.KeyPrefix + ":" + strconv.FormatInt(tenantID, 10) + ":" + c.Request.Method + ":" + c.FullPath() + ":" + idemKey
+		}
+		requestHash := hashRequest(c.Request.Method, c.Request.URL.RequestURI(), bodyBytes)
+
+		stored, err := cache.Get(ctx, key)
+		switch {
+		case err == nil:
+			var rec idempotencyRecord
+			if err := json.Unmarshal([]byte(stored), &rec); err != nil {
+				slog.ErrorContext(ctx, "idempotency: corrupted record", "key", key, "error", err)
+				c.Next()
+				return
+			}
+			replayOrReject(c, &rec, requestHash)
+			return
+		case !errors.Is(err, redis.Nil):
+			// The store is unavailable: process the request without idempotency guarantees.
+			slog.ErrorContext(ctx, "idempotency: failed to read key", "key", key, "error", err)
+			c.Next()
+			return
+		}
+
+		lock, _ := json.Marshal(idempotencyRecord{State: idempotencyInFlight, RequestHash: requestHash})
+		acquired, err := cache.SetNX(ctx, key, lock, options.LockTTL)
+		if err != nil {
+			slog.ErrorContext(ctx, "idempotency: failed to lock key", "key", key, "error", err)
+			c.Next()
+			return
+		}
+		if !acquired {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrConflict, "a request with this idempotency key is already in progress", nil))
+			return
+		}
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		// Errors added with c.Error are written later by ErrorHandlingMiddleware, so
+		// a failed request has no response yet. Failures and server errors are not
+		// stored: the key is released and the client can retry with it.
+		status := c.Writer.Status()
+		if len(c.Errors) > 0 || !writer.Written() || status >= http.StatusInternalServerError {
+			if err := cache.Delete(ctx, key); err != nil {
+				slog.ErrorContext(ctx, "idempotency: failed to release key", "key", key, "error", err)
+			}
+			return
+		}
+
+		rec, _ := json.Marshal(idempotencyRecord{
+			State:       idempotencyCompleted,
+			RequestHash: requestHash,
+			Status:      status,
+			ContentType: c.Writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		})
+		if err := cache.Set(ctx, key, rec, options.TTL); err != nil {
+			slog.ErrorContext(ctx, "idempotency: failed to store response", "key", key, "error", err)
+		}
+	}
+}
+
+// replayOrReject answers a request whose key is already known to the store.
+func replayOrReject(c *gin.Context, rec *idempotencyRecord, requestHash string) {
+	if rec.RequestHash != requestHash {
+		abortWithError(c, pkgtypes.NewErrorWithContext(
+			pkgtypes.ErrUnprocessable,
+			"idempotency key was already used with a different request",
+			nil,
+			map[string]any{"header": IdempotencyKeyHeader},
+		))
+		return
+	}
+	if rec.State == idempotencyInFlight {
+		abortWithError(c, pkgtypes.NewError(pkgtypes.ErrConflict, "a request with this idempotency key is already in progress", nil))
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	contentType := rec.ContentType
+	if contentType == "" {
+		contentType = "application/json; charset=utf-8"
+	}
+	c.Data(rec.Status, contentType, rec.Body)
+	c.Abort()
+}
+
+// hashRequest fingerprints the request so a reused key with a different payload is detected.
+func hashRequest(method, uri string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(uri))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,192 @@
+package pkgmwr
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// idempotencyServer serves POST /orders behind Idempotency, counting how many
+// times the handler runs. The X-Tenant header stands in for the Tenant middleware.
+type idempotencyServer struct {
+	router  *gin.Engine
+	redis   *miniredis.Miniredis
+	calls   int
+	handler gin.HandlerFunc
+}
+
+var (
+	sharedRedis     *miniredis.Miniredis
+	sharedRedisOnce sync.Once
+)
+
+// testRedis returns a cache backed by an empty miniredis. pkgredis keeps a single
+// client per process, so every test shares one server and starts by flushing it.
+func testRedis(t *testing.T) (pkgredis.Cache, *miniredis.Miniredis) {
+	t.Helper()
+	sharedRedisOnce.Do(func() {
+		sharedRedis = miniredis.NewMiniRedis()
+		if err := sharedRedis.Start(); err != nil {
+			panic(err)
+		}
+	})
+	sharedRedis.FlushAll()
+
+	cache, err := pkgredis.Bootstrap(sharedRedis.Addr(), "", 0)
+	require.NoError(t, err)
+	return cache, sharedRedis
+}
+
+func newIdempotencyServer(t *testing.T) *idempotencyServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	cache, mr := testRedis(t)
+
+	s := &idempotencyServer{router: gin.New(), redis: mr}
+	s.handler = func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": s.calls})
+	}
+	s.router.Use(ErrorHandlingMiddleware(), func(c *gin.Context) {
+		if id, err := strconv.ParseInt(c.GetHeader("X-Tenant"), 10, 64); err == nil {
+			c.Request = c.Request.WithContext(pkgtypes.WithTenantID(c.Request.Context(), id))
+		}
+	})
+	s.router.POST("/orders", Idempotency(cache, IdempotencyOptions{}), func(c *gin.Context) {
+		s.calls++
+		s.handler(c)
+	})
+	return s
+}
+
+func (s *idempotencyServer) post(key, body, tenant string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, key)
+	if tenant != "" {
+		req.Header.Set("X-Tenant", tenant)
+	}
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *idempotencyServer)
+	}{
+		{
+			name: "a retry with the same payload replays the stored response",
+			run: func(t *testing.T, s *idempotencyServer) {
+				first := s.post("k1", `{"qty":1}`, "")
+				require.Equal(t, http.StatusCreated, first.Code)
+
+				retry := s.post("k1", `{"qty":1}`, "")
+				assert.Equal(t, http.StatusCreated, retry.Code)
+				assert.Equal(t, first.Body.String(), retry.Body.String())
+				assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+				assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+				assert.Equal(t, 1, s.calls)
+			},
+		},
+		{
+			name: "the same key with a different payload is unprocessable",
+			run: func(t *testing.T, s *idempotencyServer) {
+				require.Equal(t, http.StatusCreated, s.post("k1", `{"qty":1}`, "").Code)
+
+				w := s.post("k1", `{"qty":2}`, "")
+				assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+				assert.Equal(t, 1, s.calls)
+			},
+		},
+		{
+			name: "a retry while the first request is in flight conflicts",
+			run: func(t *testing.T, s *idempotencyServer) {
+				var concurrent int
+				s.handler = func(c *gin.Context) {
+					if s.calls == 1 {
+						concurrent = s.post("k1", `{"qty":1}`, "").Code
+					}
+					c.Status(http.StatusCreated)
+				}
+
+				assert.Equal(t, http.StatusCreated, s.post("k1", `{"qty":1}`, "").Code)
+				assert.Equal(t, http.StatusConflict, concurrent)
+				assert.Equal(t, 1, s.calls)
+			},
+		},
+		{
+			name: "a failed request releases the key",
+			run: func(t *testing.T, s *idempotencyServer) {
+				s.handler = func(c *gin.Context) {
+					if s.calls == 1 {
+						_ = c.Error(pkgtypes.NewError(pkgtypes.ErrUnavailable, "stock service unavailable", errors.New("dial tcp: refused")))
+						return
+					}
+					c.Status(http.StatusCreated)
+				}
+
+				assert.Equal(t, http.StatusServiceUnavailable, s.post("k1", `{"qty":1}`, "").Code)
+				assert.Empty(t, s.redis.Keys())
+
+				retry := s.post("k1", `{"qty":1}`, "")
+				assert.Equal(t, http.StatusCreated, retry.Code)
+				assert.Empty(t, retry.Header().Get(IdempotentReplayedHeader))
+				assert.Equal(t, 2, s.calls)
+			},
+		},
+		{
+			name: "server errors are not stored",
+			run: func(t *testing.T, s *idempotencyServer) {
+				s.handler = func(c *gin.Context) {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "boom"})
+				}
+
+				s.post("k1", `{"qty":1}`, "")
+				assert.Empty(t, s.redis.Keys())
+			},
+		},
+		{
+			name: "keys are scoped per tenant",
+			run: func(t *testing.T, s *idempotencyServer) {
+				assert.Equal(t, http.StatusCreated, s.post("k1", `{"qty":1}`, "1").Code)
+
+				other := s.post("k1", `{"qty":1}`, "2")
+				assert.Equal(t, http.StatusCreated, other.Code)
+				assert.Empty(t, other.Header().Get(IdempotentReplayedHeader))
+				assert.Equal(t, 2, s.calls)
+				assert.Len(t, s.redis.Keys(), 2)
+
+				assert.Equal(t, "true", s.post("k1", `{"qty":1}`, "1").Header().Get(IdempotentReplayedHeader))
+			},
+		},
+		{
+			name: "requests without a key pass through",
+			run: func(t *testing.T, s *idempotencyServer) {
+				for range 2 {
+					s.router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{}`)))
+				}
+				assert.Equal(t, 2, s.calls)
+				assert.Empty(t, s.redis.Keys())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newIdempotencyServer(t))
+		})
+	}
+}
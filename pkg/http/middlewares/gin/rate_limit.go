@@ -0,0 +1,141 @@
+package pkgmwr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// Headers set by RateLimit, following the IETF RateLimit header fields draft.
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+	RetryAfterHeader         = "Retry-After"
+)
+
+// RateLimitKeyFunc returns who a request is counted against.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// RateLimitPolicy allows Limit requests per Window to each key.
+type RateLimitPolicy struct {
+	// Name identifies the policy; keys of different policies never collide.
+	Name   string
+	Limit  int
+	Window time.Duration
+	// Burst is the bucket capacity of the local limiter; Limit when zero. The
+	// Redis limiter does not burst beyond Limit.
+	Burst int
+	// Key defaults to KeyByIdentity.
+	Key RateLimitKeyFunc
+}
+
+// RateLimitResult is the outcome of counting one request.
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// Reset is when the key is back to its full allowance.
+	Reset time.Duration
+	// RetryAfter is how long to wait before the next request is allowed.
+	RetryAfter time.Duration
+}
+
+// RateLimiter counts a request of key under policy.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error)
+}
+
+// KeyByIP counts requests per client IP.
+func KeyByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// KeyByIdentity counts requests per authenticated user, then per API key, then
+// per IP. It must run after Protected to see the user. API keys are hashed so
+// they are never stored.
+func KeyByIdentity(c *gin.Context) string {
+	if subject, ok := SubjectFromContext(c); ok {
+		return "user:" + subject
+	}
+	if key := c.GetHeader(APIKeyHeader); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:8])
+	}
+	return KeyByIP(c)
+}
+
+// RateLimit rejects requests over the policy with 429 and sets the RateLimit-*
+// headers on every response. If the limiter fails the request is let through:
+// an unavailable store must not take the API down.
+func RateLimit(limiter RateLimiter, policy RateLimitPolicy) gin.HandlerFunc {
+	if policy.Limit <= 0 || policy.Window <= 0 {
+		panic(fmt.Sprintf("rate limit policy %q needs a positive limit and window", policy.Name))
+	}
+	if policy.Key == nil {
+		policy.Key = KeyByIdentity
+	}
+	policyHeader := fmt.Sprintf("%d;w=%d", policy.Limit, int(policy.Window.Seconds()))
+
+	return func(c *gin.Context) {
+		result, err := limiter.Allow(c.Request.Context(), policy.Name+":"+policy.Key(c), policy)
+		if err != nil {
+			slog.ErrorContext(c.Request.Context(), "rate limiter failed", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		h := c.Writer.Header()
+		h.Set(RateLimitLimitHeader, strconv.Itoa(policy.Limit))
+		h.Set(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+		h.Set(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.Reset)))
+		h.Set(RateLimitPolicyHeader, policyHeader)
+		if !result.Allowed {
+			h.Set(RetryAfterHeader, strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrTooManyRequests, "rate limit exceeded", nil))
+			return
+		}
+		c.Next()
+	}
+}
+
+// RateLimits resolves named policies, so route groups pick theirs by name.
+type RateLimits struct {
+	limiter  RateLimiter
+	policies map[string]RateLimitPolicy
+}
+
+// NewRateLimits creates the registry of policies enforced with limiter.
+func NewRateLimits(limiter RateLimiter, policies ...RateLimitPolicy) *RateLimits {
+	r := &RateLimits{limiter: limiter, policies: make(map[string]RateLimitPolicy, len(policies))}
+	for _, p := range policies {
+		r.policies[p.Name] = p
+	}
+	return r
+}
+
+// Policy returns the middleware of a named policy. An unknown name is a wiring
+// mistake and panics when the routes are registered.
+func (r *RateLimits) Policy(name string) gin.HandlerFunc {
+	policy, ok := r.policies[name]
+	if !ok {
+		panic(fmt.Sprintf("unknown rate limit policy %q", name))
+	}
+	return RateLimit(r.limiter, policy)
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,150 @@
+package pkgmwr
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+)
+
+// bucketSweepInterval is how often idle buckets are dropped from memory.
+const bucketSweepInterval = time.Minute
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	capacity float64
+	rate     float64 // tokens per second
+}
+
+type localRateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewLocalRateLimiter creates an in-memory token bucket limiter. Each replica
+// counts on its own, so it only fits single instances.
+func NewLocalRateLimiter() RateLimiter {
+	return &localRateLimiter{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+func (l *localRateLimiter) Allow(_ context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error) {
+	capacity := float64(policy.Burst)
+	if capacity <= 0 {
+		capacity = float64(policy.Limit)
+	}
+	rate := float64(policy.Limit) / policy.Window.Seconds()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updated: now, capacity: capacity, rate: rate}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.updated).Seconds()*b.rate)
+	b.updated = now
+
+	result := RateLimitResult{Allowed: b.tokens >= 1}
+	if result.Allowed {
+		b.tokens--
+	} else {
+		result.RetryAfter = secondsToDuration((1 - b.tokens) / b.rate)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = secondsToDuration((b.capacity - b.tokens) / b.rate)
+	return result, nil
+}
+
+// sweep drops buckets that refilled completely; they are recreated full.
+func (l *localRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < bucketSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*b.rate >= b.capacity {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// slidingWindowScript counts the requests of the last window in a sorted set
+// scored by time. It returns {allowed, remaining, reset_ms, retry_after_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	count = count + 1
+	allowed = 1
+end
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local reset = 0
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+local retry = 0
+if allowed == 0 then
+	retry = reset
+end
+return {allowed, limit - count, reset, retry}
+`)
+
+type redisRateLimiter struct {
+	cache  pkgredis.Cache
+	prefix string
+	seq    atomic.Uint64
+	now    func() time.Time
+}
+
+// NewRedisRateLimiter creates a sliding window limiter shared by every replica.
+// It is exact (no burst beyond the limit) at the cost of one round trip per request.
+func NewRedisRateLimiter(cache pkgredis.Cache, keyPrefix string) RateLimiter {
+	if keyPrefix == "" {
+		keyPrefix = "ratelimit"
+	}
+	return &redisRateLimiter{cache: cache, prefix: keyPrefix, now: time.Now}
+}
+
+func (l *redisRateLimiter) Allow(ctx context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error) {
+	now := l.now()
+	// Several replicas may count the same millisecond; the member must be unique.
+	member := strconv.FormatInt(now.UnixNano(), 36) + "-" + strconv.FormatUint(l.seq.Add(1), 36)
+	values, err := slidingWindowScript.Run(ctx, l.cache.Client(), []string{l.prefix + ":" + key},
+		now.UnixMilli(), policy.Window.Milliseconds(), policy.Limit, member).Int64Slice()
+	if err != nil {
+		return RateLimitResult{}, fmt.Errorf("failed to count request: %w", err)
+	}
+	if len(values) != 4 {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script reply %v", values)
+	}
+	return RateLimitResult{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		Reset:      time.Duration(values[2]) * time.Millisecond,
+		RetryAfter: time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package pkgmwr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced time source for the limiters.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{t: time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+// rateLimitStep is one request at the given offset from the start of the test.
+type rateLimitStep struct {
+	at   time.Duration
+	key  string
+	want RateLimitResult
+}
+
+func runRateLimitSteps(t *testing.T, limiter RateLimiter, clock *fakeClock, policy RateLimitPolicy, steps []rateLimitStep) {
+	t.Helper()
+	start := clock.t
+	for i, step := range steps {
+		clock.t = start.Add(step.at)
+		key := step.key
+		if key == "" {
+			key = "user:u1"
+		}
+		got, err := limiter.Allow(context.Background(), key, policy)
+		require.NoError(t, err)
+		assert.Equal(t, step.want, got, "step %d at %s", i, step.at)
+	}
+}
+
+func TestLocalRateLimiter(t *testing.T) {
+	second := RateLimitPolicy{Name: "api", Limit: 2, Window: time.Second}
+
+	tests := []struct {
+		name   string
+		policy RateLimitPolicy
+		steps  []rateLimitStep
+	}{
+		{
+			name:   "the bucket empties and refills at the policy rate",
+			policy: second,
+			steps: []rateLimitStep{
+				{at: 0, want: RateLimitResult{Allowed: true, Remaining: 1, Reset: 500 * time.Millisecond}},
+				{at: 0, want: RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}},
+				{at: 0, want: RateLimitResult{Allowed: false, Remaining: 0, Reset: time.Second, RetryAfter: 500 * time.Millisecond}},
+				{at: 250 * time.Millisecond, want: RateLimitResult{Allowed: false, Remaining: 0, Reset: 750 * time.Millisecond, RetryAfter: 250 * time.Millisecond}},
+				{at: 500 * time.Millisecond, want: RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}},
+				{at: 2 * time.Second, want: RateLimitResult{Allowed: true, Remaining: 1, Reset: 500 * time.Millisecond}},
+			},
+		},
+		{
+			name:   "keys have their own buckets",
+			policy: RateLimitPolicy{Name: "api", Limit: 1, Window: time.Second},
+			steps: []rateLimitStep{
+				{key: "user:u1", want: RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}},
+				{key: "user:u2", want: RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}},
+				{key: "user:u1", want: RateLimitResult{Allowed: false, Remaining: 0, Reset: time.Second, RetryAfter: time.Second}},
+			},
+		},
+		{
+			name:   "burst allows more than the limit at once",
+			policy: RateLimitPolicy{Name: "api", Limit: 1, Window: time.Second, Burst: 3},
+			steps: []rateLimitStep{
+				{want: RateLimitResult{Allowed: true, Remaining: 2, Reset: time.Second}},
+				{want: RateLimitResult{Allowed: true, Remaining: 1, Reset: 2 * time.Second}},
+				{want: RateLimitResult{Allowed: true, Remaining: 0, Reset: 3 * time.Second}},
+				{want: RateLimitResult{Allowed: false, Remaining: 0, Reset: 3 * time.Second, RetryAfter: time.Second}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newFakeClock()
+			limiter := &localRateLimiter{buckets: map[string]*bucket{}, now: clock.now}
+			runRateLimitSteps(t, limiter, clock, tt.policy, tt.steps)
+		})
+	}
+}
+
+func TestLocalRateLimiterSweep(t *testing.T) {
+	clock := newFakeClock()
+	limiter := &localRateLimiter{buckets: map[string]*bucket{}, now: clock.now}
+	ctx := context.Background()
+	fast := RateLimitPolicy{Name: "api", Limit: 10, Window: time.Second}
+	slow := RateLimitPolicy{Name: "login", Limit: 1, Window: time.Hour}
+
+	_, _ = limiter.Allow(ctx, "user:u1", fast)
+	_, _ = limiter.Allow(ctx, "ip:1", slow)
+	require.Len(t, limiter.buckets, 2)
+
+	// Before the sweep interval nothing is dropped, even if the bucket is full again.
+	clock.advance(bucketSweepInterval / 2)
+	_, _ = limiter.Allow(ctx, "user:u2", fast)
+	assert.Len(t, limiter.buckets, 3)
+
+	// Refilled buckets are dropped; the one still waiting for its hour is kept.
+	clock.advance(bucketSweepInterval)
+	_, _ = limiter.Allow(ctx, "user:u3", fast)
+	assert.Contains(t, limiter.buckets, "ip:1")
+	assert.Contains(t, limiter.buckets, "user:u3")
+	assert.NotContains(t, limiter.buckets, "user:u1")
+	assert.NotContains(t, limiter.buckets, "user:u2")
+
+	// A swept key starts again with a full bucket.
+	got, err := limiter.Allow(ctx, "user:u1", fast)
+	require.NoError(t, err)
+	assert.Equal(t, 9, got.Remaining)
+}
+
+func TestRedisRateLimiter(t *testing.T) {
+	policy := RateLimitPolicy{Name: "api", Limit: 2, Window: time.Second}
+
+	tests := []struct {
+		name  string
+		steps []rateLimitStep
+	}{
+		{
+			name: "counts the requests of the sliding window",
+			steps: []rateLimitStep{
+				{at: 0, want: RateLimitResult{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{at: 100 * time.Millisecond, want: RateLimitResult{Allowed: true, Remaining: 0, Reset: 900 * time.Millisecond}},
+				{at: 400 * time.Millisecond, want: RateLimitResult{Allowed: false, Remaining: 0, Reset: 600 * time.Millisecond, RetryAfter: 600 * time.Millisecond}},
+				{at: 1000 * time.Millisecond, want: RateLimitResult{Allowed: true, Remaining: 0, Reset: 100 * time.Millisecond}},
+				{at: 1050 * time.Millisecond, want: RateLimitResult{Allowed: false, Remaining: 0, Reset: 50 * time.Millisecond, RetryAfter: 50 * time.Millisecond}},
+			},
+		},
+		{
+			name: "keys are counted apart",
+			steps: []rateLimitStep{
+				{key: "user:u1", want: RateLimitResult{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{key: "user:u1", want: RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}},
+				{key: "user:u2", want: RateLimitResult{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{key: "user:u1", want: RateLimitResult{Allowed: false, Remaining: 0, Reset: time.Second, RetryAfter: time.Second}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, mr := testRedis(t)
+			clock := newFakeClock()
+			limiter := &redisRateLimiter{cache: cache, prefix: "ratelimit", now: clock.now}
+
+			runRateLimitSteps(t, limiter, clock, policy, tt.steps)
+			assert.True(t, mr.Exists("ratelimit:user:u1"))
+		})
+	}
+}
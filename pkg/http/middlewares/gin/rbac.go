@@ -0,0 +1,130 @@
+package pkgmwr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+const (
+	// DefaultSubjectClaim is the JWT claim identifying the user.
+	DefaultSubjectClaim = "sub"
+	// PermissionsContextKey is the gin context key where the resolved permissions are stored.
+	PermissionsContextKey = "permissions"
+
+	permissionWildcard = "*"
+)
+
+// PermissionResolver returns the permission names granted to a subject.
+type PermissionResolver interface {
+	ResolvePermissions(ctx context.Context, subject string) ([]string, error)
+}
+
+// RBAC builds permission-checking middlewares for a resolver.
+type RBAC struct {
+	cfg      pkgutils.Config
+	resolver PermissionResolver
+	claim    string
+}
+
+// NewRBAC creates an RBAC that reads the subject from the given JWT claim
+// (DefaultSubjectClaim when empty). Its middlewares must run after Validate.
+func NewRBAC(cfg pkgutils.Config, resolver PermissionResolver, claim string) *RBAC {
+	if claim == "" {
+		claim = DefaultSubjectClaim
+	}
+	return &RBAC{cfg: cfg, resolver: resolver, claim: claim}
+}
+
+// RequirePermission aborts with 403 unless the caller holds the permission. For
+// API keys the permission must also be covered by the key scopes.
+// Permissions have the form "resource:action"; "resource:*" and "*" act as wildcards.
+func (r *RBAC) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subject, err := r.subject(c)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		granted, resolveErr := r.resolver.ResolvePermissions(c.Request.Context(), subject)
+		if resolveErr != nil {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to resolve permissions", resolveErr))
+			return
+		}
+		if scopes, ok := ScopesFromContext(c); ok {
+			granted = limitToScopes(granted, scopes)
+		}
+		if !HasPermission(granted, permission) {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthorization, "missing permission "+permission, nil))
+			return
+		}
+
+		c.Set(PermissionsContextKey, granted)
+		c.Next()
+	}
+}
+
+// RequireSelfOrPermission lets the request through when the path parameter param
+// names the caller itself, and otherwise requires the permission, as
+// RequirePermission does. API keys always need the permission in their scopes.
+func (r *RBAC) RequireSelfOrPermission(param, permission string) gin.HandlerFunc {
+	require := r.RequirePermission(permission)
+	return func(c *gin.Context) {
+		subject, err := r.subject(c)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+		if _, isAPIKey := ScopesFromContext(c); !isAPIKey && subject == c.Param(param) {
+			c.Next()
+			return
+		}
+		require(c)
+	}
+}
+
+// subject returns the caller set by Subject or APIKey, falling back to the JWT claim.
+func (r *RBAC) subject(c *gin.Context) (string, *pkgtypes.Error) {
+	if subject, ok := SubjectFromContext(c); ok {
+		return subject, nil
+	}
+	value, exists := c.Get(r.cfg.ContextKey)
+	token, ok := value.(*jwt.Token)
+	if !exists || !ok {
+		return "", pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing token", nil)
+	}
+	subject, err := pkgutils.ExtractClaim(token, r.claim)
+	if err != nil || subject == "" {
+		return "", pkgtypes.NewError(pkgtypes.ErrAuthentication, "token has no subject", err)
+	}
+	return subject, nil
+}
+
+// limitToScopes keeps the scopes the user still holds: an API key never grants
+// more than its owner has, nor more than it was created with.
+func limitToScopes(granted, scopes []string) []string {
+	effective := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if HasPermission(granted, s) {
+			effective = append(effective, s)
+		}
+	}
+	return effective
+}
+
+// HasPermission reports whether the granted permissions cover the required one.
+func HasPermission(granted []string, required string) bool {
+	resource, _, _ := strings.Cut(required, ":")
+	for _, g := range granted {
+		if g == required || g == permissionWildcard || g == resource+":"+permissionWildcard {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package pkgmwr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+// staticResolver grants the same permissions to every subject and counts lookups.
+type staticResolver struct {
+	permissions []string
+	calls       int
+}
+
+func (r *staticResolver) ResolvePermissions(context.Context, string) ([]string, error) {
+	r.calls++
+	return r.permissions, nil
+}
+
+func TestRequireSelfOrPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		path        string
+		granted     []string
+		scopes      []string
+		wantStatus  int
+		wantLookups int
+	}{
+		{name: "own account", path: "/users/u1", wantStatus: http.StatusOK},
+		{name: "another account without permission", path: "/users/u2", granted: []string{"user:read"}, wantStatus: http.StatusForbidden, wantLookups: 1},
+		{name: "another account with permission", path: "/users/u2", granted: []string{"user:manage"}, wantStatus: http.StatusOK, wantLookups: 1},
+		{name: "API key on its owner needs the scope", path: "/users/u1", granted: []string{"*"}, scopes: []string{"project:read"}, wantStatus: http.StatusForbidden, wantLookups: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &staticResolver{permissions: tt.granted}
+			rbac := NewRBAC(pkgutils.Config{ContextKey: testTokenKey}, resolver, "")
+
+			router := gin.New()
+			router.Use(ErrorHandlingMiddleware(), withClaims(jwt.MapClaims{"sub": "u1"}))
+			if tt.scopes != nil {
+				router.Use(func(c *gin.Context) { c.Set(ScopesContextKey, tt.scopes) })
+			}
+			router.GET("/users/:id", rbac.RequireSelfOrPermission("id", "user:manage"), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			assert.Equal(t, tt.wantLookups, resolver.calls)
+		})
+	}
+}
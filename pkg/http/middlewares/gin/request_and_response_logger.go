@@ -3,12 +3,10 @@ package pkgmwr
 import (
 	"bytes"
 	"io"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
-	"go-micro.dev/v4/logger"
 )
 
 type HttpLoggingOptions struct {
@@ -18,13 +16,12 @@ type HttpLoggingOptions struct {
 	ExcludedPaths  []string
 }
 
-// INFO: registra y loggea las solicitudes HTTP entrantes y las respuestas salientes
+// INFO: registra y loggea las solicitudes HTTP entrantes y las respuestas salientes.
+// Los logs salen por slog con el request_id del contexto (ver RequestID).
 func RequestAndResponseLogger(options HttpLoggingOptions) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		log.Println("Request and Response Logger Middleware: Starting...")
-		// Generar un ID único para la solicitud
-		requestID := uuid.New().String()
-		c.Set("RequestID", requestID)
+		// Reusar el ID de RequestID o generar uno si no corrió antes
+		ensureRequestID(c)
 
 		// Verificar si la ruta está excluida
 		for _, path := range options.ExcludedPaths {
@@ -34,41 +31,48 @@ func RequestAndResponseLogger(options HttpLoggingOptions) gin.HandlerFunc {
 			}
 		}
 
-		// Registrar la solicitud entrante con el RequestID
+		ctx := c.Request.Context()
 		startTime := time.Now()
-		logger.Infof("[%s] Incoming request: %s %s", requestID, c.Request.Method, c.Request.URL.Path)
+		slog.InfoContext(ctx, "incoming request", "method", c.Request.Method, "path", c.Request.URL.Path)
 
 		if options.IncludeHeaders {
 			headers := make(map[string][]string)
 			for k, v := range c.Request.Header {
-				// Ejemplo: Omite headers que contienen información sensible
-				if k != "Authorization" && k != "Cookie" {
+				// Omite headers que contienen información sensible
+				if k != "Authorization" && k != "Cookie" && k != APIKeyHeader {
 					headers[k] = v
 				}
 			}
-			logger.Infof("[%s] Request headers: %v", requestID, headers)
+			slog.DebugContext(ctx, "request headers", "headers", headers)
 		}
 
 		if options.IncludeBody {
 			// Leer el cuerpo de la solicitud
 			bodyBytes, err := io.ReadAll(c.Request.Body)
 			if err == nil {
-				// Registrar el cuerpo
-				logger.Infof("[%s] Request body: %s", requestID, string(bodyBytes))
+				slog.DebugContext(ctx, "request body", "body", string(bodyBytes))
 				// Restaurar el cuerpo para que los handlers posteriores puedan leerlo
 				c.Request.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 			} else {
-				logger.Errorf("[%s] Failed to read request body: %v", requestID, err)
+				slog.ErrorContext(ctx, "failed to read request body", "error", err)
 			}
 		}
 
 		// Procesar la solicitud
 		c.Next()
 
-		// Registrar la respuesta saliente
-		endTime := time.Now()
-		latency := endTime.Sub(startTime)
-		statusCode := c.Writer.Status()
-		logger.Infof("[%s] Response: %d, Latency: %v", requestID, statusCode, latency)
+		// Registrar la respuesta saliente; el contexto ya tiene usuario y tenant
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		if status >= 500 {
+			level = slog.LevelError
+		}
+		slog.Log(c.Request.Context(), level, "response",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", status,
+			"latency_ms", float64(time.Since(startTime).Microseconds())/1000,
+			"bytes", c.Writer.Size(),
+		)
 	}
 }
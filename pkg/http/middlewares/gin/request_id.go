@@ -0,0 +1,65 @@
+package pkgmwr
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+)
+
+const (
+	// RequestIDHeader carries the request ID in both directions.
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDContextKey is the gin context key where the request ID is stored.
+	RequestIDContextKey = "RequestID"
+)
+
+// maxRequestIDLength bounds incoming IDs; longer ones are replaced.
+const maxRequestIDLength = 128
+
+// RequestID keeps the caller's X-Request-ID, or generates one, and returns it in
+// the response. The ID is stored under RequestIDContextKey and in the request
+// context (pkglogger.WithRequestID), so every log written with that context
+// carries it. It should be the first global middleware.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ensureRequestID(c)
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the ID stored by RequestID.
+func RequestIDFromContext(c *gin.Context) (string, bool) {
+	id := c.GetString(RequestIDContextKey)
+	return id, id != ""
+}
+
+func ensureRequestID(c *gin.Context) string {
+	if id, ok := RequestIDFromContext(c); ok {
+		return id
+	}
+	id := c.GetHeader(RequestIDHeader)
+	if !validRequestID(id) {
+		id = uuid.New().String()
+	}
+	c.Set(RequestIDContextKey, id)
+	c.Header(RequestIDHeader, id)
+	c.Request = c.Request.WithContext(pkglogger.WithRequestID(c.Request.Context(), id))
+	return id
+}
+
+// validRequestID accepts IDs that are safe to echo and to write to logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':', r == '/', r == '+', r == '=':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package pkgmwr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		header   string
+		wantKept bool
+	}{
+		{name: "a valid ID is kept", header: "req-42_a.b:c/d+e=", wantKept: true},
+		{name: "a missing ID is generated"},
+		{name: "an ID with forbidden characters is replaced", header: "abc\r\nX-Injected: 1"},
+		{name: "an ID with spaces is replaced", header: "abc def"},
+		{name: "an oversized ID is replaced", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ginID, ctxID string
+			r := gin.New()
+			r.Use(RequestID())
+			r.GET("/", func(c *gin.Context) {
+				ginID, _ = RequestIDFromContext(c)
+				ctxID, _ = pkglogger.RequestIDFromContext(c.Request.Context())
+				c.Status(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			echoed := w.Header().Get(RequestIDHeader)
+			require.NotEmpty(t, echoed)
+			assert.Equal(t, echoed, ginID)
+			assert.Equal(t, echoed, ctxID)
+			if tt.wantKept {
+				assert.Equal(t, tt.header, echoed)
+				return
+			}
+			_, err := uuid.Parse(echoed)
+			assert.NoError(t, err, "a generated ID is a UUID")
+		})
+	}
+}
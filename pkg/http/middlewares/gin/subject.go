@@ -0,0 +1,69 @@
+package pkgmwr
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+// SubjectContextKey is the gin context key where the authenticated user ID is stored.
+const SubjectContextKey = "subject"
+
+const (
+	// SessionClaim is the JWT claim with the ID of the session the token belongs to.
+	SessionClaim = pkgutils.SessionClaim
+	// SessionContextKey is the gin context key where Subject stores SessionClaim,
+	// when the token has one.
+	SessionContextKey = "session"
+)
+
+// Subject reads the user ID from the given JWT claim (DefaultSubjectClaim when
+// empty) and stores it under SubjectContextKey and in the request context
+// (pkgtypes.WithUserID). It must run after Validate; requests already
+// authenticated by APIKey pass through.
+func Subject(cfg pkgutils.Config, claim string) gin.HandlerFunc {
+	if claim == "" {
+		claim = DefaultSubjectClaim
+	}
+	return func(c *gin.Context) {
+		if _, ok := SubjectFromContext(c); ok {
+			c.Next() // Already authenticated by APIKey.
+			return
+		}
+		value, exists := c.Get(cfg.ContextKey)
+		token, ok := value.(*jwt.Token)
+		if !exists || !ok {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing token", nil))
+			return
+		}
+		subject, err := pkgutils.ExtractClaim(token, claim)
+		if err != nil || subject == "" {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthentication, "token has no subject", err))
+			return
+		}
+		c.Set(SubjectContextKey, subject)
+		if session, err := pkgutils.ExtractClaim(token, SessionClaim); err == nil && session != "" {
+			c.Set(SessionContextKey, session)
+		}
+		ctx := pkgtypes.WithUserID(c.Request.Context(), subject)
+		c.Request = c.Request.WithContext(pkglogger.WithAttrs(ctx, slog.String("user_id", subject)))
+		c.Next()
+	}
+}
+
+// SubjectFromContext returns the user ID stored by Subject.
+func SubjectFromContext(c *gin.Context) (string, bool) {
+	subject := c.GetString(SubjectContextKey)
+	return subject, subject != ""
+}
+
+// SessionFromContext returns the session ID stored by Subject.
+func SessionFromContext(c *gin.Context) (string, bool) {
+	session := c.GetString(SessionContextKey)
+	return session, session != ""
+}
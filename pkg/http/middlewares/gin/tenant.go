@@ -0,0 +1,57 @@
+package pkgmwr
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+const (
+	// DefaultTenantClaim is the JWT claim carrying the organization ID.
+	DefaultTenantClaim = "tenant_id"
+	// TenantContextKey is the gin context key where the tenant ID is stored.
+	TenantContextKey = "tenantID"
+)
+
+// Tenant reads the organization from the JWT claims and puts it in the request
+// context, where the GORM tenant scope picks it up. It must run after Validate,
+// which stores the parsed token under cfg.ContextKey.
+func Tenant(cfg pkgutils.Config, claim string) gin.HandlerFunc {
+	if claim == "" {
+		claim = DefaultTenantClaim
+	}
+	return func(c *gin.Context) {
+		if _, exists := c.Get(TenantContextKey); exists {
+			c.Next() // Already set by APIKey.
+			return
+		}
+		value, exists := c.Get(cfg.ContextKey)
+		token, ok := value.(*jwt.Token)
+		if !exists || !ok {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing token", nil))
+			return
+		}
+
+		raw, err := pkgutils.ExtractClaim(token, claim)
+		if err != nil {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthorization, "token has no organization", err))
+			return
+		}
+		tenantID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || tenantID <= 0 {
+			abortWithError(c, pkgtypes.NewError(pkgtypes.ErrAuthorization, "invalid organization in token", err))
+			return
+		}
+
+		c.Set(TenantContextKey, tenantID)
+		ctx := pkgtypes.WithTenantID(c.Request.Context(), tenantID)
+		c.Request = c.Request.WithContext(pkglogger.WithAttrs(ctx, slog.Int64("tenant_id", tenantID)))
+		c.Next()
+	}
+}
@@ -0,0 +1,75 @@
+package pkgmwr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+const testTokenKey = "token"
+
+// withClaims stands in for Validate, leaving a parsed token with claims in the context.
+func withClaims(claims jwt.MapClaims) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if claims != nil {
+			c.Set(testTokenKey, &jwt.Token{Claims: claims})
+		}
+		c.Next()
+	}
+}
+
+func TestTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := pkgutils.Config{ContextKey: testTokenKey}
+
+	tests := []struct {
+		name       string
+		claims     jwt.MapClaims
+		preset     int64
+		wantStatus int
+		wantTenant int64
+	}{
+		{name: "numeric claim", claims: jwt.MapClaims{"tenant_id": float64(7)}, wantStatus: http.StatusOK, wantTenant: 7},
+		{name: "string claim", claims: jwt.MapClaims{"tenant_id": "7"}, wantStatus: http.StatusOK, wantTenant: 7},
+		{name: "tenant set by an API key", preset: 9, wantStatus: http.StatusOK, wantTenant: 9},
+		{name: "missing token", wantStatus: http.StatusUnauthorized},
+		{name: "token without organization", claims: jwt.MapClaims{"sub": "u1"}, wantStatus: http.StatusForbidden},
+		{name: "invalid organization", claims: jwt.MapClaims{"tenant_id": "abc"}, wantStatus: http.StatusForbidden},
+		{name: "zero organization", claims: jwt.MapClaims{"tenant_id": "0"}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandlingMiddleware(), withClaims(tt.claims))
+			if tt.preset != 0 {
+				router.Use(func(c *gin.Context) {
+					c.Set(TenantContextKey, tt.preset)
+					c.Request = c.Request.WithContext(pkgtypes.WithTenantID(c.Request.Context(), tt.preset))
+				})
+			}
+			router.GET("/", Tenant(cfg, ""), func(c *gin.Context) {
+				tenantID, _ := pkgtypes.TenantIDFromContext(c.Request.Context())
+				c.String(http.StatusOK, strconv.FormatInt(tenantID, 10))
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, strconv.FormatInt(tt.wantTenant, 10), w.Body.String())
+			} else {
+				assert.Equal(t, pkgtypes.ProblemContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
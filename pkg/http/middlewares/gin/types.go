@@ -3,7 +3,20 @@ package pkgmwr
 import "github.com/gin-gonic/gin"
 
 type Middlewares struct {
-	Global    []gin.HandlerFunc
-	Validated []gin.HandlerFunc
-	Protected []gin.HandlerFunc
+	Global     []gin.HandlerFunc
+	Validated  []gin.HandlerFunc
+	Protected  []gin.HandlerFunc
+	Idempotent []gin.HandlerFunc
+	Tenant     []gin.HandlerFunc
+
+	// RequirePermission returns a middleware that checks a single permission.
+	RequirePermission func(permission string) gin.HandlerFunc
+	// RequireSelfOrPermission returns a middleware that lets callers act on
+	// themselves (the user ID in the path parameter) and requires the
+	// permission to act on anyone else.
+	RequireSelfOrPermission func(param, permission string) gin.HandlerFunc
+	// RateLimit returns the middleware of a named rate limit policy.
+	RateLimit func(policy string) gin.HandlerFunc
+	// Cache returns the middleware of a named cache policy.
+	Cache func(policy string) gin.HandlerFunc
 }
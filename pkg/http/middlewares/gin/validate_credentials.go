@@ -1,8 +1,6 @@
 package pkgmwr
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
@@ -22,17 +20,13 @@ func ValidateCredentials() gin.HandlerFunc {
 
 		// Try binding the JSON payload to the struct.
 		if err := ctx.ShouldBindJSON(&credentials); err != nil {
-			apiErr := pkgtypes.NewError(pkgtypes.ErrValidation, errInvalidPayload, err)
-			ctx.JSON(http.StatusBadRequest, apiErr.ToJSON())
-			ctx.Abort()
+			abortWithError(ctx, pkgtypes.NewError(pkgtypes.ErrValidation, errInvalidPayload, err))
 			return
 		}
 
 		// Validate that at least one of the optional fields is present.
 		if credentials.Username == "" && credentials.Email == "" {
-			apiErr := pkgtypes.NewMissingFieldError("username/email")
-			ctx.JSON(http.StatusBadRequest, apiErr.ToJSON())
-			ctx.Abort()
+			abortWithError(ctx, pkgtypes.NewMissingFieldError("username/email"))
 			return
 		}
 
@@ -4,11 +4,12 @@ import (
 	"crypto/rsa"
 	"fmt"
 	"log"
-	"net/http"
+	"log/slog"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
 )
 
@@ -27,33 +28,53 @@ func Validate(cfg pkgutils.Config) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenStr, err := pkgutils.ExtractTokenFromRequest(c.Request, cfg)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
-			c.Abort()
+			abortWithError(c, pkgtypes.NewAuthenticationError(err.Error(), nil))
 			return
 		}
 
 		// Parse the token without verifying to get the signing method.
 		unverifiedToken, _, err := new(jwt.Parser).ParseUnverified(tokenStr, jwt.MapClaims{})
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("invalid token: %v", err)})
-			c.Abort()
+			abortWithError(c, pkgtypes.NewAuthenticationError(fmt.Sprintf("invalid token: %v", err), nil))
 			return
 		}
 
+		// Tokens with a key ID are verified with the matching key of the key set.
 		keyFunc := pkgutils.SelectKeyFunc(unverifiedToken, cfg.SecretKey, rsaPublicKey)
+		if _, hasKid := unverifiedToken.Header["kid"]; hasKid && cfg.Keys != nil {
+			keyFunc = pkgutils.KeyIDFunc(c.Request.Context(), cfg.Keys)
+		}
 		if keyFunc == nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "unexpected signing method"})
-			c.Abort()
+			abortWithError(c, pkgtypes.NewAuthenticationError("unexpected signing method", nil))
 			return
 		}
 
 		parsedToken, err := jwt.Parse(tokenStr, keyFunc)
 		if err != nil || !parsedToken.Valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("invalid token: %v", err)})
-			c.Abort()
+			abortWithError(c, pkgtypes.NewAuthenticationError(fmt.Sprintf("invalid token: %v", err), nil))
+			return
+		}
+		// Refresh tokens are signed with the same key; they only work on /auth/refresh.
+		if err := pkgutils.CheckAccessToken(parsedToken); err != nil {
+			abortWithError(c, pkgtypes.NewAuthenticationError(fmt.Sprintf("invalid token: %v", err), nil))
 			return
 		}
 
+		// Reject tokens revoked before their expiry (logout, revoked session, forced logout).
+		if cfg.Denylist != nil {
+			claims, _ := parsedToken.Claims.(jwt.MapClaims)
+			revoked, err := cfg.Denylist.IsRevoked(c.Request.Context(), claims)
+			if err != nil {
+				slog.ErrorContext(c.Request.Context(), "failed to check token revocation", "error", err)
+				abortWithError(c, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to check token revocation", err))
+				return
+			}
+			if revoked {
+				abortWithError(c, pkgtypes.NewAuthenticationError("token has been revoked", nil))
+				return
+			}
+		}
+
 		// Save the token and claims in the Gin context.
 		c.Set(cfg.ContextKey, parsedToken)
 		c.Set(pkgutils.GetClaimsKey(cfg.ContextKey), parsedToken.Claims)
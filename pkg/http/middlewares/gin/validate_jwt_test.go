@@ -0,0 +1,64 @@
+package pkgmwr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+func TestValidateAcceptsOnlyAccessTokens(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const secret = "test-secret"
+	svc, err := pkgjwt.Bootstrap(secret, 15, 60)
+	require.NoError(t, err)
+	tokens, err := svc.GenerateTokensWithClaims(context.Background(), "u1", map[string]any{SessionClaim: "s1"}, 0, 0)
+	require.NoError(t, err)
+
+	sign := func(claims jwt.MapClaims) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return signed
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "access token", token: tokens.AccessToken, wantStatus: http.StatusOK},
+		{name: "refresh token", token: tokens.RefreshToken, wantStatus: http.StatusUnauthorized},
+		{name: "token without type", token: sign(jwt.MapClaims{"sub": "u1", "exp": exp}), wantStatus: http.StatusUnauthorized},
+		{name: "token of another type", token: sign(jwt.MapClaims{"sub": "u1", "exp": exp, "typ": "id"}), wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := pkgutils.Config{SecretKey: secret, TokenLookup: "header:Authorization", TokenPrefix: "Bearer ", ContextKey: testTokenKey}
+			router := gin.New()
+			router.Use(ErrorHandlingMiddleware())
+			router.POST("/auth/password/change", Validate(cfg), Subject(cfg, ""), func(c *gin.Context) {
+				session, _ := SessionFromContext(c)
+				assert.Equal(t, "s1", session)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/password/change", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
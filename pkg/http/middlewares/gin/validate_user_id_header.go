@@ -1,9 +1,9 @@
 package pkgmwr
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 )
 
 // ValidateUserIDHeader verifica que el header 'X-User-ID' esté presente en la solicitud.
@@ -11,11 +11,13 @@ func ValidateUserIDHeader() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := c.GetHeader("X-User-ID")
 		if userID == "" {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error":   "MISSING_USER_ID",
-				"message": "The 'X-User-ID' header is required",
-			})
-			c.Abort() // Detiene la ejecución de la solicitud
+			// Detiene la ejecución de la solicitud
+			abortWithError(c, pkgtypes.NewErrorWithContext(
+				pkgtypes.ErrMissingField,
+				"The 'X-User-ID' header is required",
+				nil,
+				map[string]any{"header": "X-User-ID"},
+			))
 			return
 		}
 		// Guarda el userID en el contexto para que los handlers puedan acceder
@@ -38,7 +38,11 @@ func Validate(cfg pkgutils.Config, next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
+		// Los tokens con kid se verifican con la clave correspondiente del key set.
 		keyFunc := pkgutils.SelectKeyFunc(unverifiedToken, cfg.SecretKey, rsaPublicKey)
+		if _, hasKid := unverifiedToken.Header["kid"]; hasKid && cfg.Keys != nil {
+			keyFunc = pkgutils.KeyIDFunc(r.Context(), cfg.Keys)
+		}
 		if keyFunc == nil {
 			http.Error(w, "unexpected signing method", http.StatusUnauthorized)
 			return
@@ -49,6 +53,26 @@ func Validate(cfg pkgutils.Config, next http.HandlerFunc) http.HandlerFunc {
 			http.Error(w, fmt.Sprintf("invalid token: %v", err), http.StatusUnauthorized)
 			return
 		}
+		// Los refresh tokens se firman con la misma clave; sólo sirven para renovar la sesión.
+		if err := pkgutils.CheckAccessToken(parsedToken); err != nil {
+			http.Error(w, fmt.Sprintf("invalid token: %v", err), http.StatusUnauthorized)
+			return
+		}
+
+		// Rechazar los tokens revocados antes de expirar.
+		if cfg.Denylist != nil {
+			claims, _ := parsedToken.Claims.(jwt.MapClaims)
+			revoked, err := cfg.Denylist.IsRevoked(r.Context(), claims)
+			if err != nil {
+				log.Printf("failed to check token revocation: %v", err)
+				http.Error(w, "failed to check token revocation", http.StatusServiceUnavailable)
+				return
+			}
+			if revoked {
+				http.Error(w, "token has been revoked", http.StatusUnauthorized)
+				return
+			}
+		}
 
 		// Añadir el token y los claims al contexto de la request.
 		ctx := r.Context()
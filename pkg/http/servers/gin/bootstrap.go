@@ -2,14 +2,20 @@ package pkggin
 
 import (
 	"fmt"
-	"net/http"
+	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 )
 
-func Bootstrap(port, version string, isTest bool) (Server, error) {
+// Bootstrap arma el servidor desde las variables de entorno. health es el registry
+// que consultan /readyz y /health; con nil el servidor usa uno propio y vacío.
+func Bootstrap(port, version string, isTest bool, health *pkghealth.Registry) (Server, error) {
 	if gin.Mode() == gin.TestMode {
 		return newTestServer()
 	}
@@ -25,6 +31,24 @@ func Bootstrap(port, version string, isTest bool) (Server, error) {
 		port,
 		version,
 	)
+	config.SetTimeouts(Timeouts{
+		ReadHeader: envDuration("HTTP_SERVER_READ_HEADER_TIMEOUT"),
+		Read:       envDuration("HTTP_SERVER_READ_TIMEOUT"),
+		Write:      envDuration("HTTP_SERVER_WRITE_TIMEOUT"),
+		Idle:       envDuration("HTTP_SERVER_IDLE_TIMEOUT"),
+		Shutdown:   envDuration("HTTP_SERVER_SHUTDOWN_TIMEOUT"),
+	})
+	config.SetMaxHeaderBytes(envInt("HTTP_SERVER_MAX_HEADER_BYTES"))
+	if certFile := os.Getenv("HTTP_SERVER_TLS_CERT_FILE"); certFile != "" {
+		config.SetTLSConfig(&TLSConfig{
+			CertFile:     certFile,
+			KeyFile:      os.Getenv("HTTP_SERVER_TLS_KEY_FILE"),
+			ClientCAFile: os.Getenv("HTTP_SERVER_TLS_CLIENT_CA_FILE"),
+		})
+	}
+	config.SetH2C(os.Getenv("HTTP_SERVER_H2C") == "true")
+	config.SetTrustedProxies(envList("TRUSTED_PROXIES"))
+	config.SetHealth(health)
 
 	if err := config.Validate(); err != nil {
 		return nil, err
@@ -35,7 +59,19 @@ func Bootstrap(port, version string, isTest bool) (Server, error) {
 		return nil, err
 	}
 
-	r := Server.GetRouter()
+	registry := config.GetHealth()
+	registry.SetTimeout(envDuration("HEALTH_CHECK_TIMEOUT"))
+	registry.SetCacheTTL(envDuration("HEALTH_CACHE_TTL"))
+	registerHealthRoutes(Server.GetRouter(), version, registry)
+
+	return Server, nil
+}
+
+// registerHealthRoutes agrega los probes de Kubernetes: /livez sólo confirma que el
+// proceso responde; /readyz consulta las dependencias del registry.
+func registerHealthRoutes(r *gin.Engine, version string, health *pkghealth.Registry) {
+	r.GET("/livez", pkghealth.LivenessHandler())
+	r.GET("/readyz", pkghealth.ReadinessHandler(health))
 
 	api := r.Group(fmt.Sprintf("/api/%s", version))
 	{
@@ -43,13 +79,45 @@ func Bootstrap(port, version string, isTest bool) (Server, error) {
 			c.JSON(200, gin.H{"message": "pong"})
 		})
 
-		api.GET("/health", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{
-				"status":    "healthy",
-				"timestamp": time.Now(),
-			})
-		})
+		api.GET("/health", pkghealth.ReadinessHandler(health))
 	}
+}
 
-	return Server, nil
+// envDuration lee una duración ("30s", "2m"); vacía o inválida retorna cero y se usa el default.
+func envDuration(key string) time.Duration {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		log.Printf("[HTTP] invalid %s %q, using default: %v", key, raw, err)
+		return 0
+	}
+	return d
+}
+
+// envList lee una lista separada por comas; vacía retorna nil.
+func envList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// envInt lee un entero positivo; vacío o inválido retorna cero y se usa el default.
+func envInt(key string) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		log.Printf("[HTTP] invalid %s %q, using default: must be a positive integer", key, raw)
+		return 0
+	}
+	return n
 }
@@ -1,18 +1,59 @@
 package pkggin
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
+)
+
+// Valores por defecto de los timeouts del http.Server.
+const (
+	defaultReadHeaderTimeout = 10 * time.Second
+	defaultReadTimeout       = 30 * time.Second
+	defaultWriteTimeout      = 30 * time.Second
+	defaultIdleTimeout       = 120 * time.Second
+	defaultShutdownTimeout   = 15 * time.Second
 )
 
+// TLSConfig habilita HTTPS. Si ClientCAFile está definido se exige certificado
+// de cliente firmado por esa CA (mTLS).
+type TLSConfig struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
+// Timeouts agrupa los límites del http.Server. Los valores en cero toman el default.
+type Timeouts struct {
+	ReadHeader time.Duration
+	Read       time.Duration
+	Write      time.Duration
+	Idle       time.Duration
+	// Shutdown es cuánto se espera a que terminen las requests en curso al apagar.
+	Shutdown time.Duration
+}
+
 type config struct {
-	routerPort string
-	apiVersion string
+	routerPort     string
+	apiVersion     string
+	timeouts       Timeouts
+	maxHeaderBytes int
+	tlsConfig      *TLSConfig
+	h2c            bool
+	trustedProxies []string
+	health         *pkghealth.Registry
 }
 
 func newConfig(routerPort, ApiVersion string) Config {
 	return &config{
 		routerPort: routerPort,
 		apiVersion: ApiVersion,
+		health:     pkghealth.NewRegistry(),
 	}
 }
 
@@ -32,9 +73,119 @@ func (c *config) SetApiVersion(ApiVersion string) {
 	c.apiVersion = ApiVersion
 }
 
+// GetTimeouts retorna los timeouts con los defaults aplicados.
+func (c *config) GetTimeouts() Timeouts {
+	t := c.timeouts
+	if t.ReadHeader <= 0 {
+		t.ReadHeader = defaultReadHeaderTimeout
+	}
+	if t.Read <= 0 {
+		t.Read = defaultReadTimeout
+	}
+	if t.Write <= 0 {
+		t.Write = defaultWriteTimeout
+	}
+	if t.Idle <= 0 {
+		t.Idle = defaultIdleTimeout
+	}
+	if t.Shutdown <= 0 {
+		t.Shutdown = defaultShutdownTimeout
+	}
+	return t
+}
+
+func (c *config) SetTimeouts(timeouts Timeouts) {
+	c.timeouts = timeouts
+}
+
+func (c *config) GetMaxHeaderBytes() int {
+	if c.maxHeaderBytes <= 0 {
+		return http.DefaultMaxHeaderBytes
+	}
+	return c.maxHeaderBytes
+}
+
+func (c *config) SetMaxHeaderBytes(maxHeaderBytes int) {
+	c.maxHeaderBytes = maxHeaderBytes
+}
+
+func (c *config) GetTLSConfig() *TLSConfig {
+	return c.tlsConfig
+}
+
+func (c *config) SetTLSConfig(tlsConfig *TLSConfig) {
+	c.tlsConfig = tlsConfig
+}
+
+func (c *config) GetH2C() bool {
+	return c.h2c
+}
+
+func (c *config) SetH2C(h2c bool) {
+	c.h2c = h2c
+}
+
+// GetTrustedProxies retorna las IPs o CIDRs de los proxies cuyos X-Forwarded-For
+// y X-Real-IP se aceptan. Vacío: no se confía en ninguno y la IP del cliente es la
+// de la conexión.
+func (c *config) GetTrustedProxies() []string {
+	return c.trustedProxies
+}
+
+func (c *config) SetTrustedProxies(trustedProxies []string) {
+	c.trustedProxies = trustedProxies
+}
+
+// GetHealth retorna el registry que consultan /readyz y /health. Por defecto es
+// uno propio y vacío, así cada servidor reporta sólo lo que se le registra.
+func (c *config) GetHealth() *pkghealth.Registry {
+	return c.health
+}
+
+// SetHealth reemplaza el registry; nil mantiene el actual.
+func (c *config) SetHealth(health *pkghealth.Registry) {
+	if health != nil {
+		c.health = health
+	}
+}
+
 func (c *config) Validate() error {
 	if c.routerPort == "" {
 		return fmt.Errorf("router port is not configured")
 	}
+	if c.tlsConfig != nil && (c.tlsConfig.CertFile == "" || c.tlsConfig.KeyFile == "") {
+		return fmt.Errorf("TLS requires both a certificate and a key file")
+	}
+	if c.tlsConfig != nil && c.h2c {
+		return fmt.Errorf("h2c cannot be combined with TLS; HTTP/2 is negotiated over TLS")
+	}
 	return nil
 }
+
+// loadTLSConfig arma la configuración TLS del servidor, con mTLS si hay CA de clientes.
+func loadTLSConfig(tlsConfig *TLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if tlsConfig.ClientCAFile == "" {
+		return cfg, nil
+	}
+
+	ca, err := os.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if ok := certPool.AppendCertsFromPEM(ca); !ok {
+		return nil, fmt.Errorf("failed to append client CA certificates")
+	}
+	cfg.ClientCAs = certPool
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg, nil
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 )
 
 // Server expone las operaciones principales de tu servidor.
@@ -20,5 +22,17 @@ type Config interface {
 	SetRouterPort(string)
 	GetApiVersion() string
 	SetApiVersion(string)
+	GetTimeouts() Timeouts
+	SetTimeouts(Timeouts)
+	GetMaxHeaderBytes() int
+	SetMaxHeaderBytes(int)
+	GetTLSConfig() *TLSConfig
+	SetTLSConfig(*TLSConfig)
+	GetH2C() bool
+	SetH2C(bool)
+	GetTrustedProxies() []string
+	SetTrustedProxies([]string)
+	GetHealth() *pkghealth.Registry
+	SetHealth(*pkghealth.Registry)
 	Validate() error
 }
@@ -2,58 +2,40 @@ package pkggin
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
-	"sync"
 
 	"github.com/gin-gonic/gin"
 )
 
-// con sigleton
-var (
-	instance  Server
-	once      sync.Once
-	initError error
-)
-
 type server struct {
 	router *gin.Engine
 	config Config
 }
 
+// newServer crea un servidor nuevo en cada llamada, así los tests pueden levantar varios.
 func newServer(config Config) (Server, error) {
-	once.Do(func() {
-		err := config.Validate()
-		if err != nil {
-			initError = err
-			return
-		}
-
-		r := gin.New()
-		instance = &server{
-			config: config,
-			router: r,
-		}
-	})
-	return instance, initError
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	r := gin.New()
+	r.UseH2C = config.GetH2C()
+	// Sin esto gin confía en X-Forwarded-For de cualquiera y el cliente elige su IP.
+	if err := r.SetTrustedProxies(config.GetTrustedProxies()); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+	return &server{
+		config: config,
+		router: r,
+	}, nil
 }
 
-// sin singleton
-// type server struct {
-// 	router *gin.Engine
-// 	config Config
-// }
-
-// func newServer(cfg Config) (Server, error) {
-// 	r := gin.Default()
-// 	return &server{
-// 		router: r,
-// 		config: cfg,
-// 	}, nil
-// }
-
 func newTestServer() (Server, error) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	_ = r.SetTrustedProxies(nil)
 
 	testConfig := &config{
 		routerPort: "8080",
@@ -66,10 +48,64 @@ func newTestServer() (Server, error) {
 	}, nil
 }
 
-// RunServer lanza el servidor en el puerto configurado.
+// RunServer escucha en el puerto configurado hasta que se cancela el contexto.
+// Al cancelarse deja de aceptar conexiones y espera a las requests en curso
+// hasta el timeout de Shutdown; las que sigan abiertas se cortan.
 func (s *server) RunServer(ctx context.Context) error {
-	// Ejemplo de "Run" bloqueante:
-	return s.router.Run(":" + s.config.GetRouterPort())
+	srv, err := s.httpServer()
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if tlsCfg := s.config.GetTLSConfig(); tlsCfg != nil {
+			errCh <- srv.ListenAndServeTLS(tlsCfg.CertFile, tlsCfg.KeyFile)
+			return
+		}
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+	}
+
+	timeout := s.config.GetTimeouts().Shutdown
+	log.Printf("[HTTP] shutting down, waiting up to %s for in-flight requests", timeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		_ = srv.Close()
+		return fmt.Errorf("HTTP server did not drain in time: %w", err)
+	}
+	return nil
+}
+
+// httpServer arma el http.Server con los timeouts, límites y TLS configurados.
+func (s *server) httpServer() (*http.Server, error) {
+	timeouts := s.config.GetTimeouts()
+	srv := &http.Server{
+		Addr:              ":" + s.config.GetRouterPort(),
+		Handler:           s.router.Handler(), // con h2c si UseH2C está activo
+		ReadHeaderTimeout: timeouts.ReadHeader,
+		ReadTimeout:       timeouts.Read,
+		WriteTimeout:      timeouts.Write,
+		IdleTimeout:       timeouts.Idle,
+		MaxHeaderBytes:    s.config.GetMaxHeaderBytes(),
+	}
+	if tlsCfg := s.config.GetTLSConfig(); tlsCfg != nil {
+		cfg, err := loadTLSConfig(tlsCfg)
+		if err != nil {
+			return nil, err
+		}
+		srv.TLSConfig = cfg
+	}
+	return srv, nil
 }
 
 // GetRouter expone el router para poder añadir rutas, middlewares, etc.
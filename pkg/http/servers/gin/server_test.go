@@ -0,0 +1,216 @@
+package pkggin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
+	pkgmwr "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+)
+
+// freePort returns a port nobody is listening on.
+func freePort(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+}
+
+// startSlowServer runs a server whose /slow handler blocks until release is
+// closed. It returns the channel closed when the handler starts and the result
+// of RunServer.
+func startSlowServer(t *testing.T, ctx context.Context, shutdown time.Duration, release <-chan struct{}) (string, <-chan struct{}, <-chan error) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	port := freePort(t)
+	cfg := newConfig(port, "v1")
+	cfg.SetTimeouts(Timeouts{Shutdown: shutdown})
+	srv, err := newServer(cfg)
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	srv.GetRouter().GET("/slow", func(c *gin.Context) {
+		close(started)
+		<-release
+		c.String(http.StatusOK, "done")
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- srv.RunServer(ctx) }()
+
+	url := "http://127.0.0.1:" + port
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", "127.0.0.1:"+port)
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err == nil
+	}, 2*time.Second, 10*time.Millisecond)
+	return url, started, done
+}
+
+type response struct {
+	status int
+	body   string
+	err    error
+}
+
+func get(url string) <-chan response {
+	ch := make(chan response, 1)
+	go func() {
+		res, err := http.Get(url)
+		if err != nil {
+			ch <- response{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		ch <- response{status: res.StatusCode, body: string(body), err: err}
+	}()
+	return ch
+}
+
+func TestRunServerDrainsInFlightRequests(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	url, started, done := startSlowServer(t, ctx, 5*time.Second, release)
+
+	inFlight := get(url + "/slow")
+	<-started
+	cancel()
+
+	// New connections are refused while the in-flight request is still running.
+	require.Eventually(t, func() bool {
+		_, err := http.Get(url + "/slow")
+		return err != nil
+	}, 2*time.Second, 10*time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("RunServer returned before the request finished: %v", err)
+	default:
+	}
+
+	close(release)
+	res := <-inFlight
+	require.NoError(t, res.err)
+	assert.Equal(t, http.StatusOK, res.status)
+	assert.Equal(t, "done", res.body)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("RunServer did not return after draining")
+	}
+}
+
+func TestRunServerCutsRequestsAfterTheShutdownTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	defer close(release)
+	url, started, done := startSlowServer(t, ctx, 100*time.Millisecond, release)
+
+	inFlight := get(url + "/slow")
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("RunServer did not give up after the shutdown timeout")
+	}
+	assert.Error(t, (<-inFlight).err)
+}
+
+func TestClientIPOnlyTrustsConfiguredProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		proxies []string
+		peer    string
+		wantKey string
+	}{
+		{name: "spoofed header from an untrusted peer", peer: "203.0.113.7:4321", wantKey: "ip:203.0.113.7"},
+		{name: "header from another peer than the proxy", proxies: []string{"10.0.0.0/8"}, peer: "203.0.113.7:4321", wantKey: "ip:203.0.113.7"},
+		{name: "header from the trusted proxy", proxies: []string{"10.0.0.0/8"}, peer: "10.1.2.3:4321", wantKey: "ip:198.51.100.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfig("8080", "v1")
+			cfg.SetTrustedProxies(tt.proxies)
+			srv, err := newServer(cfg)
+			require.NoError(t, err)
+			srv.GetRouter().GET("/key", func(c *gin.Context) {
+				c.String(http.StatusOK, pkgmwr.KeyByIP(c))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/key", nil)
+			req.RemoteAddr = tt.peer
+			req.Header.Set("X-Forwarded-For", "198.51.100.9")
+			req.Header.Set("X-Real-IP", "198.51.100.9")
+			w := httptest.NewRecorder()
+			srv.GetRouter().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantKey, w.Body.String())
+		})
+	}
+}
+
+func TestNewServerRejectsInvalidTrustedProxies(t *testing.T) {
+	cfg := newConfig("8080", "v1")
+	cfg.SetTrustedProxies([]string{"not-an-ip"})
+
+	_, err := newServer(cfg)
+
+	assert.ErrorContains(t, err, "invalid trusted proxies")
+}
+
+func TestServersReportTheirOwnHealthRegistry(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	down := pkghealth.NewRegistry()
+	down.Register("db", func(context.Context) error { return errors.New("connection refused") })
+
+	tests := []struct {
+		name       string
+		health     *pkghealth.Registry
+		wantStatus int
+	}{
+		{name: "injected registry with a failing dependency", health: down, wantStatus: http.StatusServiceUnavailable},
+		{name: "default registry is empty", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfig("8080", "v1")
+			cfg.SetHealth(tt.health)
+			srv, err := newServer(cfg)
+			require.NoError(t, err)
+			registerHealthRoutes(srv.GetRouter(), "v1", cfg.GetHealth())
+
+			for _, path := range []string{"/readyz", "/api/v1/health"} {
+				w := httptest.NewRecorder()
+				srv.GetRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+				assert.Equal(t, tt.wantStatus, w.Code, path)
+			}
+		})
+	}
+}
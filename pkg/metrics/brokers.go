@@ -0,0 +1,90 @@
+package pkgmetrics
+
+import (
+	"context"
+	"strings"
+
+	pkginmemory "github.com/alphacodinggroup/ponti-backend/pkg/brokers/inmemory"
+	pkgafka "github.com/alphacodinggroup/ponti-backend/pkg/brokers/kafka"
+	pkgrabbit "github.com/alphacodinggroup/ponti-backend/pkg/brokers/rabbitmq/amqp091/producer"
+)
+
+// ObservePublish cuenta un mensaje publicado; sirve para brokers sin decorador.
+func ObservePublish(broker, topic string, err error) {
+	brokerPublished.WithLabelValues(broker, topic, status(err)).Inc()
+}
+
+// ObserveConsume cuenta un mensaje procesado por un consumidor.
+func ObserveConsume(broker, topic string, err error) {
+	brokerConsumed.WithLabelValues(broker, topic, status(err)).Inc()
+}
+
+// InstrumentKafka cuenta las publicaciones y los mensajes consumidos por service.
+// Consume no informa el topic de cada mensaje, así que se usa la lista de topics.
+func InstrumentKafka(service pkgafka.Service) pkgafka.Service {
+	return &kafkaService{Service: service}
+}
+
+type kafkaService struct {
+	pkgafka.Service
+}
+
+func (s *kafkaService) Publish(ctx context.Context, topic string, key, value []byte) error {
+	err := s.Service.Publish(ctx, topic, key, value)
+	ObservePublish("kafka", topic, err)
+	return err
+}
+
+func (s *kafkaService) Consume(ctx context.Context, topics []string, handler func(context.Context, []byte, []byte) error) error {
+	topic := strings.Join(topics, ",")
+	return s.Service.Consume(ctx, topics, func(ctx context.Context, key, value []byte) error {
+		err := handler(ctx, key, value)
+		ObserveConsume("kafka", topic, err)
+		return err
+	})
+}
+
+// InstrumentRabbitProducer cuenta los mensajes publicados por producer, por cola.
+func InstrumentRabbitProducer(producer pkgrabbit.Producer) pkgrabbit.Producer {
+	return &rabbitProducer{Producer: producer}
+}
+
+type rabbitProducer struct {
+	pkgrabbit.Producer
+}
+
+func (p *rabbitProducer) Produce(ctx context.Context, queueName, replyTo, corrID string, message any) (string, error) {
+	id, err := p.Producer.Produce(ctx, queueName, replyTo, corrID, message)
+	ObservePublish("rabbitmq", queueName, err)
+	return id, err
+}
+
+func (p *rabbitProducer) ProduceWithRetry(ctx context.Context, queueName, replyTo, corrID string, message any, maxRetries int) (string, error) {
+	id, err := p.Producer.ProduceWithRetry(ctx, queueName, replyTo, corrID, message, maxRetries)
+	ObservePublish("rabbitmq", queueName, err)
+	return id, err
+}
+
+// InstrumentInMemory cuenta las publicaciones y las entregas a los suscriptores
+// del broker en memoria.
+func InstrumentInMemory(broker pkginmemory.Broker) pkginmemory.Broker {
+	return &inMemoryBroker{Broker: broker}
+}
+
+type inMemoryBroker struct {
+	pkginmemory.Broker
+}
+
+func (b *inMemoryBroker) Publish(ctx context.Context, topic string, key, value []byte) error {
+	err := b.Broker.Publish(ctx, topic, key, value)
+	ObservePublish("inmemory", topic, err)
+	return err
+}
+
+func (b *inMemoryBroker) Subscribe(topic string, handler pkginmemory.Handler) {
+	b.Broker.Subscribe(topic, func(ctx context.Context, msg pkginmemory.Message) error {
+		err := handler(ctx, msg)
+		ObserveConsume("inmemory", msg.Topic, err)
+		return err
+	})
+}
@@ -0,0 +1,32 @@
+package pkgmetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute agrupa las requests que no coinciden con ninguna ruta, así las
+// URLs arbitrarias (scanners, 404) no crean una serie por path.
+const unmatchedRoute = "unmatched"
+
+// Middleware cuenta las requests y mide su latencia por método, template de la
+// ruta (c.FullPath(), p. ej. /api/v1/users/:id) y status.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		code := strconv.Itoa(c.Writer.Status())
+		httpRequests.WithLabelValues(c.Request.Method, route, code).Inc()
+		httpDuration.WithLabelValues(c.Request.Method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,53 @@
+package pkgmetrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareLabels(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	httpRequests.Reset()
+	httpDuration.Reset()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(httpRequests)
+
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/api/v1/users/:id", func(c *gin.Context) {
+		if c.Param("id") == "missing" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{
+		"/api/v1/users/1",
+		"/api/v1/users/2",
+		"/api/v1/users/missing",
+		"/wp-login.php",
+		"/.env",
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := `
+# HELP ponti_http_requests_total HTTP requests by method, route template and status.
+# TYPE ponti_http_requests_total counter
+ponti_http_requests_total{method="GET",route="/api/v1/users/:id",status="200"} 2
+ponti_http_requests_total{method="GET",route="/api/v1/users/:id",status="404"} 1
+ponti_http_requests_total{method="GET",route="unmatched",status="404"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "ponti_http_requests_total"))
+	assert.Equal(t, 3, testutil.CollectAndCount(httpDuration), "latency uses the same labels")
+	assert.Zero(t, testutil.ToFloat64(httpInFlight), "in-flight requests are released")
+}
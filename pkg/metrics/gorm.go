@@ -0,0 +1,68 @@
+package pkgmetrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const startedAtKey = "metrics:started_at"
+
+// InstrumentGorm registra callbacks que miden cada operación de db; name es el
+// label db, para distinguir varias conexiones. Record not found no cuenta como error.
+func InstrumentGorm(db *gorm.DB, name string) error {
+	cb := db.Callback()
+	register := func(operation string, before, after func(string, func(*gorm.DB)) error) error {
+		if err := before("metrics:before_"+operation, startTimer); err != nil {
+			return fmt.Errorf("register metrics %s callback: %w", operation, err)
+		}
+		if err := after("metrics:after_"+operation, observeQuery(name, operation)); err != nil {
+			return fmt.Errorf("register metrics %s callback: %w", operation, err)
+		}
+		return nil
+	}
+	if err := register("create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register); err != nil {
+		return err
+	}
+	if err := register("query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register); err != nil {
+		return err
+	}
+	if err := register("update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register); err != nil {
+		return err
+	}
+	if err := register("delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register); err != nil {
+		return err
+	}
+	if err := register("row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register); err != nil {
+		return err
+	}
+	if err := register("raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register); err != nil {
+		return err
+	}
+	return nil
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(startedAtKey, time.Now())
+}
+
+func observeQuery(name, operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startedAtKey)
+		start, _ := value.(time.Time)
+		if !ok || start.IsZero() {
+			return
+		}
+		err := db.Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		dbQueryDuration.WithLabelValues(name, operation, table, status(err)).Observe(time.Since(start).Seconds())
+	}
+}
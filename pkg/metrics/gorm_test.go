@@ -0,0 +1,98 @@
+package pkgmetrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type lot struct {
+	ID   int64
+	Name string
+}
+
+func newInstrumentedDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	require.NoError(t, InstrumentGorm(db, "main"))
+	return db, mock
+}
+
+// observations returns the sample count of each series of the histogram, keyed
+// by its labels as name=value pairs in name order.
+func observations(t *testing.T, reg *prometheus.Registry, name string) map[string]uint64 {
+	t.Helper()
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	result := map[string]uint64{}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			values := make([]string, 0, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				values = append(values, l.GetName()+"="+l.GetValue())
+			}
+			result[strings.Join(values, ",")] = m.GetHistogram().GetSampleCount()
+		}
+	}
+	return result
+}
+
+func TestInstrumentGormRegistersCallbacks(t *testing.T) {
+	db, _ := newInstrumentedDB(t)
+
+	processors := map[string]interface{ Get(string) func(*gorm.DB) }{
+		"create": db.Callback().Create(),
+		"query":  db.Callback().Query(),
+		"update": db.Callback().Update(),
+		"delete": db.Callback().Delete(),
+		"row":    db.Callback().Row(),
+		"raw":    db.Callback().Raw(),
+	}
+	for operation, processor := range processors {
+		assert.NotNil(t, processor.Get("metrics:before_"+operation), "before %s", operation)
+		assert.NotNil(t, processor.Get("metrics:after_"+operation), "after %s", operation)
+	}
+}
+
+func TestInstrumentGormObservesQueries(t *testing.T) {
+	dbQueryDuration.Reset()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(dbQueryDuration)
+	db, mock := newInstrumentedDB(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "lots"`).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "north"))
+	var lots []lot
+	require.NoError(t, db.Find(&lots).Error)
+
+	mock.ExpectQuery(`SELECT \* FROM "lots"`).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	var missing lot
+	assert.ErrorIs(t, db.First(&missing, 9).Error, gorm.ErrRecordNotFound)
+
+	mock.ExpectExec(`DELETE FROM "lots"`).WillReturnError(errors.New("connection reset"))
+	assert.Error(t, db.Delete(&lot{}, 3).Error)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, map[string]uint64{
+		"db=main,operation=query,status=ok,table=lots":     2, // not found is not an error
+		"db=main,operation=delete,status=error,table=lots": 1,
+	}, observations(t, reg, "ponti_db_query_duration_seconds"))
+}
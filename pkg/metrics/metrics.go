@@ -0,0 +1,106 @@
+package pkgmetrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefija todas las métricas.
+const Namespace = "ponti"
+
+// registry contiene sólo las métricas de este paquete y las del runtime de Go y
+// del proceso, en lugar del registry global de prometheus.
+var registry = prometheus.NewRegistry()
+
+var factory = promauto.With(registry)
+
+var (
+	httpRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, route template and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method, route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	httpInFlight = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "HTTP requests being served.",
+	})
+
+	dbQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "GORM query latency by database, operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"db", "operation", "table", "status"})
+
+	redisDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "redis",
+		Name:      "command_duration_seconds",
+		Help:      "Redis command latency by command and status.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"command", "status"})
+
+	smtpSent = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "smtp",
+		Name:      "emails_total",
+		Help:      "Emails sent by outcome.",
+	}, []string{"status"})
+
+	brokerPublished = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "broker",
+		Name:      "published_total",
+		Help:      "Messages published by broker, topic and outcome.",
+	}, []string{"broker", "topic", "status"})
+
+	brokerConsumed = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "broker",
+		Name:      "consumed_total",
+		Help:      "Messages consumed by broker, topic and outcome.",
+	}, []string{"broker", "topic", "status"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Registry devuelve el registry donde se registran las métricas, para agregar
+// métricas propias de un servicio.
+func Registry() *prometheus.Registry {
+	return registry
+}
+
+// Handler expone las métricas en el formato de texto de Prometheus.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// status traduce un error al label status de las métricas.
+func status(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,74 @@
+package pkgmetrics
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// RegisterSQLDB expone las estadísticas del pool de un *sql.DB (conexiones
+// abiertas, en uso, esperas) con el label db_name.
+func RegisterSQLDB(name string, db *sql.DB) error {
+	if err := registry.Register(collectors.NewDBStatsCollector(db, name)); err != nil {
+		return fmt.Errorf("register sql pool metrics for %s: %w", name, err)
+	}
+	return nil
+}
+
+// RegisterPgxPool expone las estadísticas de un pool de pgx con el label db_name.
+func RegisterPgxPool(name string, pool *pgxpool.Pool) error {
+	if err := registry.Register(newPgxPoolCollector(name, pool)); err != nil {
+		return fmt.Errorf("register pgx pool metrics for %s: %w", name, err)
+	}
+	return nil
+}
+
+type pgxPoolCollector struct {
+	pool *pgxpool.Pool
+
+	maxConns      *prometheus.Desc
+	totalConns    *prometheus.Desc
+	acquiredConns *prometheus.Desc
+	idleConns     *prometheus.Desc
+	acquires      *prometheus.Desc
+	emptyAcquires *prometheus.Desc
+	canceled      *prometheus.Desc
+	acquireTime   *prometheus.Desc
+}
+
+func newPgxPoolCollector(name string, pool *pgxpool.Pool) *pgxPoolCollector {
+	labels := prometheus.Labels{"db_name": name}
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(Namespace, "pgxpool", metric), help, nil, labels)
+	}
+	return &pgxPoolCollector{
+		pool:          pool,
+		maxConns:      desc("max_connections", "Maximum size of the pool."),
+		totalConns:    desc("connections", "Connections currently in the pool."),
+		acquiredConns: desc("acquired_connections", "Connections currently in use."),
+		idleConns:     desc("idle_connections", "Idle connections in the pool."),
+		acquires:      desc("acquires_total", "Successful connection acquires."),
+		emptyAcquires: desc("empty_acquires_total", "Acquires that had to wait for a connection."),
+		canceled:      desc("canceled_acquires_total", "Acquires canceled by their context."),
+		acquireTime:   desc("acquire_seconds_total", "Total time spent acquiring connections."),
+	}
+}
+
+func (c *pgxPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *pgxPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceled, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireTime, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
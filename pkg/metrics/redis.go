@@ -0,0 +1,56 @@
+package pkgmetrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type redisStartKey struct{}
+
+// InstrumentRedis agrega al cliente un hook que mide cada comando. Un pipeline
+// se cuenta como un solo comando "pipeline". redis.Nil (clave inexistente) no es
+// un error.
+func InstrumentRedis(client *redis.Client) {
+	client.AddHook(redisHook{})
+}
+
+type redisHook struct{}
+
+func (redisHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+func (redisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	observeRedis(ctx, cmd.Name(), cmd.Err())
+	return nil
+}
+
+func (redisHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+func (redisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmdErr := cmd.Err(); cmdErr != nil && !errors.Is(cmdErr, redis.Nil) {
+			err = cmdErr
+			break
+		}
+	}
+	observeRedis(ctx, "pipeline", err)
+	return nil
+}
+
+func observeRedis(ctx context.Context, command string, err error) {
+	start, ok := ctx.Value(redisStartKey{}).(time.Time)
+	if !ok {
+		return
+	}
+	if errors.Is(err, redis.Nil) {
+		err = nil
+	}
+	redisDuration.WithLabelValues(command, status(err)).Observe(time.Since(start).Seconds())
+}
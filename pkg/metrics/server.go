@@ -0,0 +1,47 @@
+package pkgmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// DefaultPath es la ruta donde se exponen las métricas.
+const DefaultPath = "/metrics"
+
+// Serve expone las métricas en addr (p. ej. ":9100") hasta que se cancela ctx.
+// Sirve para no publicar /metrics en el puerto de la API.
+func Serve(ctx context.Context, addr, path string) error {
+	if path == "" {
+		path = DefaultPath
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, Handler())
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("[Metrics] serving %s on %s", path, addr)
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("metrics server: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
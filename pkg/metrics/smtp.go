@@ -0,0 +1,22 @@
+package pkgmetrics
+
+import (
+	"context"
+
+	pkgsmtp "github.com/alphacodinggroup/ponti-backend/pkg/notification/smtp"
+)
+
+// InstrumentSMTP cuenta los envíos de service por resultado.
+func InstrumentSMTP(service pkgsmtp.Service) pkgsmtp.Service {
+	return &smtpService{Service: service}
+}
+
+type smtpService struct {
+	pkgsmtp.Service
+}
+
+func (s *smtpService) SendEmail(ctx context.Context, email *pkgsmtp.Email) error {
+	err := s.Service.SendEmail(ctx, email)
+	smtpSent.WithLabelValues(status(err)).Inc()
+	return err
+}
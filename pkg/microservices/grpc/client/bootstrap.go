@@ -1,16 +1,22 @@
 package pkgcgrpcclient
 
 import (
-	"github.com/spf13/viper"
+	"os"
+	"strconv"
 )
 
+// Bootstrap crea el cliente gRPC con el host y el puerto leídos de las variables
+// de entorno indicadas. El token del cliente se lee de GRPC_CLIENT_TOKEN.
 func Bootstrap(grpcServerHostKey, grpcServerPortKey string) (Client, error) {
+	port, _ := strconv.Atoi(os.Getenv(grpcServerPortKey))
 	config := newConfig(
-		viper.GetString(grpcServerHostKey),
-		viper.GetInt(grpcServerPortKey),
+		os.Getenv(grpcServerHostKey),
+		port,
 		nil, // Configuración TLS, si es necesario
 	)
 
+	config.SetToken(os.Getenv("GRPC_CLIENT_TOKEN"))
+
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
@@ -24,7 +24,10 @@ type client struct {
 // newClient creates a new instance of a gRPC client
 func newClient(config Config) (Client, error) {
 	once.Do(func() {
-		var opts []grpc.DialOption
+		opts := Interceptors()
+		if token := config.GetToken(); token != "" {
+			opts = append(opts, WithToken(token))
+		}
 		if config.GetTLSConfig() != nil {
 			tlsConfig, err := loadTLSConfig(config.GetTLSConfig())
 			if err != nil {
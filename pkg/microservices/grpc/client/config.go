@@ -12,6 +12,7 @@ type config struct {
 	host      string
 	port      int
 	tlsConfig *TLSConfig
+	token     string
 }
 
 // newClientConfig crea una nueva configuración para el cliente gRPC
@@ -47,6 +48,14 @@ func (c *config) SetTLSConfig(tlsConfig *TLSConfig) {
 	c.tlsConfig = tlsConfig
 }
 
+func (c *config) GetToken() string {
+	return c.token
+}
+
+func (c *config) SetToken(token string) {
+	c.token = token
+}
+
 func (c *config) Validate() error {
 	if c.port == 0 {
 		return fmt.Errorf("gRPC client port is not configured")
@@ -0,0 +1,38 @@
+package pkgcgrpcclient
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// typesByCode es la inversa del mapeo de pkggrpcserver.ToStatus.
+var typesByCode = map[codes.Code]pkgtypes.ErrorType{
+	codes.NotFound:          pkgtypes.ErrNotFound,
+	codes.AlreadyExists:     pkgtypes.ErrConflict,
+	codes.InvalidArgument:   pkgtypes.ErrValidation,
+	codes.Unauthenticated:   pkgtypes.ErrAuthentication,
+	codes.PermissionDenied:  pkgtypes.ErrAuthorization,
+	codes.DeadlineExceeded:  pkgtypes.ErrTimeout,
+	codes.Unavailable:       pkgtypes.ErrUnavailable,
+	codes.ResourceExhausted: pkgtypes.ErrTooManyRequests,
+	codes.Internal:          pkgtypes.ErrInternal,
+}
+
+// FromStatus convierte un error de status gRPC en un *pkgtypes.Error, de modo que
+// quien usa el cliente maneje los mismos tipos de error que con los casos de uso locales.
+func FromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return pkgtypes.NewError(pkgtypes.ErrConnection, "gRPC call failed", err)
+	}
+	errType, ok := typesByCode[st.Code()]
+	if !ok {
+		errType = pkgtypes.ErrOperationFailed
+	}
+	return pkgtypes.NewError(errType, st.Message(), err)
+}
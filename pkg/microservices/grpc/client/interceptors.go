@@ -0,0 +1,60 @@
+package pkgcgrpcclient
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// TenantMetadataKey debe coincidir con pkggrpcserver.TenantMetadataKey.
+const TenantMetadataKey = "x-tenant-id"
+
+// Interceptors devuelve las opciones que instala el cliente: la traza y el
+// tenant del contexto (pkgtypes.WithTenantID) viajan como metadata y los errores
+// de status se convierten con FromStatus.
+func Interceptors() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(pkgtracing.UnaryClientInterceptor, unaryClient),
+		grpc.WithChainStreamInterceptor(pkgtracing.StreamClientInterceptor, streamClient),
+	}
+}
+
+func unaryClient(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return FromStatus(invoker(withTenantMetadata(ctx), method, req, reply, cc, opts...))
+}
+
+func streamClient(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cs, err := streamer(withTenantMetadata(ctx), desc, cc, method, opts...)
+	if err != nil {
+		return nil, FromStatus(err)
+	}
+	return &errorStream{ClientStream: cs}, nil
+}
+
+func withTenantMetadata(ctx context.Context) context.Context {
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, TenantMetadataKey, strconv.FormatInt(tenantID, 10))
+}
+
+// errorStream convierte los errores recibidos en un stream; io.EOF se mantiene
+// para que el cliente detecte el fin del stream.
+type errorStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return FromStatus(err)
+}
@@ -13,6 +13,8 @@ type Config interface {
 	SetPort(port int)
 	GetTLSConfig() *TLSConfig
 	SetTLSConfig(tlsConfig *TLSConfig)
+	GetToken() string
+	SetToken(token string)
 	Validate() error
 }
 
@@ -0,0 +1,29 @@
+package pkgcgrpcclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// AuthorizationMetadataKey debe coincidir con pkggrpcserver.AuthorizationMetadataKey.
+const AuthorizationMetadataKey = "authorization"
+
+// WithToken envía en cada llamada el token con el que el servidor identifica al
+// cliente (y su organización).
+func WithToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(tokenCredentials{token: token})
+}
+
+type tokenCredentials struct {
+	token string
+}
+
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{AuthorizationMetadataKey: "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity admite conexiones sin TLS dentro de la red interna.
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
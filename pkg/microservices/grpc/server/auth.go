@@ -0,0 +1,146 @@
+package pkggrpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// AuthorizationMetadataKey es la clave de metadata con el token del cliente
+// ("Bearer <token>").
+const AuthorizationMetadataKey = "authorization"
+
+// Client es un servicio autorizado a llamar al servidor. Cada token queda atado
+// a una organización: el tenant de la llamada sale del cliente autenticado y no
+// de la metadata.
+type Client struct {
+	Name     string
+	TenantID int64
+	Token    string
+}
+
+func (c Client) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("gRPC client name is required")
+	}
+	if c.TenantID <= 0 {
+		return fmt.Errorf("gRPC client %s: organization is required", c.Name)
+	}
+	if len(c.Token) < 16 {
+		return fmt.Errorf("gRPC client %s: token must have at least 16 characters", c.Name)
+	}
+	return nil
+}
+
+// ParseClients lee la lista "nombre:organización:token,..." de GRPC_SERVER_CLIENTS.
+func ParseClients(raw string) ([]Client, error) {
+	var clients []Client
+	for _, entry := range strings.Split(raw, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid gRPC client %q: expected name:organization:token", parts[0])
+		}
+		tenantID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gRPC client %s: organization must be a number", parts[0])
+		}
+		client := Client{Name: parts[0], TenantID: tenantID, Token: parts[2]}
+		if err := client.validate(); err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+type clientKey struct{}
+
+// ClientFromContext retorna el nombre del cliente que hizo la llamada.
+func ClientFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(clientKey{}).(string)
+	return name, ok
+}
+
+type registeredClient struct {
+	Client
+	digest [sha256.Size]byte
+}
+
+// authenticator valida el token de cada llamada contra los clientes configurados.
+type authenticator struct {
+	clients []registeredClient
+}
+
+func newAuthenticator(clients []Client) *authenticator {
+	a := &authenticator{clients: make([]registeredClient, 0, len(clients))}
+	for _, c := range clients {
+		a.clients = append(a.clients, registeredClient{Client: c, digest: sha256.Sum256([]byte(c.Token))})
+	}
+	return a
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate identifica al cliente por su token y deja en el contexto su
+// organización. Una metadata x-tenant-id distinta de la del cliente se rechaza.
+func (a *authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(AuthorizationMetadataKey)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+
+	// Se comparan los hashes contra todos los clientes, sin cortar al encontrar
+	// uno, para que el tiempo de respuesta no revele el token.
+	digest := sha256.Sum256([]byte(token))
+	var client *Client
+	for i := range a.clients {
+		if subtle.ConstantTimeCompare(digest[:], a.clients[i].digest[:]) == 1 {
+			client = &a.clients[i].Client
+		}
+	}
+	if client == nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+
+	if values := md.Get(TenantMetadataKey); len(values) > 0 && values[0] != strconv.FormatInt(client.TenantID, 10) {
+		return nil, status.Errorf(codes.PermissionDenied, "client %s cannot act on organization %s", client.Name, values[0])
+	}
+
+	ctx = context.WithValue(ctx, clientKey{}, client.Name)
+	ctx = pkglogger.WithAttrs(ctx, slog.String("grpc_client", client.Name), slog.Int64("tenant_id", client.TenantID))
+	return pkgtypes.WithTenantID(ctx, client.TenantID), nil
+}
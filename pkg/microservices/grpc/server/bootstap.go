@@ -1,22 +1,37 @@
 package pkggrpcserver
 
 import (
-	"github.com/spf13/viper"
+	"os"
+	"strconv"
 )
 
-// Bootstrap inicializa y devuelve una instancia de servidor gRPC
-func Bootstrap() (Server, error) {
-	host := viper.GetString("GRPC_SERVER_HOST")
+// Bootstrap inicializa y devuelve una instancia de servidor gRPC.
+// Si host o port no se indican se leen de GRPC_SERVER_HOST y GRPC_SERVER_PORT;
+// sin host escucha sólo en loopback. Los clientes autorizados se leen de
+// GRPC_SERVER_CLIENTS ("nombre:organización:token,...").
+func Bootstrap(host string, port int) (Server, error) {
 	if host == "" {
-		host = "0.0.0.0" // Valor predeterminado si no se especifica
+		host = os.Getenv("GRPC_SERVER_HOST")
+	}
+	if host == "" {
+		host = "127.0.0.1" // Exponerlo fuera del host debe ser explícito
+	}
+	if port == 0 {
+		port, _ = strconv.Atoi(os.Getenv("GRPC_SERVER_PORT"))
 	}
 
 	config := newConfig(
-		host, // viper.GetString("GRPC_SERVER_HOST"), // si es necesario
-		viper.GetInt("GRPC_SERVER_PORT"),
+		host,
+		port,
 		nil, // Configuración TLS, si es necesario
 	)
 
+	clients, err := ParseClients(os.Getenv("GRPC_SERVER_CLIENTS"))
+	if err != nil {
+		return nil, err
+	}
+	config.SetClients(clients)
+
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ type config struct {
 	host      string
 	port      int
 	tlsConfig *TLSConfig
+	clients   []Client
 }
 
 // newServerConfig crea una nueva configuración para el servidor gRPC
@@ -47,10 +48,27 @@ func (c *config) SetTLSConfig(tlsConfig *TLSConfig) {
 	c.tlsConfig = tlsConfig
 }
 
+func (c *config) GetClients() []Client {
+	return c.clients
+}
+
+func (c *config) SetClients(clients []Client) {
+	c.clients = clients
+}
+
 func (c *config) Validate() error {
 	if c.port == 0 {
 		return fmt.Errorf("gRPC server port is not configured")
 	}
+	// Sin clientes el servidor rechazaría todas las llamadas.
+	if len(c.clients) == 0 {
+		return fmt.Errorf("gRPC server has no clients configured")
+	}
+	for _, client := range c.clients {
+		if err := client.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,61 @@
+package pkggrpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+// codesByType traduce los tipos de error del dominio a códigos gRPC.
+var codesByType = map[pkgtypes.ErrorType]codes.Code{
+	pkgtypes.ErrNotFound:        codes.NotFound,
+	pkgtypes.ErrTokenNotFound:   codes.NotFound,
+	pkgtypes.ErrConflict:        codes.AlreadyExists,
+	pkgtypes.ErrInvalidInput:    codes.InvalidArgument,
+	pkgtypes.ErrValidation:      codes.InvalidArgument,
+	pkgtypes.ErrInvalidID:       codes.InvalidArgument,
+	pkgtypes.ErrMissingField:    codes.InvalidArgument,
+	pkgtypes.ErrAuthentication:  codes.Unauthenticated,
+	pkgtypes.ErrAuthorization:   codes.PermissionDenied,
+	pkgtypes.ErrTimeout:         codes.DeadlineExceeded,
+	pkgtypes.ErrUnavailable:     codes.Unavailable,
+	pkgtypes.ErrConnection:      codes.Unavailable,
+	pkgtypes.ErrTooManyRequests: codes.ResourceExhausted,
+	pkgtypes.ErrUnprocessable:   codes.FailedPrecondition,
+	pkgtypes.ErrOperationFailed: codes.Internal,
+	pkgtypes.ErrInternal:        codes.Internal,
+}
+
+// ToStatus convierte un error del dominio en un error de status gRPC. Los detalles
+// internos no se exponen: sólo viaja el mensaje del error.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	var appErr *pkgtypes.Error
+	if !errors.As(err, &appErr) {
+		return status.Error(codes.Internal, "internal server error")
+	}
+	code, ok := codesByType[appErr.Type]
+	if !ok {
+		code = codes.Unknown
+	}
+	if code == codes.Internal {
+		return status.Error(code, "internal server error")
+	}
+	return status.Error(code, appErr.Message)
+}
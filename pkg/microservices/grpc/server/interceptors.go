@@ -0,0 +1,77 @@
+package pkggrpcserver
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/google/uuid"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
+)
+
+// TenantMetadataKey es la clave de metadata con la organización de la llamada.
+// El tenant sale del cliente autenticado; la metadata sólo se admite si coincide.
+const TenantMetadataKey = "x-tenant-id"
+
+// RequestIDMetadataKey es la clave de metadata con el ID de la request; si no
+// viene se genera uno, y se devuelve en el header de la respuesta.
+const RequestIDMetadataKey = "x-request-id"
+
+// Interceptors devuelve las opciones que instala el servidor: cada llamada crea
+// un span que continúa la traza de la metadata, el request ID pasa al contexto,
+// sólo los clientes dados pueden llamar (con el tenant de su token) y los errores
+// del dominio se traducen con ToStatus. Sin clientes se rechaza toda llamada.
+func Interceptors(clients []Client) []grpc.ServerOption {
+	auth := newAuthenticator(clients)
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(pkgtracing.UnaryServerInterceptor, unaryRequestID, unaryErrors, auth.unary),
+		grpc.ChainStreamInterceptor(pkgtracing.StreamServerInterceptor, streamRequestID, streamErrors, auth.stream),
+	}
+}
+
+func unaryErrors(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+	return res, ToStatus(err)
+}
+
+func streamErrors(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return ToStatus(handler(srv, ss))
+}
+
+func unaryRequestID(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(requestIDContext(ctx), req)
+}
+
+func streamRequestID(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: requestIDContext(ss.Context())})
+}
+
+// requestIDContext agrega al contexto, y a sus logs, el request ID de la metadata.
+func requestIDContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id := ""
+	if values := md.Get(RequestIDMetadataKey); len(values) > 0 && len(values[0]) <= 128 {
+		id = values[0]
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id)); err != nil {
+		slog.DebugContext(ctx, "failed to set request id header", "error", err)
+	}
+	return pkglogger.WithRequestID(ctx, id)
+}
+
+// contextStream reemplaza el contexto de un ServerStream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -9,6 +9,8 @@ type Config interface {
 	SetPort(port int)
 	GetTLSConfig() *TLSConfig
 	SetTLSConfig(tlsConfig *TLSConfig)
+	GetClients() []Client
+	SetClients(clients []Client)
 	Validate() error
 }
 
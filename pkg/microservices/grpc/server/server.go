@@ -24,7 +24,7 @@ type server struct {
 
 func newServer(config Config) (Server, error) {
 	once.Do(func() {
-		var opts []grpc.ServerOption
+		opts := Interceptors(config.GetClients())
 		if config.GetTLSConfig() != nil {
 			tlsConfig, err := loadTLSConfig(config.GetTLSConfig())
 			if err != nil {
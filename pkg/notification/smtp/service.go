@@ -4,9 +4,12 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/smtp"
 	"os"
 	"sync"
+
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 )
 
 var (
@@ -23,9 +26,11 @@ type service struct {
 // newService crea una nueva instancia del servicio SMTP usando la configuración proporcionada.
 func newService(config Config) (Service, error) {
 	once.Do(func() {
-		instance = &service{
+		svc := &service{
 			config: config,
 		}
+		instance = svc
+		pkghealth.Register("smtp", svc.ping, pkghealth.NonCritical())
 	})
 
 	if initErr != nil {
@@ -35,6 +40,17 @@ func newService(config Config) (Service, error) {
 	return instance, nil
 }
 
+// ping abre una conexión TCP al servidor SMTP para /readyz, sin autenticarse
+// ni enviar comandos.
+func (s *service) ping(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.config.GetSMTPServer(), s.config.GetPort()))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 // SendEmail envía un correo electrónico usando el contenido de data (To, Subject, Body, etc.).
 func (s *service) SendEmail(ctx context.Context, data *Email) error {
 	// Construir el mensaje en formato RFC822
@@ -0,0 +1,87 @@
+package pkgtracing
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+// InstrumentGorm registra callbacks que crean un span de cliente por operación,
+// hijo del contexto de la query (db.WithContext). El SQL se guarda con los
+// placeholders, sin los valores. system es el label db.system (p. ej. postgresql).
+func InstrumentGorm(db *gorm.DB, system string) error {
+	cb := db.Callback()
+	register := func(operation string, before, after func(string, func(*gorm.DB)) error) error {
+		if err := before("tracing:before_"+operation, startSpan(system, operation)); err != nil {
+			return fmt.Errorf("register tracing %s callback: %w", operation, err)
+		}
+		if err := after("tracing:after_"+operation, endSpan); err != nil {
+			return fmt.Errorf("register tracing %s callback: %w", operation, err)
+		}
+		return nil
+	}
+	if err := register("create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register); err != nil {
+		return err
+	}
+	if err := register("query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register); err != nil {
+		return err
+	}
+	if err := register("update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register); err != nil {
+		return err
+	}
+	if err := register("delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register); err != nil {
+		return err
+	}
+	if err := register("row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register); err != nil {
+		return err
+	}
+	if err := register("raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register); err != nil {
+		return err
+	}
+	return nil
+}
+
+func startSpan(system, operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if ctx == nil || !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			return // Sin request en curso (migraciones, jobs) no se crean trazas sueltas.
+		}
+		name := "db." + operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		_, span := Tracer().Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemKey.String(system),
+				semconv.DBOperationName(operation),
+				semconv.DBCollectionName(db.Statement.Table),
+			),
+		)
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	span, _ := value.(trace.Span)
+	if !ok || span == nil {
+		return
+	}
+	span.SetAttributes(
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
@@ -0,0 +1,126 @@
+package pkgtracing
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+)
+
+// metadataCarrier adapta la metadata de gRPC al propagador de otel.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// UnaryServerInterceptor crea un span de servidor por llamada, continuando la
+// traza que llega en la metadata.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, span := startServerSpan(ctx, info.FullMethod)
+	res, err := handler(ctx, req)
+	endRPCSpan(span, err)
+	return res, err
+}
+
+// StreamServerInterceptor es UnaryServerInterceptor para streams.
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	endRPCSpan(span, err)
+	return err
+}
+
+// UnaryClientInterceptor crea un span de cliente por llamada y envía la traza
+// en la metadata.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, span := startClientSpan(ctx, method)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	endRPCSpan(span, err)
+	return err
+}
+
+// StreamClientInterceptor propaga la traza en streams; el span cubre el
+// establecimiento del stream.
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := startClientSpan(ctx, method)
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	endRPCSpan(span, err)
+	return cs, err
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md.Copy()))
+	ctx, span := Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+	if sc := span.SpanContext(); sc.IsValid() {
+		ctx = pkglogger.WithAttrs(ctx, slog.String("trace_id", sc.TraceID().String()))
+	}
+	return ctx, span
+}
+
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	ctx, span := Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// rpcAttributes separa "/paquete.Servicio/Metodo" en servicio y método.
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return []attribute.KeyValue{semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(method)}
+}
+
+func endRPCSpan(span trace.Span, err error) {
+	st, _ := status.FromError(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(st.Code())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, st.Message())
+	}
+	span.End()
+}
+
+// contextStream reemplaza el contexto de un ServerStream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,78 @@
+package pkgtracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentRedis agrega al cliente un hook que crea un span por comando o
+// pipeline. Sólo se guarda el nombre del comando: claves y valores pueden tener
+// datos sensibles (tokens, sesiones).
+func InstrumentRedis(client *redis.Client) {
+	client.AddHook(redisHook{})
+}
+
+type redisHook struct{}
+
+func (redisHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return startRedisSpan(ctx, cmd.Name(), 1), nil
+}
+
+func (redisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	endRedisSpan(ctx, cmd.Err())
+	return nil
+}
+
+func (redisHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	names := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		names = append(names, cmd.Name())
+	}
+	ctx = startRedisSpan(ctx, "pipeline", len(cmds))
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("db.redis.commands", strings.Join(names, " ")))
+	return ctx, nil
+}
+
+func (redisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmdErr := cmd.Err(); cmdErr != nil && !errors.Is(cmdErr, redis.Nil) {
+			err = cmdErr
+			break
+		}
+	}
+	endRedisSpan(ctx, err)
+	return nil
+}
+
+func startRedisSpan(ctx context.Context, command string, commands int) context.Context {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx
+	}
+	ctx, _ = Tracer().Start(ctx, "redis."+command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemRedis,
+			semconv.DBOperationName(command),
+			attribute.Int("db.redis.num_commands", commands),
+		),
+	)
+	return ctx
+}
+
+func endRedisSpan(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	if errors.Is(err, redis.Nil) {
+		err = nil
+	}
+	End(span, err)
+}
@@ -0,0 +1,183 @@
+package pkgtracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifica a los spans creados por este paquete.
+const instrumentationName = "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
+
+// Exporters soportados por Setup.
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterMemory = "memory"
+)
+
+// Config configura el tracing de un servicio.
+type Config struct {
+	ServiceName    string
+	ServiceVersion string
+	Environment    string
+	// Exporter es otlp, stdout, memory o none (por defecto): con none los spans
+	// no se exportan pero el contexto se sigue propagando.
+	Exporter string
+	// SampleRatio es la fracción de trazas nuevas que se muestrean (0 a 1); las que
+	// llegan con un padre respetan su decisión.
+	SampleRatio float64
+	// SpanExporter reemplaza al exporter elegido por nombre.
+	SpanExporter sdktrace.SpanExporter
+}
+
+// ConfigFromEnv lee OTEL_SERVICE_NAME, OTEL_TRACES_EXPORTER, OTEL_TRACES_SAMPLER_ARG
+// y DEPLOYMENT_ENVIRONMENT. El exporter OTLP lee además las variables estándar
+// OTEL_EXPORTER_OTLP_* (endpoint, headers, insecure).
+func ConfigFromEnv(serviceName string) Config {
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		serviceName = name
+	}
+	ratio := 1.0
+	if raw := os.Getenv("OTEL_TRACES_SAMPLER_ARG"); raw != "" {
+		if parsed, err := strconv.ParseFloat(raw, 64); err == nil {
+			ratio = parsed
+		}
+	}
+	return Config{
+		ServiceName:    serviceName,
+		ServiceVersion: os.Getenv("API_VERSION"),
+		Environment:    os.Getenv("DEPLOYMENT_ENVIRONMENT"),
+		Exporter:       strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")),
+		SampleRatio:    ratio,
+	}
+}
+
+// Provider es el TracerProvider instalado por Setup.
+type Provider struct {
+	tp     *sdktrace.TracerProvider
+	memory *tracetest.InMemoryExporter
+}
+
+// Setup instala el TracerProvider global y el propagador W3C (traceparent y
+// baggage). Shutdown debe llamarse al terminar para exportar los spans pendientes.
+func Setup(ctx context.Context, cfg Config) (*Provider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	p := &Provider{}
+	exporter := cfg.SpanExporter
+	if exporter == nil {
+		var err error
+		exporter, err = p.newExporter(ctx, cfg.Exporter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+		semconv.DeploymentEnvironment(cfg.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	if exporter != nil {
+		if p.memory != nil {
+			// En tests los spans tienen que estar disponibles apenas terminan.
+			opts = append(opts, sdktrace.WithSyncer(exporter))
+		} else {
+			opts = append(opts, sdktrace.WithBatcher(exporter))
+		}
+	}
+	p.tp = sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(p.tp)
+	return p, nil
+}
+
+func (p *Provider) newExporter(ctx context.Context, name string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterOTLP:
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		return exporter, nil
+	case ExporterStdout:
+		exporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		return exporter, nil
+	case ExporterMemory:
+		p.memory = tracetest.NewInMemoryExporter()
+		return p.memory, nil
+	default:
+		return nil, fmt.Errorf("unsupported traces exporter %q", name)
+	}
+}
+
+// Spans devuelve los spans terminados cuando el exporter es memory.
+func (p *Provider) Spans() tracetest.SpanStubs {
+	if p.memory == nil {
+		return nil
+	}
+	return p.memory.GetSpans()
+}
+
+// Shutdown exporta los spans pendientes y libera el exporter.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	return p.tp.Shutdown(ctx)
+}
+
+// Tracer devuelve el tracer de este paquete; sirve para crear spans propios.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End marca el span como fallido si err no es nil y lo termina.
+func End(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, context.Canceled) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject devuelve el contexto de traza de ctx como headers (traceparent,
+// tracestate, baggage), para propagarlo en mensajes.
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract agrega a ctx el contexto de traza recibido en headers.
+func Extract(ctx context.Context, headers map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(headers))
+}
@@ -0,0 +1,150 @@
+package pkgtracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupMemory(t *testing.T) *Provider {
+	t.Helper()
+	p, err := Setup(context.Background(), Config{ServiceName: "test", Exporter: ExporterMemory, SampleRatio: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Shutdown(context.Background()) })
+	return p
+}
+
+func attr(span tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		path        string
+		traceparent string
+		wantName    string
+		wantStatus  int
+		wantCode    codes.Code
+	}{
+		{name: "named after the route template", path: "/items/7", wantName: "GET /items/:id", wantStatus: http.StatusOK, wantCode: codes.Unset},
+		{name: "server errors fail the span", path: "/fail", wantName: "GET /fail", wantStatus: http.StatusInternalServerError, wantCode: codes.Error},
+		{name: "client errors do not fail the span", path: "/missing", wantName: "GET", wantStatus: http.StatusNotFound, wantCode: codes.Unset},
+		{
+			name:        "continues the incoming trace",
+			path:        "/items/7",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantName:    "GET /items/:id",
+			wantStatus:  http.StatusOK,
+			wantCode:    codes.Unset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := setupMemory(t)
+
+			router := gin.New()
+			router.Use(Middleware())
+			router.GET("/items/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+			router.GET("/fail", func(c *gin.Context) { c.Status(http.StatusInternalServerError) })
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			spans := p.Spans()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.wantName, span.Name)
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+			assert.Equal(t, int64(tt.wantStatus), attr(span, semconv.HTTPResponseStatusCodeKey).AsInt64())
+			assert.Equal(t, tt.wantCode, span.Status.Code)
+			if tt.traceparent != "" {
+				assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+				assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+			} else {
+				assert.False(t, span.Parent.IsValid())
+			}
+		})
+	}
+}
+
+func TestTracePropagatesToOutboundCalls(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	p := setupMemory(t)
+
+	var received http.Header
+	downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer downstream.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/proxy", func(c *gin.Context) {
+		req, err := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, downstream.URL+"/stock", nil)
+		require.NoError(t, err)
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/proxy", nil))
+
+	spans := p.Spans()
+	require.Len(t, spans, 2)
+	clientSpan, server := spans[0], spans[1]
+	assert.Equal(t, "HTTP GET", clientSpan.Name)
+	assert.Equal(t, trace.SpanKindClient, clientSpan.SpanKind)
+	assert.Equal(t, codes.Error, clientSpan.Status.Code)
+	assert.Equal(t, server.SpanContext.SpanID(), clientSpan.Parent.SpanID())
+	assert.Equal(t, server.SpanContext.TraceID(), clientSpan.SpanContext.TraceID())
+
+	// The downstream service continues the trace from the client span.
+	remote := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(received)))
+	require.True(t, remote.IsValid(), "no traceparent in %v", received)
+	assert.Equal(t, clientSpan.SpanContext.TraceID(), remote.TraceID())
+	assert.Equal(t, clientSpan.SpanContext.SpanID(), remote.SpanID())
+}
+
+func TestInjectExtract(t *testing.T) {
+	p := setupMemory(t)
+
+	ctx, span := Tracer().Start(context.Background(), "publish")
+	headers := Inject(ctx)
+	span.End()
+	require.Contains(t, headers, "traceparent")
+
+	_, consumer := Tracer().Start(Extract(context.Background(), headers), "consume")
+	End(consumer, nil)
+
+	spans := p.Spans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+}
@@ -11,15 +11,17 @@ type APIErrorType string
 
 // Constantes para APIErrorType.
 const (
-	APIErrNotFound     APIErrorType = "NOT_FOUND"
-	APIErrConflict     APIErrorType = "CONFLICT"
-	APIErrBadRequest   APIErrorType = "BAD_REQUEST"
-	APIErrInternal     APIErrorType = "INTERNAL_ERROR"
-	APIErrValidation   APIErrorType = "VALIDATION_ERROR"
-	APIErrUnauthorized APIErrorType = "UNAUTHORIZED"
-	APIErrTimeout      APIErrorType = "TIMEOUT"
-	APIErrUnavailable  APIErrorType = "SERVICE_UNAVAILABLE"
-	APIErrForbidden    APIErrorType = "FORBIDDEN"
+	APIErrNotFound        APIErrorType = "NOT_FOUND"
+	APIErrConflict        APIErrorType = "CONFLICT"
+	APIErrBadRequest      APIErrorType = "BAD_REQUEST"
+	APIErrInternal        APIErrorType = "INTERNAL_ERROR"
+	APIErrValidation      APIErrorType = "VALIDATION_ERROR"
+	APIErrUnauthorized    APIErrorType = "UNAUTHORIZED"
+	APIErrTimeout         APIErrorType = "TIMEOUT"
+	APIErrUnavailable     APIErrorType = "SERVICE_UNAVAILABLE"
+	APIErrForbidden       APIErrorType = "FORBIDDEN"
+	APIErrTooManyRequests APIErrorType = "TOO_MANY_REQUESTS"
+	APIErrUnprocessable   APIErrorType = "UNPROCESSABLE"
 )
 
 // APIError representa un error de API.
@@ -73,19 +75,23 @@ var errorToAPIError = map[ErrorType]APIErrorType{
 	ErrUnavailable:     APIErrUnavailable,
 	ErrTokenNotFound:   APIErrUnauthorized,
 	ErrMissingField:    APIErrBadRequest,
+	ErrTooManyRequests: APIErrTooManyRequests,
+	ErrUnprocessable:   APIErrUnprocessable,
 }
 
 // Mapear APIErrorType a códigos HTTP.
 var httpStatus = map[APIErrorType]int{
-	APIErrBadRequest:   http.StatusBadRequest,
-	APIErrNotFound:     http.StatusNotFound,
-	APIErrConflict:     http.StatusConflict,
-	APIErrInternal:     http.StatusInternalServerError,
-	APIErrValidation:   http.StatusBadRequest,
-	APIErrUnauthorized: http.StatusUnauthorized,
-	APIErrTimeout:      http.StatusGatewayTimeout,
-	APIErrUnavailable:  http.StatusServiceUnavailable,
-	APIErrForbidden:    http.StatusForbidden,
+	APIErrBadRequest:      http.StatusBadRequest,
+	APIErrNotFound:        http.StatusNotFound,
+	APIErrConflict:        http.StatusConflict,
+	APIErrInternal:        http.StatusInternalServerError,
+	APIErrValidation:      http.StatusBadRequest,
+	APIErrUnauthorized:    http.StatusUnauthorized,
+	APIErrTimeout:         http.StatusGatewayTimeout,
+	APIErrUnavailable:     http.StatusServiceUnavailable,
+	APIErrForbidden:       http.StatusForbidden,
+	APIErrTooManyRequests: http.StatusTooManyRequests,
+	APIErrUnprocessable:   http.StatusUnprocessableEntity,
 }
 
 // NewAPIError convierte un error de dominio a un APIError junto con el código HTTP.
@@ -23,6 +23,8 @@ const (
 	ErrInvalidID       ErrorType = "INVALID_ID"
 	ErrUnavailable     ErrorType = "SERVICE_UNAVAILABLE"
 	ErrTokenNotFound   ErrorType = "TOKEN_NOT_FOUND"
+	ErrTooManyRequests ErrorType = "TOO_MANY_REQUESTS"
+	ErrUnprocessable   ErrorType = "UNPROCESSABLE"
 	// Nuevo error para campos faltantes
 	ErrMissingField ErrorType = "MISSING_FIELD"
 )
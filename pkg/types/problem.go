@@ -0,0 +1,103 @@
+package pkgtypes
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ProblemContentType es el media type de las respuestas de error (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// DefaultProblemTypeBase es el prefijo de los type URI cuando no se configura
+// otro. Es una referencia relativa, que RFC 7807 admite.
+const DefaultProblemTypeBase = "/problems/"
+
+// ValidationErrorsKey es la clave del contexto de un error de validación que
+// lleva los errores por campo ([]FieldError).
+const ValidationErrorsKey = "errors"
+
+// Problem es el cuerpo de un error según RFC 7807 (problem details). Code,
+// Errors y Context son miembros de extensión.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Code     APIErrorType   `json:"code"`
+	Errors   []FieldError   `json:"errors,omitempty"`
+	Context  map[string]any `json:"context,omitempty"`
+	// Debug lleva la causa interna del error; sólo se completa con ExposeDetails.
+	Debug string `json:"debug,omitempty"`
+}
+
+// FieldError describe una regla de validación que no cumplió un campo.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// ProblemOptions ajusta cómo se arma un Problem.
+type ProblemOptions struct {
+	// TypeBase es el prefijo de los type URI; vacío usa DefaultProblemTypeBase.
+	TypeBase string
+	// ExposeDetails agrega la causa interna en Debug y el mensaje real de los
+	// errores no tipados. Sólo debe activarse en desarrollo.
+	ExposeDetails bool
+}
+
+// NewValidationError crea un error de validación con los errores por campo.
+func NewValidationError(message string, fields []FieldError, details error) *Error {
+	return NewErrorWithContext(ErrValidation, message, details, map[string]any{
+		ValidationErrorsKey: fields,
+	})
+}
+
+// NewProblem convierte un error en un Problem. Los errores de dominio se mapean
+// con NewAPIError; cualquier otro error es un 500 cuyo mensaje no se expone,
+// porque puede traer detalles de la base de datos o de la infraestructura.
+func NewProblem(err error, instance string, opts ProblemOptions) *Problem {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		apiErr, _ = NewAPIError(err)
+	}
+
+	problem := &Problem{
+		Type:     ProblemType(opts.TypeBase, apiErr.Type),
+		Title:    http.StatusText(apiErr.Code),
+		Status:   apiErr.Code,
+		Detail:   apiErr.Message,
+		Instance: instance,
+		Code:     apiErr.Type,
+	}
+
+	for key, value := range apiErr.Context {
+		if fields, ok := value.([]FieldError); ok && key == ValidationErrorsKey {
+			problem.Errors = fields
+			continue
+		}
+		if problem.Context == nil {
+			problem.Context = make(map[string]any)
+		}
+		problem.Context[key] = value
+	}
+
+	if opts.ExposeDetails {
+		problem.Debug = apiErr.Details
+	}
+	return problem
+}
+
+// ProblemType arma el type URI de un tipo de error: NOT_FOUND -> /problems/not-found.
+func ProblemType(base string, errType APIErrorType) string {
+	if base == "" {
+		base = DefaultProblemTypeBase
+	}
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	return base + strings.ReplaceAll(strings.ToLower(string(errType)), "_", "-")
+}
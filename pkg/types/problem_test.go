@@ -0,0 +1,124 @@
+package pkgtypes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblemMapping(t *testing.T) {
+	tests := []struct {
+		errType    ErrorType
+		wantStatus int
+		wantType   string
+	}{
+		{ErrNotFound, http.StatusNotFound, "/problems/not-found"},
+		{ErrTokenNotFound, http.StatusUnauthorized, "/problems/unauthorized"},
+		{ErrConflict, http.StatusConflict, "/problems/conflict"},
+		{ErrInvalidInput, http.StatusBadRequest, "/problems/bad-request"},
+		{ErrInvalidID, http.StatusBadRequest, "/problems/bad-request"},
+		{ErrMissingField, http.StatusBadRequest, "/problems/bad-request"},
+		{ErrValidation, http.StatusBadRequest, "/problems/validation-error"},
+		{ErrUnprocessable, http.StatusUnprocessableEntity, "/problems/unprocessable"},
+		{ErrAuthentication, http.StatusUnauthorized, "/problems/unauthorized"},
+		{ErrAuthorization, http.StatusForbidden, "/problems/forbidden"},
+		{ErrTooManyRequests, http.StatusTooManyRequests, "/problems/too-many-requests"},
+		{ErrTimeout, http.StatusGatewayTimeout, "/problems/timeout"},
+		{ErrUnavailable, http.StatusServiceUnavailable, "/problems/service-unavailable"},
+		{ErrConnection, http.StatusServiceUnavailable, "/problems/service-unavailable"},
+		{ErrOperationFailed, http.StatusInternalServerError, "/problems/internal-error"},
+		{ErrInternal, http.StatusInternalServerError, "/problems/internal-error"},
+		{ErrorType("SOMETHING_NEW"), http.StatusInternalServerError, "/problems/internal-error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.errType), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", NewError(tt.errType, "something happened", nil))
+			problem := NewProblem(err, "/api/v1/lots/7", ProblemOptions{})
+
+			assert.Equal(t, tt.wantStatus, problem.Status)
+			assert.Equal(t, tt.wantType, problem.Type)
+			assert.Equal(t, http.StatusText(tt.wantStatus), problem.Title)
+			assert.Equal(t, "something happened", problem.Detail)
+			assert.Equal(t, "/api/v1/lots/7", problem.Instance)
+		})
+	}
+}
+
+func TestNewProblemDetails(t *testing.T) {
+	cause := errors.New(`pq: duplicate key value violates unique constraint "lots_name_key"`)
+	fields := []FieldError{{Field: "name", Rule: "required", Message: "name is required"}}
+
+	tests := []struct {
+		name        string
+		err         error
+		opts        ProblemOptions
+		wantType    string
+		wantDetail  string
+		wantDebug   string
+		wantErrors  []FieldError
+		wantContext map[string]any
+	}{
+		{
+			name:       "internal causes are hidden by default",
+			err:        NewError(ErrConflict, "lot already exists", cause),
+			wantType:   "/problems/conflict",
+			wantDetail: "lot already exists",
+		},
+		{
+			name:       "internal causes are exposed in dev",
+			err:        NewError(ErrConflict, "lot already exists", cause),
+			opts:       ProblemOptions{ExposeDetails: true},
+			wantType:   "/problems/conflict",
+			wantDetail: "lot already exists",
+			wantDebug:  cause.Error(),
+		},
+		{
+			name:       "untyped errors are a 500 without their message",
+			err:        cause,
+			wantType:   "/problems/internal-error",
+			wantDetail: "Internal server error",
+		},
+		{
+			name:       "untyped errors keep their message in debug in dev",
+			err:        cause,
+			opts:       ProblemOptions{ExposeDetails: true},
+			wantType:   "/problems/internal-error",
+			wantDetail: "Internal server error",
+			wantDebug:  cause.Error(),
+		},
+		{
+			name:       "validation errors list their fields",
+			err:        NewValidationError("invalid lot", fields, nil),
+			wantType:   "/problems/validation-error",
+			wantDetail: "invalid lot",
+			wantErrors: fields,
+		},
+		{
+			name:        "context travels as an extension",
+			err:         NewErrorWithContext(ErrNotFound, "lot not found", nil, map[string]any{"lot_id": 7}),
+			opts:        ProblemOptions{TypeBase: "https://example.com/problems"},
+			wantType:    "https://example.com/problems/not-found",
+			wantDetail:  "lot not found",
+			wantContext: map[string]any{"lot_id": 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := NewProblem(tt.err, "/api/v1/lots", tt.opts)
+
+			assert.Equal(t, tt.wantType, problem.Type)
+			assert.Equal(t, tt.wantDetail, problem.Detail)
+			assert.Equal(t, tt.wantDebug, problem.Debug)
+			assert.Equal(t, tt.wantErrors, problem.Errors)
+			assert.Equal(t, tt.wantContext, problem.Context)
+			if !tt.opts.ExposeDetails {
+				assert.NotContains(t, problem.Detail, "lots_name_key")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package pkgtypes
+
+import "context"
+
+type userContextKey struct{}
+
+// WithUserID devuelve un contexto que lleva el usuario autenticado de la request.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userContextKey{}, userID)
+}
+
+// UserIDFromContext obtiene el usuario guardado con WithUserID.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(userContextKey{}).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,35 @@
+package pkgtypes
+
+import "context"
+
+type tenantContextKey struct{}
+type skipTenantScopeKey struct{}
+
+// WithTenantID devuelve un contexto que lleva el tenant (organización) de la request.
+func WithTenantID(ctx context.Context, tenantID int64) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+// TenantIDFromContext obtiene el tenant guardado con WithTenantID.
+func TenantIDFromContext(ctx context.Context) (int64, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	id, ok := ctx.Value(tenantContextKey{}).(int64)
+	return id, ok && id > 0
+}
+
+// WithoutTenantScope marca el contexto para que las consultas no se filtren por tenant.
+// Sólo debe usarse en procesos internos (migraciones, chequeos de pertenencia).
+func WithoutTenantScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipTenantScopeKey{}, true)
+}
+
+// TenantScopeSkipped indica si el contexto fue marcado con WithoutTenantScope.
+func TenantScopeSkipped(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	skip, _ := ctx.Value(skipTenantScopeKey{}).(bool)
+	return skip
+}
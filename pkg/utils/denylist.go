@@ -0,0 +1,13 @@
+package pkgutils
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenDenylist decide si un token con firma y expiración válidas fue revocado,
+// por ejemplo por su jti o por un logout forzado de su usuario.
+type TokenDenylist interface {
+	IsRevoked(ctx context.Context, claims jwt.MapClaims) (bool, error)
+}
@@ -0,0 +1,188 @@
+package pkgutils
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWK es una clave pública en formato JSON Web Key (RFC 7517). Sólo se
+// soportan claves RSA y EC P-256, las que usan RS256 y ES256.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS es el documento que se publica en /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeyProvider resuelve la clave de verificación de un token a partir de su kid.
+type KeyProvider interface {
+	VerificationKey(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// NewJWK convierte una clave pública RSA o ECDSA en JWK.
+func NewJWK(kid, alg string, pub crypto.PublicKey) (JWK, error) {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Alg: alg,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return JWK{}, fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			Kty: "EC",
+			Kid: kid,
+			Alg: alg,
+			Use: "sig",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
+
+// PublicKey convierte el JWK en una clave pública.
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// jwksProvider descarga y cachea un JWKS remoto.
+type jwksProvider struct {
+	url        string
+	client     *http.Client
+	minRefresh time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewJWKSProvider crea un KeyProvider que valida con las claves publicadas en url.
+// Un kid desconocido fuerza una nueva descarga, como mucho una vez cada minRefresh,
+// así las claves rotadas se aprenden sin reiniciar.
+func NewJWKSProvider(url string, minRefresh time.Duration) KeyProvider {
+	if minRefresh <= 0 {
+		minRefresh = time.Minute
+	}
+	return &jwksProvider{
+		url:        url,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		minRefresh: minRefresh,
+		keys:       map[string]crypto.PublicKey{},
+	}
+}
+
+func (p *jwksProvider) VerificationKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.RLock()
+	key, ok := p.keys[kid]
+	stale := time.Since(p.fetchedAt) >= p.minRefresh
+	p.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (p *jwksProvider) refresh(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.fetchedAt) < p.minRefresh {
+		return nil // Otra request ya la actualizó.
+	}
+	p.fetchedAt = time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build jwks request: %w", err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jwks endpoint returned %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue // Las claves que no entendemos no invalidan el resto.
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	return nil
+}
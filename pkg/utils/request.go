@@ -2,40 +2,34 @@
 package pkgutils
 
 import (
+	"encoding/json"
 	"errors"
-	"net/http"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 )
 
+var jsonFieldNames sync.Once
+
 // ValidateRequest valida la solicitud y retorna un error enriquecido si falla.
 // c: Contexto de Gin.
 // req: Puntero a la estructura donde se deserializará la solicitud.
 func ValidateRequest(c *gin.Context, req any) error {
+	useJSONFieldNames()
+
 	// Intentar parsear el JSON a la estructura proporcionada
 	if err := c.ShouldBindJSON(req); err != nil {
 		var validationErrors validator.ValidationErrors
 		// Si el error es de validación, enriquecerlo con detalles del campo y la regla
 		if errors.As(err, &validationErrors) {
-			validationDetails := make(map[string]any)
-			for _, fieldErr := range validationErrors {
-				validationDetails[fieldErr.Field()] = map[string]string{
-					"tag":     fieldErr.Tag(),
-					"param":   fieldErr.Param(),
-					"message": fieldErr.Error(),
-				}
-			}
-			return pkgtypes.NewErrorWithContext(
-				pkgtypes.ErrValidation,
-				"Validation failed",
-				err,
-				map[string]any{
-					"errors": validationDetails,
-				},
-			)
+			return pkgtypes.NewValidationError("Validation failed", FieldErrors(validationErrors), err)
 		}
 
 		// Si no es un error de validación, manejarlo como un error de deserialización
@@ -52,21 +46,93 @@ func ValidateRequest(c *gin.Context, req any) error {
 	return nil // No hay errores
 }
 
-// RespondWithError envía una respuesta HTTP estándar con el código de estado y el mensaje de error.
-// c: Contexto de Gin.
-// err: Error que contiene información enriquecida.
-func RespondWithError(c *gin.Context, err error) {
-	// Verificar si el error es del tipo `pkgtypes.Error`
-	if enrichedError, ok := err.(*pkgtypes.Error); ok {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   enrichedError.Message, // Puedes ajustar esto según tu preferencia
-			"details": enrichedError.Context,
+// FieldErrors traduce los errores de validator a errores por campo, con el
+// path JSON del campo (items[0].name) y un mensaje legible.
+func FieldErrors(validationErrors validator.ValidationErrors) []pkgtypes.FieldError {
+	fields := make([]pkgtypes.FieldError, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		// El namespace empieza con el nombre del struct raíz, que no es parte del body.
+		field := fieldErr.Namespace()
+		if _, rest, ok := strings.Cut(field, "."); ok {
+			field = rest
+		}
+		fields = append(fields, pkgtypes.FieldError{
+			Field:   field,
+			Rule:    fieldErr.Tag(),
+			Param:   fieldErr.Param(),
+			Message: fieldMessage(fieldErr),
 		})
-		return
 	}
+	return fields
+}
+
+func fieldMessage(fieldErr validator.FieldError) string {
+	param := fieldErr.Param()
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url", "uri":
+		return "must be a valid URL"
+	case "uuid", "uuid4":
+		return "must be a valid UUID"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(param, " ", ", ")
+	case "min", "gte":
+		return "must be at least " + param + sizeUnit(fieldErr)
+	case "max", "lte":
+		return "must be at most " + param + sizeUnit(fieldErr)
+	case "gt":
+		return "must be greater than " + param + sizeUnit(fieldErr)
+	case "lt":
+		return "must be less than " + param + sizeUnit(fieldErr)
+	case "len":
+		return "must be exactly " + param + sizeUnit(fieldErr)
+	default:
+		return fmt.Sprintf("failed the '%s' validation", fieldErr.Tag())
+	}
+}
 
-	// Responder con un mensaje genérico si el error no está enriquecido
-	c.JSON(http.StatusInternalServerError, gin.H{
-		"error": "An unexpected error occurred",
+// sizeUnit aclara si el límite es de largo o de cantidad; en números es el valor.
+func sizeUnit(fieldErr validator.FieldError) string {
+	switch fieldErr.Kind() {
+	case reflect.String:
+		return " characters long"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return " items"
+	default:
+		return ""
+	}
+}
+
+// useJSONFieldNames hace que validator reporte los campos con su nombre JSON,
+// que es el que conoce el cliente, en lugar del nombre del campo en Go.
+func useJSONFieldNames() {
+	jsonFieldNames.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return field.Name
+			}
+			return name
+		})
 	})
 }
+
+// RespondWithError envía el error como problem+json (RFC 7807), con el código
+// de estado que corresponde al tipo de error.
+// c: Contexto de Gin.
+// err: Error que contiene información enriquecida.
+func RespondWithError(c *gin.Context, err error) {
+	problem := pkgtypes.NewProblem(err, c.Request.URL.Path, pkgtypes.ProblemOptions{})
+	body, _ := json.Marshal(problem)
+	c.Data(problem.Status, pkgtypes.ProblemContentType, body)
+}
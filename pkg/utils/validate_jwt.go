@@ -1,6 +1,8 @@
 package pkgutils
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -30,6 +32,18 @@ const (
 	errUnsupported = "unsupported token lookup method"
 )
 
+const (
+	// TokenTypeClaim distingue los access tokens de los refresh tokens, que se
+	// firman con la misma clave.
+	TokenTypeClaim = "typ"
+	// AccessTokenType es el único tipo de token que autentica requests.
+	AccessTokenType = "access"
+	// RefreshTokenType sólo sirve para pedir un nuevo par de tokens.
+	RefreshTokenType = "refresh"
+	// SessionClaim es el claim con el ID de la sesión a la que pertenece el token.
+	SessionClaim = "sid"
+)
+
 // Config define la configuración común para la validación y extracción de JWT.
 type Config struct {
 	SecretKey    string // Clave secreta para tokens firmados con HMAC.
@@ -37,6 +51,10 @@ type Config struct {
 	TokenLookup  string // Define cómo y desde dónde extraer el token (ej. "header:Authorization" o "query:token").
 	TokenPrefix  string // Prefijo a remover del token (ej. "Bearer ").
 	ContextKey   string // Clave para almacenar el token en el contexto de la request.
+	// Keys resuelve las claves públicas de los tokens que traen kid (RS256/ES256).
+	Keys KeyProvider
+	// Denylist, si se define, rechaza los tokens revocados antes de expirar.
+	Denylist TokenDenylist
 }
 
 // NewConfigFromEnv crea una instancia de Config leyendo las variables de entorno,
@@ -51,7 +69,17 @@ func NewConfigFromEnv() Config {
 		TokenPrefix: getEnvOrDefault("JWT_TOKEN_PREFIX", bearerPrefix),
 		// Si no se define la variable, se utiliza "token" como clave en el contexto.
 		ContextKey: getEnvOrDefault("JWT_CONTEXT_KEY", DefaultContextKey),
+		// Si se define, los tokens con kid se validan contra el JWKS publicado por el emisor.
+		Keys: jwksProviderFromEnv(),
+	}
+}
+
+func jwksProviderFromEnv() KeyProvider {
+	url := os.Getenv("JWT_JWKS_URL")
+	if url == "" {
+		return nil
 	}
+	return NewJWKSProvider(url, 0)
 }
 
 // ParseRSAPublicKey convierte una cadena PEM en una clave pública RSA.
@@ -122,6 +150,46 @@ func SelectKeyFunc(token *jwt.Token, secretKey string, rsaKey *rsa.PublicKey) jw
 	}
 }
 
+// KeyIDFunc devuelve la función que busca la clave por el kid del token. Verifica
+// que el algoritmo del header corresponda al tipo de clave, para que una clave
+// pública no pueda usarse como secreto HMAC.
+func KeyIDFunc(ctx context.Context, keys KeyProvider) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, fmt.Errorf("token has no key id")
+		}
+		key, err := keys.VerificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		switch key.(type) {
+		case *rsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodRSA); ok {
+				return key, nil
+			}
+		case *ecdsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+}
+
+// CheckAccessToken rechaza los tokens que no son access tokens (sin claim typ o
+// con otro tipo), para que un refresh token no autentique requests.
+func CheckAccessToken(token *jwt.Token) error {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return fmt.Errorf("invalid claims type")
+	}
+	if typ, _ := claims[TokenTypeClaim].(string); typ != AccessTokenType {
+		return fmt.Errorf("not an access token")
+	}
+	return nil
+}
+
 // GetClaimsKey genera la clave para almacenar los claims del token en el contexto.
 // Se concatena la clave base con un sufijo.
 func GetClaimsKey(tokenKey string) string {
@@ -22,6 +22,7 @@ type config struct {
 	Limit     int
 	Threshold float64
 	logger    Logger
+	scope     ScopeFunc
 }
 
 // WithDSN overrides the default database DSN.
@@ -42,6 +43,9 @@ func WithThreshold(t float64) Option { return func(c *config) { c.Threshold = t
 // WithLogger sets a custom logger implementation.
 func WithLogger(l Logger) Option { return func(c *config) { c.logger = l } }
 
+// WithScope sets how a Searcher resolves the value for the ScopeColumn of its sources.
+func WithScope(f ScopeFunc) Option { return func(c *config) { c.scope = f } }
+
 // newConfig builds the configuration from options and environment, then validates it.
 func newConfig(opts ...Option) (*config, error) {
 	c := &config{
@@ -0,0 +1,227 @@
+package pkgsuggester
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Source is a table searched by a Searcher. Columns are concatenated with a space,
+// so several columns (e.g. first and last name) can be matched as one text.
+type Source struct {
+	Name     string   // name reported in each Match, e.g. "customers"
+	Table    string   // table to search
+	IDColumn string   // defaults to "id"
+	Columns  []string // columns matched against the query
+	Filter   string   // optional trusted SQL condition, e.g. "deleted_at IS NULL"
+	// ScopeColumn, when set, restricts the source to rows whose column equals the
+	// value returned by the Searcher's ScopeFunc, e.g. "tenant_id".
+	ScopeColumn string
+	// Restrict, when set, adds a condition resolved on each request, e.g. to keep
+	// only the rows the caller may see.
+	Restrict RestrictFunc
+}
+
+// ScopeFunc resolves the scope value of a request. Sources with a ScopeColumn are
+// skipped when it reports false, so unscoped requests never see scoped rows.
+type ScopeFunc func(ctx context.Context) (any, bool)
+
+// RestrictFunc returns a trusted SQL condition with ? placeholders and its
+// arguments. An empty condition leaves the source unrestricted.
+type RestrictFunc func(ctx context.Context) (condition string, args []any)
+
+// Match is one result of a multi-table search.
+type Match struct {
+	Source string  `json:"source"`
+	ID     string  `json:"id"`
+	Text   string  `json:"text"`
+	Score  float64 `json:"score"`
+}
+
+// Searcher runs one trigram query over several tables at once.
+type Searcher interface {
+	// Search returns up to limit matches per source, ordered by similarity.
+	Search(ctx context.Context, query string) ([]Match, error)
+	// EnsureIndexes enables pg_trgm and creates the GIN trigram index of every source.
+	EnsureIndexes(ctx context.Context) error
+}
+
+type searcher struct {
+	db        *gorm.DB
+	sources   []Source
+	limit     int
+	threshold float64
+	logger    Logger
+	scope     ScopeFunc
+}
+
+// NewSearcher creates a Searcher over an existing GORM connection. Only the
+// Limit, Threshold, Logger and Scope options apply.
+func NewSearcher(db *gorm.DB, sources []Source, opts ...Option) (Searcher, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gorm db cannot be nil")
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("at least one source is required")
+	}
+	c := &config{
+		Limit:     defaultLimit,
+		Threshold: defaultThreshold,
+		logger:    noopLogger{},
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	if c.Limit <= 0 {
+		return nil, fmt.Errorf("Limit must be > 0, got %d", c.Limit)
+	}
+	if c.Threshold < 0 || c.Threshold > 1 {
+		return nil, fmt.Errorf("Threshold must be between 0 and 1, got %f", c.Threshold)
+	}
+
+	validated := make([]Source, 0, len(sources))
+	for _, s := range sources {
+		if s.IDColumn == "" {
+			s.IDColumn = "id"
+		}
+		if !validIdentifier.MatchString(s.Table) || !validIdentifier.MatchString(s.IDColumn) {
+			return nil, fmt.Errorf("invalid source %q: bad table or id column", s.Name)
+		}
+		if len(s.Columns) == 0 {
+			return nil, fmt.Errorf("invalid source %q: no columns", s.Name)
+		}
+		for _, col := range s.Columns {
+			if !validIdentifier.MatchString(col) {
+				return nil, fmt.Errorf("invalid source %q: bad column %s", s.Name, col)
+			}
+		}
+		if s.ScopeColumn != "" && !validIdentifier.MatchString(s.ScopeColumn) {
+			return nil, fmt.Errorf("invalid source %q: bad scope column %s", s.Name, s.ScopeColumn)
+		}
+		if s.Name == "" {
+			s.Name = s.Table
+		}
+		if !validIdentifier.MatchString(s.Name) {
+			return nil, fmt.Errorf("invalid source name %q", s.Name)
+		}
+		validated = append(validated, s)
+	}
+
+	return &searcher{
+		db:        db,
+		sources:   validated,
+		limit:     c.Limit,
+		threshold: c.Threshold,
+		logger:    c.logger,
+		scope:     c.scope,
+	}, nil
+}
+
+// textExpr builds the indexed expression. It only uses immutable functions so the
+// same expression can back a GIN index.
+func (s Source) textExpr() string {
+	parts := make([]string, 0, len(s.Columns))
+	for _, col := range s.Columns {
+		parts = append(parts, fmt.Sprintf("coalesce(%s, '')", col))
+	}
+	return "(" + strings.Join(parts, " || ' ' || ") + ")"
+}
+
+func (s Source) indexName() string {
+	return fmt.Sprintf("idx_%s_%s_trgm", s.Table, strings.Join(s.Columns, "_"))
+}
+
+// Search matches the query by trigram similarity or substring, so short queries
+// still find longer names. The threshold is applied with SET LOCAL, hence the transaction.
+func (e *searcher) Search(ctx context.Context, query string) ([]Match, error) {
+	q := strings.TrimSpace(query)
+	if q == "" {
+		return nil, nil
+	}
+	pattern := "%" + escapeLike(q) + "%"
+
+	var scope any
+	scoped := false
+	if e.scope != nil {
+		scope, scoped = e.scope(ctx)
+	}
+
+	selects := make([]string, 0, len(e.sources))
+	args := make([]any, 0, len(e.sources)*4)
+	for _, s := range e.sources {
+		if s.ScopeColumn != "" && !scoped {
+			continue
+		}
+		expr := s.textExpr()
+		where := fmt.Sprintf("(%s %% ? OR %s ILIKE ?)", expr, expr)
+		if s.Filter != "" {
+			where += " AND (" + s.Filter + ")"
+		}
+		if s.ScopeColumn != "" {
+			where += fmt.Sprintf(" AND %s = ?", s.ScopeColumn)
+		}
+		var restrictArgs []any
+		if s.Restrict != nil {
+			if condition, condArgs := s.Restrict(ctx); condition != "" {
+				where += " AND (" + condition + ")"
+				restrictArgs = condArgs
+			}
+		}
+		selects = append(selects, fmt.Sprintf(
+			`(SELECT '%s' AS source, CAST(%s AS TEXT) AS id, trim(%s) AS text, similarity(%s, ?) AS score
+			  FROM %s WHERE %s ORDER BY score DESC LIMIT %d)`,
+			s.Name, s.IDColumn, expr, expr, s.Table, where, e.limit,
+		))
+		args = append(args, q, q, pattern)
+		if s.ScopeColumn != "" {
+			args = append(args, scope)
+		}
+		args = append(args, restrictArgs...)
+	}
+	if len(selects) == 0 {
+		return nil, nil
+	}
+	sql := strings.Join(selects, " UNION ALL ") + " ORDER BY score DESC, source, id"
+
+	var results []Match
+	start := time.Now()
+	err := e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("SET LOCAL pg_trgm.similarity_threshold = %f", e.threshold)).Error; err != nil {
+			return fmt.Errorf("set threshold: %w", err)
+		}
+		return tx.Raw(sql, args...).Scan(&results).Error
+	})
+	if err != nil {
+		e.logger.Error("search query failed", err)
+		return nil, fmt.Errorf("search query: %w", err)
+	}
+	e.logger.Debug(fmt.Sprintf("search latency: %s", time.Since(start)))
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	return results, nil
+}
+
+// EnsureIndexes is idempotent and meant to run with the migrations.
+func (e *searcher) EnsureIndexes(ctx context.Context) error {
+	db := e.db.WithContext(ctx)
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("create pg_trgm extension: %w", err)
+	}
+	for _, s := range e.sources {
+		stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s gin_trgm_ops)",
+			s.indexName(), s.Table, s.textExpr())
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("create trigram index on %s: %w", s.Table, err)
+		}
+	}
+	return nil
+}
+
+// escapeLike escapes the ILIKE wildcards typed by the user.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
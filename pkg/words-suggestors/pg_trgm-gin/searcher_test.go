@@ -0,0 +1,195 @@
+package pkgsuggester
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type tenantKey struct{}
+
+func tenantScope(ctx context.Context) (any, bool) {
+	id, ok := ctx.Value(tenantKey{}).(int64)
+	return id, ok
+}
+
+var (
+	customers = Source{Name: "customers", Table: "customers", Columns: []string{"name"}, ScopeColumn: "tenant_id"}
+	people    = Source{Name: "people", Table: "people", Columns: []string{"first_name", "last_name"}, Filter: "deleted_at IS NULL"}
+)
+
+func newSearchDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	return db, mock
+}
+
+// expectSearch expects the threshold and the search query inside one transaction.
+func expectSearch(mock sqlmock.Sqlmock, threshold, query string, args ...driver.Value) *sqlmock.ExpectedQuery {
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL pg_trgm.similarity_threshold = ` + threshold).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	return mock.ExpectQuery(query).WithArgs(args...)
+}
+
+func TestSearcherSearch(t *testing.T) {
+	tenant7 := context.WithValue(context.Background(), tenantKey{}, int64(7))
+	matchRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"source", "id", "text", "score"})
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		query   string
+		sources []Source
+		opts    []Option
+		setup   func(mock sqlmock.Sqlmock)
+		want    []Match
+	}{
+		{
+			name:    "every source is queried with its limit and the results ordered by score",
+			ctx:     tenant7,
+			query:   " tom ",
+			sources: []Source{customers, people},
+			opts:    []Option{WithLimit(5), WithThreshold(0.45), WithScope(tenantScope)},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectSearch(mock, `0\.450000`,
+					`(?s)^\(SELECT 'customers' AS source, CAST\(id AS TEXT\) AS id.* FROM customers WHERE \(.* % \$2 OR .* ILIKE \$3\) AND tenant_id = \$4 ORDER BY score DESC LIMIT 5\)`+
+						` UNION ALL \(SELECT 'people' AS source.*coalesce\(first_name, ''\) \|\| ' ' \|\| coalesce\(last_name, ''\).* FROM people WHERE .* AND \(deleted_at IS NULL\) ORDER BY score DESC LIMIT 5\)`+
+						` ORDER BY score DESC, source, id$`,
+					"tom", "tom", "%tom%", int64(7), "tom", "tom", "%tom%").
+					WillReturnRows(matchRows().
+						AddRow("customers", "3", "Tomatera", 0.4).
+						AddRow("people", "a1", "Tomás Pérez", 0.6))
+				mock.ExpectCommit()
+			},
+			want: []Match{
+				{Source: "people", ID: "a1", Text: "Tomás Pérez", Score: 0.6},
+				{Source: "customers", ID: "3", Text: "Tomatera", Score: 0.4},
+			},
+		},
+		{
+			name:    "scoped sources are skipped without a tenant",
+			ctx:     context.Background(),
+			query:   "tom",
+			sources: []Source{customers, people},
+			opts:    []Option{WithScope(tenantScope)},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectSearch(mock, `0\.300000`, `^\(SELECT 'people' AS source.* ORDER BY score DESC, source, id$`,
+					"tom", "tom", "%tom%").
+					WillReturnRows(matchRows())
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "scoped sources are skipped without a scope function",
+			ctx:     tenant7,
+			query:   "tom",
+			sources: []Source{customers},
+		},
+		{
+			name:  "restricted sources add their condition and arguments",
+			ctx:   tenant7,
+			query: "north",
+			sources: []Source{{
+				Name: "projects", Table: "projects", Columns: []string{"name"}, ScopeColumn: "tenant_id",
+				Restrict: func(context.Context) (string, []any) {
+					return "id IN (SELECT project_id FROM project_members WHERE user_id = ?)", []any{"u1"}
+				},
+			}},
+			opts: []Option{WithScope(tenantScope)},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectSearch(mock, `0\.300000`,
+					`AND tenant_id = \$4 AND \(id IN \(SELECT project_id FROM project_members WHERE user_id = \$5\)\) ORDER BY`,
+					"north", "north", "%north%", int64(7), "u1").
+					WillReturnRows(matchRows())
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:  "an empty restriction adds nothing",
+			ctx:   context.Background(),
+			query: "north",
+			sources: []Source{{
+				Name: "projects", Table: "projects", Columns: []string{"name"},
+				Restrict: func(context.Context) (string, []any) { return "", nil },
+			}},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectSearch(mock, `0\.300000`, `WHERE \(.* ILIKE \$3\) ORDER BY`, "north", "north", "%north%").
+					WillReturnRows(matchRows())
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "LIKE wildcards typed by the user are escaped",
+			ctx:     context.Background(),
+			query:   `50%_a\`,
+			sources: []Source{people},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectSearch(mock, `0\.300000`, `ILIKE`, `50%_a\`, `50%_a\`, `%50\%\_a\\%`).
+					WillReturnRows(matchRows())
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "a blank query does not hit the database",
+			ctx:     tenant7,
+			query:   "   ",
+			sources: []Source{people},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newSearchDB(t)
+			if tt.setup != nil {
+				tt.setup(mock)
+			}
+
+			s, err := NewSearcher(db, tt.sources, tt.opts...)
+			require.NoError(t, err)
+
+			got, err := s.Search(tt.ctx, tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNewSearcherValidation(t *testing.T) {
+	db, _ := newSearchDB(t)
+
+	tests := []struct {
+		name    string
+		sources []Source
+		opts    []Option
+	}{
+		{name: "no sources"},
+		{name: "bad table", sources: []Source{{Table: "people; DROP TABLE people", Columns: []string{"name"}}}},
+		{name: "bad column", sources: []Source{{Table: "people", Columns: []string{"name)"}}}},
+		{name: "bad scope column", sources: []Source{{Table: "people", Columns: []string{"name"}, ScopeColumn: "tenant id"}}},
+		{name: "no columns", sources: []Source{{Table: "people"}}},
+		{name: "zero limit", sources: []Source{people}, opts: []Option{WithLimit(0)}},
+		{name: "threshold above one", sources: []Source{people}, opts: []Option{WithThreshold(1.5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSearcher(db, tt.sources, tt.opts...)
+			assert.Error(t, err)
+		})
+	}
+}
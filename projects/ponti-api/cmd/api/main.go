@@ -7,15 +7,35 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
+
+	pkglogger "github.com/alphacodinggroup/ponti-backend/pkg/config/logger"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/wire"
 )
 
 func main() {
+	// Structured JSON logs; log.Printf calls are routed through the same logger
+	pkglogger.Setup(pkglogger.OptionsFromEnv())
+
 	// Create a context with cancellation to handle graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Tracing (OTEL_TRACES_EXPORTER); pending spans are flushed on exit
+	tracer, err := pkgtracing.Setup(ctx, pkgtracing.ConfigFromEnv("ponti-api"))
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %s", err)
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := tracer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Error flushing traces: %v", err)
+		}
+	}()
+
 	// Capture system signals for clean termination
 	go func() {
 		sigChan := make(chan os.Signal, 1)
@@ -36,8 +56,20 @@ func main() {
 		log.Fatalf("Failed to run Gorm's migrations: %v", err)
 	}
 
+	if err := RunOrganizationMigrations(ctx, deps.OrganizationRepository); err != nil {
+		log.Fatalf("Failed to run organization migrations: %v", err)
+	}
+
+	if err := RunRBACMigrations(ctx, deps.UserUseCases); err != nil {
+		log.Fatalf("Failed to run RBAC migrations: %v", err)
+	}
+
+	if err := RunSearchMigrations(ctx, deps.SearchRepository); err != nil {
+		log.Fatalf("Failed to run search migrations: %v", err)
+	}
+
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -46,6 +78,42 @@ func main() {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+		if err := RunGrpcServer(ctx, deps); err != nil {
+			log.Fatalf("Error running gRPC server: %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := deps.OutboxRelay.Run(ctx); err != nil {
+			log.Printf("Error running outbox relay: %v", err)
+		}
+	}()
+
+	// Serves /metrics on its own port when METRICS_PORT is set.
+	if os.Getenv("METRICS_PORT") != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := RunMetricsServer(ctx); err != nil {
+				log.Printf("Error running metrics server: %v", err)
+			}
+		}()
+	}
+
+	// Rotates the JWT signing key (only with JWT_SIGNING_ALG RS256/ES256).
+	if deps.JwtKeySet != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := deps.JwtKeySet.Run(ctx); err != nil {
+				log.Printf("Error rotating JWT signing keys: %v", err)
+			}
+		}()
+	}
+
 	wg.Wait()
 
 	log.Println("Application terminated successfully.")
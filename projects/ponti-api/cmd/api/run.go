@@ -5,20 +5,37 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/gin-gonic/gin"
+
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	ginadapter "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger/adapters"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgmetrics "github.com/alphacodinggroup/ponti-backend/pkg/metrics"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 
+	apikeymodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/repository/models"
 	cropmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/repository/models"
 	customermodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/repository/models"
 	fieldmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/repository/models"
 	investormodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/repository/models"
 	lotmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/repository/models"
 	managermodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/repository/models"
+	organizationmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/repository/models"
+	outboxmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/repository/models"
 	personmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/repository/models"
 	projectmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/repository/models"
+	rainfallmodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/repository/models"
 	usermodels "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/repository/models"
 
+	organization "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization"
+	search "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+
 	wire "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/wire"
 )
 
@@ -30,16 +47,32 @@ func RunHttpServer(ctx context.Context, deps *wire.Dependencies) error {
 
 	log.Println("Registering HTTP routes...")
 
+	// /metrics is registered before the global middlewares, so scrapes are
+	// neither logged nor counted. With METRICS_PORT it is served apart (RunMetricsServer).
+	if os.Getenv("METRICS_PORT") == "" {
+		deps.GinServer.GetRouter().GET(metricsPath(), gin.WrapH(pkgmetrics.Handler()))
+	}
+
 	// Configure global middlewares if any.
 	if len(deps.Middlewares.Global) > 0 {
 		deps.GinServer.GetRouter().Use(deps.Middlewares.Global...)
 	}
+	// Unknown routes go through ErrorHandlingMiddleware too, so they answer problem+json.
+	deps.GinServer.GetRouter().NoRoute(func(c *gin.Context) {
+		c.Error(pkgtypes.NewError(pkgtypes.ErrNotFound, "route not found", nil))
+	})
 
 	// Register all application routes.
 	log.Println("Starting HTTP Server...")
 	registerHttpRoutes(deps)
 
-	// Start the HTTP server (e.g., on port 8080).
+	// The OpenAPI spec is generated from the routes registered above. In dev a
+	// route without documented DTOs stops the startup.
+	if err := ginadapter.SetupSwagger(deps.GinServer.GetRouter(), deps.SwaggerService, swaggerOptions(deps)); err != nil {
+		return fmt.Errorf("failed to generate OpenAPI spec: %w", err)
+	}
+
+	// Blocks until ctx is cancelled, then drains in-flight requests.
 	return deps.GinServer.RunServer(ctx)
 }
 
@@ -55,6 +88,77 @@ func registerHttpRoutes(deps *wire.Dependencies) {
 	deps.ProjectHandler.Routes()
 	deps.CropHandler.Routes()
 	deps.ManagerHandler.Routes()
+	deps.RainfallHandler.Routes()
+	deps.SearchHandler.Routes()
+	deps.OrganizationHandler.Routes()
+	deps.AuthHandler.Routes()
+	deps.APIKeyHandler.Routes()
+}
+
+// swaggerOptions leaves probes and metrics out of the spec and marks the routes
+// behind the Protected middlewares as requiring a JWT or an API key.
+func swaggerOptions(deps *wire.Dependencies) pkgswagger.BuildOptions {
+	apiBase := "/api/" + deps.GinServer.GetApiVersion()
+	protectedPrefixes := []string{
+		apiBase + "/search",
+		apiBase + "/auth/sessions",
+		apiBase + "/auth/users",
+		apiBase + "/auth/password/change",
+	}
+
+	return pkgswagger.BuildOptions{
+		Ignore: []string{"/livez", "/readyz", metricsPath(), apiBase + "/ping", apiBase + "/health"},
+		SecuritySchemes: map[string]*pkgswagger.SecurityScheme{
+			"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			"apiKey":     {Type: "apiKey", In: "header", Name: mdw.APIKeyHeader},
+		},
+		Security: func(route pkgswagger.Route) []string {
+			if strings.Contains(route.Path, "/protected") {
+				return []string{"bearerAuth", "apiKey"}
+			}
+			for _, prefix := range protectedPrefixes {
+				if strings.HasPrefix(route.Path, prefix) {
+					return []string{"bearerAuth", "apiKey"}
+				}
+			}
+			return nil
+		},
+		Strict: os.Getenv("APP_ENV") == "dev",
+	}
+}
+
+// RunMetricsServer serves /metrics on METRICS_PORT until ctx is cancelled.
+func RunMetricsServer(ctx context.Context) error {
+	return pkgmetrics.Serve(ctx, ":"+os.Getenv("METRICS_PORT"), metricsPath())
+}
+
+func metricsPath() string {
+	if path := os.Getenv("METRICS_PATH"); path != "" {
+		return path
+	}
+	return pkgmetrics.DefaultPath
+}
+
+// RunGrpcServer registers the gRPC services and serves them until ctx is cancelled.
+func RunGrpcServer(ctx context.Context, deps *wire.Dependencies) error {
+	if deps == nil {
+		return errors.New("dependencies cannot be nil")
+	}
+
+	log.Println("Registering gRPC services...")
+	registerGrpcServices(ctx, deps)
+
+	log.Println("Starting gRPC Server...")
+	return deps.GrpcServer.Start(ctx)
+}
+
+// registerGrpcServices registers all gRPC services in the server.
+func registerGrpcServices(ctx context.Context, deps *wire.Dependencies) {
+	deps.UserGrpcServer.Register(ctx, deps.GrpcServer)
+	deps.CropGrpcServer.Register(ctx, deps.GrpcServer)
+	deps.LotGrpcServer.Register(ctx, deps.GrpcServer)
+	deps.FieldGrpcServer.Register(ctx, deps.GrpcServer)
+	deps.ProjectGrpcServer.Register(ctx, deps.GrpcServer)
 }
 
 // RunGormMigrations runs SQL migrations using GORM.
@@ -72,16 +176,27 @@ func RunGormMigrations(ctx context.Context, repo gorm.Repository) error {
 
 	// List of models to migrate (entidades existentes + 6 nuevas).
 	modelsToMigrate := []any{
+		&organizationmodels.Organization{},
 		&personmodels.Person{},
 		&usermodels.User{},
 		&usermodels.Follow{},
+		&usermodels.UserIdentity{},
+		&usermodels.Role{},
+		&usermodels.Permission{},
+		&usermodels.UserRole{},
+		&usermodels.RolePermission{},
 		&lotmodels.Lot{},
 		&customermodels.Customer{},
 		&investormodels.Investor{},
 		&fieldmodels.Field{},
 		&projectmodels.Project{},
+		&projectmodels.ProjectMember{},
+		&projectmodels.ProjectInvitation{},
 		&cropmodels.Crop{},
 		&managermodels.Manager{},
+		&outboxmodels.OutboxEvent{},
+		&rainfallmodels.RainfallReading{},
+		&apikeymodels.APIKey{},
 	}
 
 	start := time.Now()
@@ -93,3 +208,34 @@ func RunGormMigrations(ctx context.Context, repo gorm.Repository) error {
 
 	return nil
 }
+
+// RunSearchMigrations creates the trigram indexes used by the global search.
+// It must run after the GORM migrations so the indexed tables exist.
+func RunSearchMigrations(ctx context.Context, repo search.Repository) error {
+	log.Println("Creating search indexes...")
+	if err := repo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to create search indexes: %w", err)
+	}
+	log.Println("Search indexes are up to date.")
+	return nil
+}
+
+// RunOrganizationMigrations seeds the default organization, which owns every row
+// created before tenancy existed. It must run after the GORM migrations.
+func RunOrganizationMigrations(ctx context.Context, repo organization.Repository) error {
+	log.Println("Ensuring default organization...")
+	if err := repo.EnsureDefault(ctx); err != nil {
+		return fmt.Errorf("failed to ensure default organization: %w", err)
+	}
+	return nil
+}
+
+// RunRBACMigrations seeds the permission catalog and the admin role. The admin
+// role is assigned to the user whose email is in RBAC_ADMIN_EMAIL, if it exists.
+func RunRBACMigrations(ctx context.Context, users user.UseCases) error {
+	log.Println("Seeding roles and permissions...")
+	if err := users.SeedRBAC(ctx, os.Getenv("RBAC_ADMIN_EMAIL")); err != nil {
+		return fmt.Errorf("failed to seed roles and permissions: %w", err)
+	}
+	return nil
+}
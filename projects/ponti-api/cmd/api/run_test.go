@@ -0,0 +1,145 @@
+package main
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	apikey "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey"
+	auth "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth"
+	crop "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop"
+	customer "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer"
+	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
+	investor "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor"
+	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
+	manager "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	organization "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization"
+	person "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person"
+	project "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project"
+	rainfall "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall"
+	search "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+
+	wire "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/wire"
+)
+
+type specConfig struct{}
+
+func (specConfig) GetTitle() string       { return "Ponti API" }
+func (specConfig) GetDescription() string { return "" }
+func (specConfig) GetVersion() string     { return "v1" }
+func (specConfig) GetHost() string        { return "api.example.com" }
+func (specConfig) GetBasePath() string    { return "/" }
+func (specConfig) GetSchemes() []string   { return []string{"https"} }
+func (specConfig) IsEnabled() bool        { return true }
+func (specConfig) Validate() error        { return nil }
+
+// routeDeps wires every HTTP handler against a test router. Use cases are nil:
+// only the route registration runs.
+func routeDeps(t *testing.T) *wire.Dependencies {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	server, err := gsv.Bootstrap("", "v1", true, nil)
+	require.NoError(t, err)
+
+	noop := func(*gin.Context) {}
+	mws := &mdw.Middlewares{
+		RequirePermission:       func(string) gin.HandlerFunc { return noop },
+		RequireSelfOrPermission: func(string, string) gin.HandlerFunc { return noop },
+		RateLimit:               func(string) gin.HandlerFunc { return noop },
+		Cache:                   func(string) gin.HandlerFunc { return noop },
+	}
+
+	return &wire.Dependencies{
+		GinServer:           server,
+		PersonHandler:       person.NewHandler(server, nil, mws),
+		UserHandler:         user.NewHandler(server, nil, mws),
+		NotificationHandler: notification.NewHandler(server, nil, mws),
+		LotHandler:          lot.NewHandler(server, nil, mws),
+		CustomerHandler:     customer.NewHandler(server, nil, mws),
+		InvestorHandler:     investor.NewHandler(server, nil, mws),
+		FieldHandler:        field.NewHandler(server, nil, mws),
+		ProjectHandler:      project.NewHandler(server, nil, mws),
+		CropHandler:         crop.NewHandler(server, nil, mws),
+		ManagerHandler:      manager.NewHandler(server, nil, mws),
+		RainfallHandler:     rainfall.NewHandler(server, nil, mws),
+		SearchHandler:       search.NewHandler(server, nil, mws),
+		OrganizationHandler: organization.NewHandler(server, nil, mws),
+		AuthHandler:         auth.NewHandler(server, nil, mws, nil),
+		APIKeyHandler:       apikey.NewHandler(server, nil, mws),
+	}
+}
+
+var pathParam = regexp.MustCompile(`[:*]([^/]+)`)
+
+// TestEveryRouteIsDocumented keeps the OpenAPI spec in step with the router:
+// a new route without Describe (or without its DTOs) fails here instead of
+// silently missing from the published spec.
+func TestEveryRouteIsDocumented(t *testing.T) {
+	deps := routeDeps(t)
+	registerHttpRoutes(deps)
+
+	var routes []pkgswagger.Route
+	for _, route := range deps.GinServer.GetRouter().Routes() {
+		routes = append(routes, pkgswagger.Route{Method: route.Method, Path: route.Path, Handler: route.Handler})
+	}
+	require.NotEmpty(t, routes)
+
+	opts := swaggerOptions(deps)
+	doc, undocumented := pkgswagger.Generate(specConfig{}, routes, opts)
+	assert.Empty(t, undocumented, "routes without OpenAPI documentation")
+
+	for _, route := range routes {
+		if slices.Contains(opts.Ignore, route.Path) {
+			continue
+		}
+		path := pathParam.ReplaceAllString(route.Path, "{$1}")
+		op := doc.Paths[path][strings.ToLower(route.Method)]
+		if assert.NotNil(t, op, "%s %s missing from the spec", route.Method, route.Path) {
+			assert.NotEmpty(t, op.Summary, "%s %s has no summary", route.Method, route.Path)
+		}
+	}
+}
+
+func TestProtectedRoutesRequireCredentials(t *testing.T) {
+	deps := routeDeps(t)
+	registerHttpRoutes(deps)
+
+	var routes []pkgswagger.Route
+	for _, route := range deps.GinServer.GetRouter().Routes() {
+		routes = append(routes, pkgswagger.Route{Method: route.Method, Path: route.Path, Handler: route.Handler})
+	}
+	doc, _ := pkgswagger.Generate(specConfig{}, routes, swaggerOptions(deps))
+
+	tests := []struct {
+		method string
+		path   string
+		secure bool
+	}{
+		{method: "get", path: "/api/v1/crops/protected/{id}", secure: true},
+		{method: "get", path: "/api/v1/auth/sessions", secure: true},
+		{method: "post", path: "/api/v1/auth/login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			op := doc.Paths[tt.path][tt.method]
+			require.NotNil(t, op)
+			if tt.secure {
+				assert.Equal(t, []map[string][]string{{"bearerAuth": {}}, {"apiKey": {}}}, op.Security)
+			} else {
+				assert.Empty(t, op.Security)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package apikey
+
+import (
+	"context"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+)
+
+type authenticator struct {
+	ucs UseCases
+}
+
+// Authenticator adapts the use cases to the APIKey middleware.
+func Authenticator(ucs UseCases) mdw.APIKeyAuthenticator {
+	return &authenticator{ucs: ucs}
+}
+
+func (a *authenticator) AuthenticateAPIKey(ctx context.Context, secret string) (*mdw.APIKeyIdentity, error) {
+	k, err := a.ucs.AuthenticateAPIKey(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &mdw.APIKeyIdentity{
+		Subject:  k.UserID,
+		TenantID: k.TenantID,
+		Scopes:   k.Scopes,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package apikey
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/handler/dto"
+)
+
+// Handler encapsulates dependencies for the API key HTTP handler.
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+// NewHandler creates a new API key handler.
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{ucs: u, gsv: s, mws: m}
+}
+
+// Routes registers HTTP routes for API keys. Keys are managed by their owner
+// with an interactive login; an API key cannot manage keys.
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/api-keys"
+	protectedPrefix := apiBase + "/protected"
+
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(h.mws.Tenant...)
+		protected.Use(rejectAPIKeys)
+		// Sin Idempotent: la respuesta lleva el secreto y no debe quedar cacheada.
+		protected.POST("", h.CreateAPIKey)
+		protected.GET("", h.ListAPIKeys)
+		protected.DELETE("/:id", h.RevokeAPIKey)
+		protected.POST("/:id/rotate", h.RotateAPIKey)
+	}
+
+	h.describeRoutes()
+}
+
+func rejectAPIKeys(c *gin.Context) {
+	if _, ok := mdw.ScopesFromContext(c); ok {
+		c.Error(types.NewError(types.ErrAuthorization, "API keys cannot manage API keys", nil))
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// CreateAPIKey handles POST /api-keys
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	var req dto.CreateAPIKey
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	key, secret, err := h.ucs.CreateAPIKey(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.APIKeySecretResponse{APIKey: *dto.FromDomain(*key), Key: secret})
+}
+
+// ListAPIKeys handles GET /api-keys
+func (h *Handler) ListAPIKeys(c *gin.Context) {
+	list, err := h.ucs.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	result := make([]dto.APIKey, 0, len(list))
+	for _, k := range list {
+		result = append(result, *dto.FromDomain(k))
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// RevokeAPIKey handles DELETE /api-keys/:id
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid api key id", err))
+		return
+	}
+
+	if err := h.ucs.RevokeAPIKey(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "API key revoked successfully"})
+}
+
+// RotateAPIKey handles POST /api-keys/:id/rotate
+func (h *Handler) RotateAPIKey(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid api key id", err))
+		return
+	}
+
+	key, secret, err := h.ucs.RotateAPIKey(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.APIKeySecretResponse{APIKey: *dto.FromDomain(*key), Key: secret})
+}
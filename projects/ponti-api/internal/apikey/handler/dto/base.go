@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+)
+
+// APIKey is the DTO for an API key. It never carries the secret.
+type APIKey struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// FromDomain converts a domain.APIKey into a DTO.
+func FromDomain(d domain.APIKey) *APIKey {
+	return &APIKey{
+		ID:         d.ID,
+		Name:       d.Name,
+		Prefix:     d.Prefix,
+		Scopes:     d.Scopes,
+		ExpiresAt:  d.ExpiresAt,
+		LastUsedAt: d.LastUsedAt,
+		RevokedAt:  d.RevokedAt,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+// APIKeySecretResponse is returned by create and rotate. The key is shown only once.
+type APIKeySecretResponse struct {
+	APIKey
+	Key string `json:"key"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+)
+
+// CreateAPIKey is the DTO for creating an API key.
+type CreateAPIKey struct {
+	Name      string     `json:"name" binding:"required"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// ToDomain converts the DTO into a domain.APIKey.
+func (r CreateAPIKey) ToDomain() *domain.APIKey {
+	return &domain.APIKey{
+		Name:      r.Name,
+		Scopes:    r.Scopes,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "API key ID")
+
+	pkgswagger.Describe(h.CreateAPIKey, pkgswagger.Operation{
+		Summary:     "Create an API key",
+		Description: "The secret is only returned in this response.",
+		Request:     dto.CreateAPIKey{},
+		Responses:   map[int]any{http.StatusCreated: dto.APIKeySecretResponse{}},
+	})
+	pkgswagger.Describe(h.ListAPIKeys, pkgswagger.Operation{
+		Summary:   "List the tenant's API keys",
+		Responses: map[int]any{http.StatusOK: []dto.APIKey{}},
+	})
+	pkgswagger.Describe(h.RevokeAPIKey, pkgswagger.Operation{
+		Summary:    "Revoke an API key",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.RotateAPIKey, pkgswagger.Operation{
+		Summary:    "Rotate an API key",
+		Parameters: []pkgswagger.Parameter{id},
+		NoBody:     true,
+		Responses:  map[int]any{http.StatusOK: dto.APIKeySecretResponse{}},
+	})
+}
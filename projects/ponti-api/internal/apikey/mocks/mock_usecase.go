@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/apikey/ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockUseCases) AuthenticateAPIKey(arg0 context.Context, arg1 string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockUseCasesMockRecorder) AuthenticateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockUseCases)(nil).AuthenticateAPIKey), arg0, arg1)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockUseCases) CreateAPIKey(arg0 context.Context, arg1 *domain.APIKey) (*domain.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockUseCasesMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockUseCases)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockUseCases) ListAPIKeys(arg0 context.Context) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockUseCasesMockRecorder) ListAPIKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockUseCases)(nil).ListAPIKeys), arg0)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockUseCases) RevokeAPIKey(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockUseCasesMockRecorder) RevokeAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockUseCases)(nil).RevokeAPIKey), arg0, arg1)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockUseCases) RotateAPIKey(arg0 context.Context, arg1 int64) (*domain.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockUseCasesMockRecorder) RotateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockUseCases)(nil).RotateAPIKey), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockRepository) CreateAPIKey(arg0 context.Context, arg1 *domain.APIKey) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockRepositoryMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockRepository)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockRepository) GetAPIKey(arg0 context.Context, arg1 int64) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockRepositoryMockRecorder) GetAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockRepository)(nil).GetAPIKey), arg0, arg1)
+}
+
+// GetAPIKeyByHash mocks base method.
+func (m *MockRepository) GetAPIKeyByHash(arg0 context.Context, arg1 string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyByHash", arg0, arg1)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyByHash indicates an expected call of GetAPIKeyByHash.
+func (mr *MockRepositoryMockRecorder) GetAPIKeyByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyByHash", reflect.TypeOf((*MockRepository)(nil).GetAPIKeyByHash), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockRepository) ListAPIKeys(arg0 context.Context, arg1 string) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockRepositoryMockRecorder) ListAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockRepository)(nil).ListAPIKeys), arg0, arg1)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockRepository) RevokeAPIKey(arg0 context.Context, arg1 int64, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockRepositoryMockRecorder) RevokeAPIKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockRepository)(nil).RevokeAPIKey), arg0, arg1, arg2)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockRepository) TouchAPIKey(arg0 context.Context, arg1 int64, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockRepositoryMockRecorder) TouchAPIKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockRepository)(nil).TouchAPIKey), arg0, arg1, arg2)
+}
+
+// UpdateAPIKeySecret mocks base method.
+func (m *MockRepository) UpdateAPIKeySecret(arg0 context.Context, arg1 int64, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAPIKeySecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAPIKeySecret indicates an expected call of UpdateAPIKeySecret.
+func (mr *MockRepositoryMockRecorder) UpdateAPIKeySecret(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAPIKeySecret", reflect.TypeOf((*MockRepository)(nil).UpdateAPIKeySecret), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,31 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+)
+
+type UseCases interface {
+	// CreateAPIKey crea una key para el usuario del contexto y devuelve el secreto,
+	// que no se puede volver a consultar.
+	CreateAPIKey(context.Context, *domain.APIKey) (*domain.APIKey, string, error)
+	ListAPIKeys(context.Context) ([]domain.APIKey, error)
+	RevokeAPIKey(context.Context, int64) error
+	// RotateAPIKey reemplaza el secreto de la key conservando nombre, scopes y vencimiento.
+	RotateAPIKey(context.Context, int64) (*domain.APIKey, string, error)
+	// AuthenticateAPIKey resuelve un secreto y registra su uso.
+	AuthenticateAPIKey(context.Context, string) (*domain.APIKey, error)
+}
+
+type Repository interface {
+	CreateAPIKey(context.Context, *domain.APIKey) (int64, error)
+	ListAPIKeys(context.Context, string) ([]domain.APIKey, error)
+	GetAPIKey(context.Context, int64) (*domain.APIKey, error)
+	// GetAPIKeyByHash busca en todas las organizaciones: todavía no se conoce el tenant.
+	GetAPIKeyByHash(context.Context, string) (*domain.APIKey, error)
+	RevokeAPIKey(context.Context, int64, time.Time) error
+	UpdateAPIKeySecret(context.Context, int64, string, string) error
+	TouchAPIKey(context.Context, int64, time.Time) error
+}
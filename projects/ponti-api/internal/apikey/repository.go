@@ -0,0 +1,113 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gorm0 "gorm.io/gorm"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/repository/models"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+// NewRepository creates a new GORM repository for API keys.
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{db: db}
+}
+
+// CreateAPIKey persists a key and returns its autogenerated ID.
+func (r *repository) CreateAPIKey(ctx context.Context, k *domain.APIKey) (int64, error) {
+	if k == nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "api key is nil", nil)
+	}
+	model := models.FromDomain(k)
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create api key", err)
+	}
+	return model.ID, nil
+}
+
+// ListAPIKeys returns the keys of a user, newest first.
+func (r *repository) ListAPIKeys(ctx context.Context, userID string) ([]domain.APIKey, error) {
+	var list []models.APIKey
+	if err := r.db.Client().WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Find(&list).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list api keys", err)
+	}
+	result := make([]domain.APIKey, 0, len(list))
+	for _, m := range list {
+		result = append(result, *m.ToDomain())
+	}
+	return result, nil
+}
+
+// GetAPIKey retrieves a key by its ID.
+func (r *repository) GetAPIKey(ctx context.Context, id int64) (*domain.APIKey, error) {
+	var model models.APIKey
+	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("api key with id %d not found", id), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get api key", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// GetAPIKeyByHash retrieves a key by the hash of its secret, in any organization.
+func (r *repository) GetAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	var model models.APIKey
+	err := r.db.Client().WithContext(pkgtypes.WithoutTenantScope(ctx)).
+		Where("key_hash = ?", hash).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "api key not found", err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get api key", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// RevokeAPIKey marks a key as revoked. Revoking twice keeps the first timestamp.
+func (r *repository) RevokeAPIKey(ctx context.Context, id int64, at time.Time) error {
+	if err := r.db.Client().WithContext(ctx).
+		Model(&models.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to revoke api key", err)
+	}
+	return nil
+}
+
+// UpdateAPIKeySecret replaces the secret of a key.
+func (r *repository) UpdateAPIKeySecret(ctx context.Context, id int64, hash, prefix string) error {
+	if err := r.db.Client().WithContext(ctx).
+		Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"key_hash": hash, "prefix": prefix, "last_used_at": nil}).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to rotate api key", err)
+	}
+	return nil
+}
+
+// TouchAPIKey records the last use of a key. It runs before the tenant is known.
+func (r *repository) TouchAPIKey(ctx context.Context, id int64, at time.Time) error {
+	if err := r.db.Client().WithContext(pkgtypes.WithoutTenantScope(ctx)).
+		Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", at).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update api key usage", err)
+	}
+	return nil
+}
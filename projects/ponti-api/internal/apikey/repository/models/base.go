@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+)
+
+// APIKey es el modelo GORM de una API key. Del secreto sólo se guarda el hash.
+type APIKey struct {
+	pkggorm.TenantScoped
+
+	ID         int64      `gorm:"primaryKey;autoIncrement;column:id"`
+	UserID     string     `gorm:"not null;index;column:user_id"`
+	Name       string     `gorm:"size:100;not null;column:name"`
+	Prefix     string     `gorm:"size:20;not null;column:prefix"`
+	KeyHash    string     `gorm:"size:64;not null;uniqueIndex;column:key_hash"`
+	Scopes     []string   `gorm:"type:text;serializer:json;column:scopes"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;column:created_at"`
+}
+
+func (m *APIKey) ToDomain() *domain.APIKey {
+	return &domain.APIKey{
+		ID:         m.ID,
+		TenantID:   m.TenantID,
+		UserID:     m.UserID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		Hash:       m.KeyHash,
+		Scopes:     m.Scopes,
+		ExpiresAt:  m.ExpiresAt,
+		LastUsedAt: m.LastUsedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func FromDomain(d *domain.APIKey) *APIKey {
+	return &APIKey{
+		TenantScoped: pkggorm.TenantScoped{TenantID: d.TenantID},
+		ID:           d.ID,
+		UserID:       d.UserID,
+		Name:         d.Name,
+		Prefix:       d.Prefix,
+		KeyHash:      d.Hash,
+		Scopes:       d.Scopes,
+		ExpiresAt:    d.ExpiresAt,
+		LastUsedAt:   d.LastUsedAt,
+		RevokedAt:    d.RevokedAt,
+		CreatedAt:    d.CreatedAt,
+	}
+}
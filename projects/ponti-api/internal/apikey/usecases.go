@@ -0,0 +1,217 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+)
+
+const (
+	secretBytes = 24
+	// prefixLength covers domain.KeyPrefix plus 8 characters of the secret.
+	prefixLength = 11
+	// touchInterval throttles last_used_at writes for busy keys.
+	touchInterval = time.Minute
+)
+
+var errInvalidAPIKey = pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid API key", nil)
+
+type useCases struct {
+	repo  Repository
+	users user.UseCases
+}
+
+func NewUseCases(repo Repository, users user.UseCases) UseCases {
+	return &useCases{repo: repo, users: users}
+}
+
+func (u *useCases) CreateAPIKey(ctx context.Context, k *domain.APIKey) (*domain.APIKey, string, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return nil, "", pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required", nil)
+	}
+
+	k.Name = strings.TrimSpace(k.Name)
+	if k.Name == "" {
+		return nil, "", pkgtypes.NewError(pkgtypes.ErrValidation, "api key name is required", nil)
+	}
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return nil, "", pkgtypes.NewError(pkgtypes.ErrValidation, "expiration must be in the future", nil)
+	}
+	scopes, err := u.validateScopes(ctx, userID, k.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	k.ID = 0
+	k.UserID = userID
+	k.TenantID = tenantID
+	k.Scopes = scopes
+	k.Prefix = secret[:prefixLength]
+	k.Hash = hashSecret(secret)
+	k.LastUsedAt, k.RevokedAt = nil, nil
+	k.CreatedAt = time.Now()
+
+	id, err := u.repo.CreateAPIKey(ctx, k)
+	if err != nil {
+		return nil, "", err
+	}
+	k.ID = id
+	return k, secret, nil
+}
+
+func (u *useCases) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.ListAPIKeys(ctx, userID)
+}
+
+func (u *useCases) RevokeAPIKey(ctx context.Context, id int64) error {
+	if _, err := u.ownedKey(ctx, id); err != nil {
+		return err
+	}
+	return u.repo.RevokeAPIKey(ctx, id, time.Now())
+}
+
+func (u *useCases) RotateAPIKey(ctx context.Context, id int64) (*domain.APIKey, string, error) {
+	k, err := u.ownedKey(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if k.Revoked() {
+		return nil, "", pkgtypes.NewError(pkgtypes.ErrConflict, "api key has been revoked", nil)
+	}
+	if k.Expired(time.Now()) {
+		return nil, "", pkgtypes.NewError(pkgtypes.ErrConflict, "api key has expired", nil)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	k.Prefix = secret[:prefixLength]
+	k.Hash = hashSecret(secret)
+	k.LastUsedAt = nil
+	if err := u.repo.UpdateAPIKeySecret(ctx, k.ID, k.Hash, k.Prefix); err != nil {
+		return nil, "", err
+	}
+	return k, secret, nil
+}
+
+func (u *useCases) AuthenticateAPIKey(ctx context.Context, secret string) (*domain.APIKey, error) {
+	if !strings.HasPrefix(secret, domain.KeyPrefix) {
+		return nil, errInvalidAPIKey
+	}
+	k, err := u.repo.GetAPIKeyByHash(ctx, hashSecret(secret))
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return nil, errInvalidAPIKey
+		}
+		return nil, err
+	}
+	if k.Revoked() {
+		return nil, errInvalidAPIKey
+	}
+	now := time.Now()
+	if k.Expired(now) {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "API key has expired", nil)
+	}
+
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= touchInterval {
+		if err := u.repo.TouchAPIKey(ctx, k.ID, now); err != nil {
+			log.Printf("[APIKey] failed to record usage of key %d: %v", k.ID, err)
+		} else {
+			k.LastUsedAt = &now
+		}
+	}
+	return k, nil
+}
+
+// ownedKey returns the key only if it belongs to the caller. Keys of other users
+// are reported as not found so their IDs cannot be probed.
+func (u *useCases) ownedKey(ctx context.Context, id int64) (*domain.APIKey, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	k, err := u.repo.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if k.UserID != userID {
+		return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "api key not found", nil)
+	}
+	return k, nil
+}
+
+// validateScopes normalizes the requested scopes and checks that the creator
+// holds every one of them: a key never grants more than its owner has.
+func (u *useCases) validateScopes(ctx context.Context, userID string, requested []string) ([]string, error) {
+	scopes := make([]string, 0, len(requested))
+	seen := make(map[string]struct{}, len(requested))
+	for _, s := range requested {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		scopes = append(scopes, s)
+	}
+	if len(scopes) == 0 {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "at least one scope is required", nil)
+	}
+
+	granted, err := u.users.ResolvePermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range scopes {
+		if !mdw.HasPermission(granted, s) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrAuthorization, "scope "+s+" is not granted to the user", nil)
+		}
+	}
+	return scopes, nil
+}
+
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := pkgtypes.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing user", nil)
+	}
+	return userID, nil
+}
+
+func newSecret() (string, error) {
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate api key", err)
+	}
+	return domain.KeyPrefix + hex.EncodeToString(raw), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
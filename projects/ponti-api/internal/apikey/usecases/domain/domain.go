@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// KeyPrefix identifies the secrets issued by this API.
+const KeyPrefix = "pk_"
+
+// APIKey is a credential for machine-to-machine clients. It acts on behalf of the
+// user that created it, limited to its scopes. Only the hash of the secret is
+// stored; Prefix is kept so the owner can tell keys apart.
+type APIKey struct {
+	ID         int64
+	TenantID   int64
+	UserID     string
+	Name       string
+	Prefix     string
+	Hash       string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Expired reports whether the key has an expiry and it already passed.
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Revoked reports whether the key was revoked.
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
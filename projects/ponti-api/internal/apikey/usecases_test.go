@@ -0,0 +1,205 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/mocks"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey/usecases/domain"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+)
+
+func callerCtx() context.Context {
+	ctx := pkgtypes.WithUserID(context.Background(), "u1")
+	return pkgtypes.WithTenantID(ctx, 7)
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		key     *domain.APIKey
+		granted []string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "scopes held by the creator",
+			key:     &domain.APIKey{Name: " partner ", Scopes: []string{"lot:read", "lot:read", "field:read"}},
+			granted: []string{"lot:*", "field:read"},
+		},
+		{
+			name:    "scope not held by the creator",
+			key:     &domain.APIKey{Name: "partner", Scopes: []string{"lot:write"}},
+			granted: []string{"lot:read"},
+			wantErr: pkgtypes.IsAuthorizationError,
+		},
+		{
+			name:    "no scopes",
+			key:     &domain.APIKey{Name: "partner", Scopes: []string{" "}},
+			wantErr: pkgtypes.IsValidationError,
+		},
+		{
+			name:    "expiration in the past",
+			key:     &domain.APIKey{Name: "partner", Scopes: []string{"lot:read"}, ExpiresAt: &past},
+			wantErr: pkgtypes.IsValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(ctrl)
+			users := user.NewMockUseCases(ctrl)
+			u := NewUseCases(repo, users)
+
+			if tt.granted != nil {
+				users.EXPECT().ResolvePermissions(gomock.Any(), "u1").Return(tt.granted, nil)
+			}
+			var stored *domain.APIKey
+			if tt.wantErr == nil {
+				repo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *domain.APIKey) (int64, error) {
+						stored = k
+						return 42, nil
+					})
+			}
+
+			got, secret, err := u.CreateAPIKey(callerCtx(), tt.key)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(42), got.ID)
+			assert.Equal(t, "partner", got.Name)
+			assert.Equal(t, []string{"lot:read", "field:read"}, got.Scopes)
+			assert.Equal(t, "u1", stored.UserID)
+			assert.Equal(t, int64(7), stored.TenantID)
+			assert.True(t, strings.HasPrefix(secret, domain.KeyPrefix))
+			assert.True(t, strings.HasPrefix(secret, stored.Prefix))
+			assert.Equal(t, hashSecret(secret), stored.Hash)
+			assert.NotContains(t, stored.Hash, secret)
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := domain.KeyPrefix + "abcdef0123456789"
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now().Add(-10 * time.Second)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		secret    string
+		stored    *domain.APIKey
+		lookupErr error
+		wantTouch bool
+		wantErr   bool
+	}{
+		{
+			name:      "valid key records its use",
+			secret:    secret,
+			stored:    &domain.APIKey{ID: 1, UserID: "u1", TenantID: 7, ExpiresAt: &future},
+			wantTouch: true,
+		},
+		{
+			name:   "recently used key is not touched again",
+			secret: secret,
+			stored: &domain.APIKey{ID: 1, UserID: "u1", TenantID: 7, LastUsedAt: &recent},
+		},
+		{
+			name:    "revoked key",
+			secret:  secret,
+			stored:  &domain.APIKey{ID: 1, RevokedAt: &past},
+			wantErr: true,
+		},
+		{
+			name:    "expired key",
+			secret:  secret,
+			stored:  &domain.APIKey{ID: 1, ExpiresAt: &past},
+			wantErr: true,
+		},
+		{
+			name:      "unknown key",
+			secret:    secret,
+			lookupErr: pkgtypes.NewError(pkgtypes.ErrNotFound, "api key not found", nil),
+			wantErr:   true,
+		},
+		{
+			name:    "malformed key is not looked up",
+			secret:  "not-a-key",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(ctrl)
+			u := NewUseCases(repo, user.NewMockUseCases(ctrl))
+
+			if tt.stored != nil || tt.lookupErr != nil {
+				repo.EXPECT().GetAPIKeyByHash(gomock.Any(), hashSecret(tt.secret)).Return(tt.stored, tt.lookupErr)
+			}
+			if tt.wantTouch {
+				repo.EXPECT().TouchAPIKey(gomock.Any(), tt.stored.ID, gomock.Any()).Return(nil)
+			}
+
+			got, err := u.AuthenticateAPIKey(context.Background(), tt.secret)
+			if tt.wantErr {
+				assert.True(t, pkgtypes.IsAuthenticationError(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "u1", got.UserID)
+			assert.Equal(t, int64(7), got.TenantID)
+		})
+	}
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("replaces the secret of an owned key", func(t *testing.T) {
+		repo := mocks.NewMockRepository(ctrl)
+		u := NewUseCases(repo, user.NewMockUseCases(ctrl))
+
+		repo.EXPECT().GetAPIKey(gomock.Any(), int64(3)).
+			Return(&domain.APIKey{ID: 3, UserID: "u1", Hash: "old", Scopes: []string{"lot:read"}}, nil)
+		var newHash string
+		repo.EXPECT().UpdateAPIKeySecret(gomock.Any(), int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, hash, _ string) error {
+				newHash = hash
+				return nil
+			})
+
+		got, secret, err := u.RotateAPIKey(callerCtx(), 3)
+		assert.NoError(t, err)
+		assert.Equal(t, hashSecret(secret), newHash)
+		assert.NotEqual(t, "old", newHash)
+		assert.Equal(t, []string{"lot:read"}, got.Scopes)
+	})
+
+	t.Run("keys of other users are not found", func(t *testing.T) {
+		repo := mocks.NewMockRepository(ctrl)
+		u := NewUseCases(repo, user.NewMockUseCases(ctrl))
+
+		repo.EXPECT().GetAPIKey(gomock.Any(), int64(3)).Return(&domain.APIKey{ID: 3, UserID: "u2"}, nil)
+
+		_, _, err := u.RotateAPIKey(callerCtx(), 3)
+		assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+	})
+}
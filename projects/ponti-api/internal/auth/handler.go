@@ -0,0 +1,301 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+type Handler struct {
+	ucs  UseCases
+	gsv  gsv.Server
+	mws  *mdw.Middlewares
+	keys *pkgjwt.KeySet
+}
+
+// NewHandler creates the auth handler. keys is nil when tokens are signed with
+// the shared HS256 secret; the JWKS endpoint then publishes no keys.
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares, keys *pkgjwt.KeySet) *Handler {
+	return &Handler{
+		ucs:  u,
+		gsv:  s,
+		mws:  m,
+		keys: keys,
+	}
+}
+
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/auth"
+
+	// Other services verify our RS256/ES256 tokens against this key set.
+	router.GET("/.well-known/jwks.json", h.mws.Cache("public"), h.JWKS)
+
+	auth := router.Group(apiBase)
+	{
+		// Unauthenticated endpoints share a per-IP limit against credential stuffing.
+		limited := auth.Group("", h.mws.RateLimit("auth"))
+
+		// Validated parses the credentials and leaves them in the context.
+		limited.Group("", h.mws.Validated...).POST("/login", h.Login)
+		limited.POST("/refresh", h.Refresh)
+		limited.POST("/logout", h.Logout)
+
+		limited.POST("/password/forgot", h.ForgotPassword)
+		limited.POST("/password/reset", h.ResetPassword)
+		auth.Group("", h.mws.Protected...).POST("/password/change", h.ChangePassword)
+
+		limited.GET("/oauth/:provider/start", h.StartOAuth)
+		limited.GET("/oauth/:provider/callback", h.OAuthCallback)
+
+		// Session lists carry device and IP data, never kept by caches.
+		sessions := auth.Group("/sessions", h.mws.Protected...)
+		sessions.Use(rejectAPIKeys, h.mws.Cache("no-store"))
+		sessions.GET("", h.ListSessions)
+		sessions.DELETE("", h.RevokeAllSessions)
+		sessions.DELETE("/:id", h.RevokeSession)
+
+		admin := auth.Group("/users", h.mws.Protected...)
+		admin.Use(h.mws.Tenant...)
+		admin.POST("/:id/logout", h.mws.RequirePermission("session:manage"), h.ForceLogout)
+	}
+
+	h.describeRoutes()
+}
+
+// rejectAPIKeys keeps session management to users: an API key has no session.
+func rejectAPIKeys(c *gin.Context) {
+	if _, ok := mdw.ScopesFromContext(c); ok {
+		c.Error(types.NewError(types.ErrAuthorization, "API keys have no sessions", nil))
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// withClient records the caller's device and IP on the session.
+func withClient(c *gin.Context) context.Context {
+	return WithClient(c.Request.Context(), domain.Client{
+		Device: c.Request.UserAgent(),
+		IP:     c.ClientIP(),
+	})
+}
+
+// oauthStateCookie binds the OAuth2 state to the browser that started the login,
+// so a callback URL crafted by someone else is rejected.
+const oauthStateCookie = "ponti_oauth_state"
+
+func (h *Handler) JWKS(c *gin.Context) {
+	set := utils.JWKS{Keys: []utils.JWK{}}
+	if h.keys != nil {
+		set = h.keys.JWKS()
+	}
+	c.JSON(http.StatusOK, set)
+}
+
+func (h *Handler) Login(c *gin.Context) {
+	value, _ := c.Get("credentials")
+	credentials, ok := value.(types.LoginCredentials)
+	if !ok {
+		c.Error(types.NewMissingFieldError("username/email"))
+		return
+	}
+
+	tokens, err := h.ucs.Login(withClient(c), credentials)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(tokens))
+}
+
+func (h *Handler) Refresh(c *gin.Context) {
+	var req dto.RefreshRequest
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	tokens, err := h.ucs.Refresh(withClient(c), req.RefreshToken)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(tokens))
+}
+
+func (h *Handler) Logout(c *gin.Context) {
+	var req dto.RefreshRequest
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	// The access token is optional; when sent it is revoked right away.
+	accessToken := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if err := h.ucs.Logout(c.Request.Context(), req.RefreshToken, accessToken); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Logged out successfully",
+	})
+}
+
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.ucs.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		c.Error(err)
+		return
+	}
+	// Same answer whether the email exists or not.
+	c.JSON(http.StatusAccepted, types.MessageResponse{
+		Message: "If the email is registered, a reset link was sent",
+	})
+}
+
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.ucs.ResetPassword(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Password reset successfully",
+	})
+}
+
+func (h *Handler) ChangePassword(c *gin.Context) {
+	userID, ok := mdw.SubjectFromContext(c)
+	if !ok {
+		c.Error(types.NewError(types.ErrAuthentication, "missing user", nil))
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.ucs.ChangePassword(c.Request.Context(), userID, req.CurrentPassword, req.NewPassword); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Password changed successfully",
+	})
+}
+
+// StartOAuth redirects the browser to the provider's login page.
+func (h *Handler) StartOAuth(c *gin.Context) {
+	authURL, state, err := h.ucs.StartOAuth(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	// Session cookie: the stored state already expires after OAuthStateTTL.
+	c.SetCookie(oauthStateCookie, state, 0, h.oauthPath(), "", isSecure(c), true)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OAuthCallback is where the provider redirects back with the code and state.
+func (h *Handler) OAuthCallback(c *gin.Context) {
+	if providerErr := c.Query("error"); providerErr != "" {
+		c.Error(types.NewError(types.ErrAuthentication, "oauth login failed: "+providerErr, nil))
+		return
+	}
+
+	state := c.Query("state")
+	cookie, err := c.Cookie(oauthStateCookie)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(state)) != 1 {
+		c.Error(types.NewError(types.ErrAuthentication, "oauth state does not match", err))
+		return
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, "", -1, h.oauthPath(), "", isSecure(c), true)
+
+	tokens, err := h.ucs.CompleteOAuth(withClient(c), c.Param("provider"), state, c.Query("code"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(tokens))
+}
+
+func (h *Handler) ListSessions(c *gin.Context) {
+	userID, _ := mdw.SubjectFromContext(c)
+	sessions, err := h.ucs.ListSessions(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	current, _ := mdw.SessionFromContext(c)
+	c.JSON(http.StatusOK, dto.FromDomainSessions(sessions, current))
+}
+
+func (h *Handler) RevokeSession(c *gin.Context) {
+	userID, _ := mdw.SubjectFromContext(c)
+	if err := h.ucs.RevokeSession(c.Request.Context(), userID, c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Session revoked successfully",
+	})
+}
+
+func (h *Handler) RevokeAllSessions(c *gin.Context) {
+	userID, _ := mdw.SubjectFromContext(c)
+	if err := h.ucs.RevokeAllSessions(c.Request.Context(), userID); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "All sessions revoked successfully",
+	})
+}
+
+// ForceLogout ends every session of another user of the organization.
+func (h *Handler) ForceLogout(c *gin.Context) {
+	if err := h.ucs.ForceLogout(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "User logged out successfully",
+	})
+}
+
+func (h *Handler) oauthPath() string {
+	return "/api/" + h.gsv.GetApiVersion() + "/auth/oauth"
+}
+
+func isSecure(c *gin.Context) bool {
+	return c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+}
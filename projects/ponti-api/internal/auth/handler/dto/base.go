@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// Response
+type TokenResponse struct {
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresIn        int64     `json:"expires_in"`
+	AccessExpiresAt  time.Time `json:"access_expires_at"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+func FromDomain(t *domain.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:      t.AccessToken,
+		RefreshToken:     t.RefreshToken,
+		TokenType:        t.TokenType,
+		ExpiresIn:        int64(time.Until(t.AccessExpiresAt).Seconds()),
+		AccessExpiresAt:  t.AccessExpiresAt,
+		RefreshExpiresAt: t.RefreshExpiresAt,
+	}
+}
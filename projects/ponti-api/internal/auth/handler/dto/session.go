@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+// Response
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	// Current marks the session of the token used for the request.
+	Current bool `json:"current"`
+}
+
+func FromDomainSessions(sessions []domain.Session, current string) []SessionResponse {
+	data := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		data = append(data, SessionResponse{
+			ID:         s.ID,
+			Device:     s.Device,
+			IP:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == current,
+		})
+	}
+	return data
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	provider := pkgswagger.PathParam("provider", "string", "OAuth provider configured in OAUTH_PROVIDERS")
+
+	pkgswagger.Describe(h.JWKS, pkgswagger.Operation{
+		Summary:   "Public keys to verify access tokens",
+		Responses: map[int]any{http.StatusOK: utils.JWKS{}},
+	})
+	pkgswagger.Describe(h.Login, pkgswagger.Operation{
+		Summary:     "Log in with email or username and password",
+		Description: "Starts a session and returns an access/refresh token pair.",
+		Request:     types.LoginCredentials{},
+		Responses:   map[int]any{http.StatusOK: dto.TokenResponse{}},
+	})
+	pkgswagger.Describe(h.Refresh, pkgswagger.Operation{
+		Summary:     "Rotate the refresh token",
+		Description: "The refresh token used is invalidated; reusing it revokes the session.",
+		Request:     dto.RefreshRequest{},
+		Responses:   map[int]any{http.StatusOK: dto.TokenResponse{}},
+	})
+	pkgswagger.Describe(h.Logout, pkgswagger.Operation{
+		Summary:   "Close the session of a refresh token",
+		Request:   dto.RefreshRequest{},
+		Responses: map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ForgotPassword, pkgswagger.Operation{
+		Summary:   "Send a password reset link",
+		Request:   dto.ForgotPasswordRequest{},
+		Responses: map[int]any{http.StatusAccepted: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ResetPassword, pkgswagger.Operation{
+		Summary:   "Reset the password with a reset token",
+		Request:   dto.ResetPasswordRequest{},
+		Responses: map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ChangePassword, pkgswagger.Operation{
+		Summary:   "Change the password of the current user",
+		Request:   dto.ChangePasswordRequest{},
+		Responses: map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.StartOAuth, pkgswagger.Operation{
+		Summary:    "Redirect to the OAuth provider",
+		Parameters: []pkgswagger.Parameter{provider},
+		Responses:  map[int]any{http.StatusFound: nil},
+	})
+	pkgswagger.Describe(h.OAuthCallback, pkgswagger.Operation{
+		Summary: "Complete an OAuth login",
+		Parameters: []pkgswagger.Parameter{
+			provider,
+			pkgswagger.QueryParam("code", "string", "Authorization code", true),
+			pkgswagger.QueryParam("state", "string", "State issued by the start endpoint", true),
+			pkgswagger.QueryParam("error", "string", "Error reported by the provider", false),
+		},
+		Responses: map[int]any{http.StatusOK: dto.TokenResponse{}},
+	})
+	pkgswagger.Describe(h.ListSessions, pkgswagger.Operation{
+		Summary:   "List the sessions of the current user",
+		Responses: map[int]any{http.StatusOK: []dto.SessionResponse{}},
+	})
+	pkgswagger.Describe(h.RevokeAllSessions, pkgswagger.Operation{
+		Summary:   "Revoke every session of the current user",
+		Responses: map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.RevokeSession, pkgswagger.Operation{
+		Summary:    "Revoke a session of the current user",
+		Parameters: []pkgswagger.Parameter{pkgswagger.PathParam("id", "string", "Session ID")},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ForceLogout, pkgswagger.Operation{
+		Summary:    "Revoke every session of a user",
+		Parameters: []pkgswagger.Parameter{pkgswagger.PathParam("id", "string", "User ID")},
+		NoBody:     true,
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
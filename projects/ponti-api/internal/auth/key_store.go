@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+)
+
+const (
+	signingKeysKey     = tokenKeyPrefix + ":jwks"
+	signingKeysLockKey = tokenKeyPrefix + ":jwks:lock"
+	signingKeysLockTTL = 10 * time.Second
+)
+
+// encryptedPEMPrefix marca las claves privadas cifradas. Las que no lo tienen son
+// de antes del cifrado; se leen igual y se cifran en la próxima rotación.
+const encryptedPEMPrefix = "enc:v1:"
+
+type keyStore struct {
+	cache     pkgredis.Cache
+	aead      cipher.AEAD
+	retention time.Duration
+}
+
+// NewKeyStore creates a pkgjwt.KeyStore backed by Redis, so every replica signs
+// and verifies with the same keys. Private keys are sealed with AES-GCM under
+// encryptionKey (32 bytes), so Redis read access alone cannot mint tokens. Keys
+// replaced more than retention ago are pruned on the next rotation.
+func NewKeyStore(c pkgredis.Cache, encryptionKey []byte, retention time.Duration) (pkgjwt.KeyStore, error) {
+	if len(encryptionKey) != 32 {
+		return nil, fmt.Errorf("signing keys encryption key must have 32 bytes, got %d", len(encryptionKey))
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signing keys cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signing keys cipher: %w", err)
+	}
+	return &keyStore{cache: c, aead: aead, retention: retention}, nil
+}
+
+func (s *keyStore) LoadKeys(ctx context.Context) ([]pkgjwt.StoredKey, error) {
+	raw, err := s.cache.Get(ctx, signingKeysKey)
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing keys: %w", err)
+	}
+	var keys []pkgjwt.StoredKey
+	if err := json.Unmarshal([]byte(raw), &keys); err != nil {
+		return nil, fmt.Errorf("failed to decode signing keys: %w", err)
+	}
+	for i := range keys {
+		if keys[i].PrivatePEM, err = s.open(keys[i].ID, keys[i].PrivatePEM); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// AddKey appends under a short SETNX lock, so two replicas rotating at the same
+// time do not overwrite each other's key. Every key is written back encrypted
+// and the ones past their retention are dropped.
+func (s *keyStore) AddKey(ctx context.Context, key pkgjwt.StoredKey) error {
+	if err := s.lock(ctx); err != nil {
+		return err
+	}
+	defer s.cache.Delete(ctx, signingKeysLockKey)
+
+	keys, err := s.LoadKeys(ctx)
+	if err != nil {
+		return err
+	}
+	keys = s.prune(append(keys, key), time.Now())
+	for i := range keys {
+		if keys[i].PrivatePEM, err = s.seal(keys[i].PrivatePEM); err != nil {
+			return err
+		}
+	}
+	raw, err := json.Marshal(keys)
+	if err != nil {
+		return fmt.Errorf("failed to encode signing keys: %w", err)
+	}
+	if err := s.cache.Set(ctx, signingKeysKey, raw, 0); err != nil {
+		return fmt.Errorf("failed to store signing keys: %w", err)
+	}
+	return nil
+}
+
+// prune descarta las claves reemplazadas hace más de retention, con la misma
+// regla que el KeySet: una clave queda reemplazada cuando se crea la siguiente.
+func (s *keyStore) prune(keys []pkgjwt.StoredKey, now time.Time) []pkgjwt.StoredKey {
+	if s.retention <= 0 {
+		return keys
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	result := make([]pkgjwt.StoredKey, 0, len(keys))
+	for i, key := range keys {
+		if i < len(keys)-1 && now.Sub(keys[i+1].CreatedAt) > s.retention {
+			continue
+		}
+		result = append(result, key)
+	}
+	return result
+}
+
+func (s *keyStore) seal(privatePEM string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+	sealed := s.aead.Seal(nonce, nonce, []byte(privatePEM), nil)
+	return encryptedPEMPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *keyStore) open(kid, stored string) (string, error) {
+	encoded, ok := strings.CutPrefix(stored, encryptedPEMPrefix)
+	if !ok {
+		return stored, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return "", fmt.Errorf("signing key %s is corrupted", kid)
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	plain, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt signing key %s: wrong encryption key?", kid)
+	}
+	return string(plain), nil
+}
+
+func (s *keyStore) lock(ctx context.Context) error {
+	for attempt := 0; attempt < 20; attempt++ {
+		acquired, err := s.cache.SetNX(ctx, signingKeysLockKey, "1", signingKeysLockTTL)
+		if err != nil {
+			return fmt.Errorf("failed to lock signing keys: %w", err)
+		}
+		if acquired {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return fmt.Errorf("signing keys are locked by another replica")
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+)
+
+// memoryCache keeps the values the key store writes; the rest of pkgredis.Cache
+// is not used by it.
+type memoryCache struct {
+	pkgredis.Cache
+	data map[string]string
+}
+
+func (m *memoryCache) Get(_ context.Context, key string) (string, error) {
+	v, ok := m.data[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	return v, nil
+}
+
+func (m *memoryCache) Set(_ context.Context, key string, value any, _ ...time.Duration) error {
+	m.data[key] = string(value.([]byte))
+	return nil
+}
+
+func (m *memoryCache) SetNX(_ context.Context, key string, value any, _ time.Duration) (bool, error) {
+	if _, ok := m.data[key]; ok {
+		return false, nil
+	}
+	m.data[key] = value.(string)
+	return true, nil
+}
+
+func (m *memoryCache) Delete(_ context.Context, key string) error {
+	delete(m.data, key)
+	return nil
+}
+
+func TestKeyStoreEncryptsPrivateKeys(t *testing.T) {
+	ctx := context.Background()
+	cache := &memoryCache{data: map[string]string{}}
+	encryptionKey := bytes.Repeat([]byte{7}, 32)
+	store, err := NewKeyStore(cache, encryptionKey, 0)
+	require.NoError(t, err)
+
+	key := storedES256Key(t, "k1", time.Now())
+	require.NoError(t, store.AddKey(ctx, key))
+
+	raw := cache.data[signingKeysKey]
+	assert.NotContains(t, raw, "PRIVATE KEY", "the PEM must not reach Redis in clear")
+	assert.Contains(t, raw, encryptedPEMPrefix)
+
+	keys, err := store.LoadKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, key.PrivatePEM, keys[0].PrivatePEM)
+
+	other, err := NewKeyStore(cache, bytes.Repeat([]byte{8}, 32), 0)
+	require.NoError(t, err)
+	_, err = other.LoadKeys(ctx)
+	assert.ErrorContains(t, err, "failed to decrypt signing key k1")
+
+	_, err = NewKeyStore(cache, []byte("short"), 0)
+	assert.Error(t, err)
+}
+
+func TestKeyStoreEncryptsLegacyKeysAndPrunesExpiredOnes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	legacy := []pkgjwt.StoredKey{
+		storedES256Key(t, "expired", now.Add(-5*time.Hour)),
+		storedES256Key(t, "retained", now.Add(-2*time.Hour)),
+		storedES256Key(t, "current", now.Add(-30*time.Minute)),
+	}
+	raw, err := json.Marshal(legacy)
+	require.NoError(t, err)
+	cache := &memoryCache{data: map[string]string{signingKeysKey: string(raw)}}
+	store, err := NewKeyStore(cache, bytes.Repeat([]byte{7}, 32), time.Hour)
+	require.NoError(t, err)
+
+	keys, err := store.LoadKeys(ctx)
+	require.NoError(t, err)
+	assert.Len(t, keys, 3, "plaintext keys stored before encryption are still read")
+
+	require.NoError(t, store.AddKey(ctx, storedES256Key(t, "new", now)))
+
+	assert.NotContains(t, cache.data[signingKeysKey], "PRIVATE KEY")
+	keys, err = store.LoadKeys(ctx)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, k.ID)
+	}
+	assert.Equal(t, []string{"retained", "current", "new"}, ids)
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+)
+
+// serveJWKS publishes the key set through the auth handler, as other services see it.
+func serveJWKS(t *testing.T, keys *pkgjwt.KeySet) *httptest.Server {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/.well-known/jwks.json", (&Handler{keys: keys}).JWKS)
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func kidOf(t *testing.T, token string) string {
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+	require.NoError(t, err)
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+func TestAsymmetricSigningVerifiedThroughJWKS(t *testing.T) {
+	for _, alg := range []string{pkgjwt.AlgRS256, pkgjwt.AlgES256} {
+		t.Run(alg, func(t *testing.T) {
+			ctx := context.Background()
+			keys, err := pkgjwt.NewKeySet(ctx, alg, nil, pkgjwt.KeySetOptions{})
+			require.NoError(t, err)
+			svc, err := pkgjwt.BootstrapWithKeys(keys, 15, 60)
+			require.NoError(t, err)
+
+			tokens, err := svc.GenerateTokens(ctx, "user-1", 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, keys.Current().ID, kidOf(t, tokens.AccessToken))
+
+			claims, err := svc.ValidateToken(ctx, tokens.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", claims.Subject)
+
+			srv := serveJWKS(t, keys)
+			var set pkgutils.JWKS
+			resp, err := http.Get(srv.URL + "/.well-known/jwks.json")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&set))
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, alg, set.Keys[0].Alg)
+
+			// Another service validates with the published keys only, no shared secret.
+			router := gin.New()
+			router.Use(mdw.Validate(pkgutils.Config{
+				TokenLookup: "header:Authorization",
+				TokenPrefix: "Bearer ",
+				Keys:        pkgutils.NewJWKSProvider(srv.URL+"/.well-known/jwks.json", 0),
+			}))
+			router.GET("/", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+			for name, tc := range map[string]struct {
+				token string
+				want  int
+			}{
+				"valid":    {tokens.AccessToken, http.StatusNoContent},
+				"tampered": {tokens.AccessToken[:len(tokens.AccessToken)-4] + "AAAA", http.StatusUnauthorized},
+				"refresh":  {tokens.RefreshToken, http.StatusUnauthorized},
+			} {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				assert.Equal(t, tc.want, w.Code, name)
+			}
+		})
+	}
+}
+
+func TestKeyRotationKeepsOldKeysUntilRetention(t *testing.T) {
+	ctx := context.Background()
+	keys, err := pkgjwt.NewKeySet(ctx, pkgjwt.AlgES256, nil, pkgjwt.KeySetOptions{Retention: time.Hour})
+	require.NoError(t, err)
+	svc, err := pkgjwt.BootstrapWithKeys(keys, 15, 60)
+	require.NoError(t, err)
+
+	before, err := svc.GenerateTokens(ctx, "user-1", 0, 0)
+	require.NoError(t, err)
+	oldKid := keys.Current().ID
+
+	require.NoError(t, keys.Rotate(ctx))
+	after, err := svc.GenerateTokens(ctx, "user-1", 0, 0)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, oldKid, kidOf(t, after.AccessToken))
+	_, err = svc.ValidateToken(ctx, before.AccessToken)
+	assert.NoError(t, err, "tokens signed with the previous key stay valid")
+	_, err = svc.ValidateToken(ctx, after.AccessToken)
+	assert.NoError(t, err)
+	assert.Len(t, keys.JWKS().Keys, 2)
+}
+
+func TestKeyRetentionDropsReplacedKeys(t *testing.T) {
+	ctx := context.Background()
+	store := pkgjwt.NewMemoryKeyStore()
+	now := time.Now()
+	require.NoError(t, store.AddKey(ctx, storedES256Key(t, "old", now.Add(-3*time.Hour))))
+	require.NoError(t, store.AddKey(ctx, storedES256Key(t, "current", now.Add(-2*time.Hour))))
+
+	keys, err := pkgjwt.NewKeySet(ctx, pkgjwt.AlgES256, store, pkgjwt.KeySetOptions{Retention: time.Hour})
+	require.NoError(t, err)
+
+	assert.Equal(t, "current", keys.Current().ID)
+	_, err = keys.VerificationKey(ctx, "old")
+	assert.Error(t, err, "replaced more than Retention ago")
+	_, err = keys.VerificationKey(ctx, "current")
+	assert.NoError(t, err)
+}
+
+func TestKeySetStillAcceptsLegacyHS256Tokens(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("JWT_SECRET_KEY", "test-secret")
+	legacy, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+	require.NoError(t, err)
+	old, err := legacy.GenerateTokens(ctx, "user-1", 0, 0)
+	require.NoError(t, err)
+
+	keys, err := pkgjwt.NewKeySet(ctx, pkgjwt.AlgRS256, nil, pkgjwt.KeySetOptions{})
+	require.NoError(t, err)
+	svc, err := pkgjwt.BootstrapWithKeys(keys, 15, 60)
+	require.NoError(t, err)
+
+	claims, err := svc.ValidateToken(ctx, old.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.Subject)
+
+	// Without the secret, HS256 tokens are rejected instead of checked against a key.
+	t.Setenv("JWT_SECRET_KEY", "")
+	svc, err = pkgjwt.BootstrapWithKeys(keys, 15, 60)
+	require.NoError(t, err)
+	_, err = svc.ValidateToken(ctx, old.AccessToken)
+	assert.Error(t, err)
+}
+
+func storedES256Key(t *testing.T, kid string, createdAt time.Time) pkgjwt.StoredKey {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	return pkgjwt.StoredKey{
+		ID:         kid,
+		Algorithm:  pkgjwt.AlgES256,
+		PrivatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		CreatedAt:  createdAt,
+	}
+}
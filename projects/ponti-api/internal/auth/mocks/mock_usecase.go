@@ -0,0 +1,482 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/auth/ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockUseCases) ChangePassword(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUseCasesMockRecorder) ChangePassword(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUseCases)(nil).ChangePassword), arg0, arg1, arg2, arg3)
+}
+
+// CompleteOAuth mocks base method.
+func (m *MockUseCases) CompleteOAuth(arg0 context.Context, arg1, arg2, arg3 string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOAuth", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOAuth indicates an expected call of CompleteOAuth.
+func (mr *MockUseCasesMockRecorder) CompleteOAuth(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOAuth", reflect.TypeOf((*MockUseCases)(nil).CompleteOAuth), arg0, arg1, arg2, arg3)
+}
+
+// ForceLogout mocks base method.
+func (m *MockUseCases) ForceLogout(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceLogout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceLogout indicates an expected call of ForceLogout.
+func (mr *MockUseCasesMockRecorder) ForceLogout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceLogout", reflect.TypeOf((*MockUseCases)(nil).ForceLogout), arg0, arg1)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockUseCases) ForgotPassword(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockUseCasesMockRecorder) ForgotPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockUseCases)(nil).ForgotPassword), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockUseCases) ListSessions(arg0 context.Context, arg1 string) ([]domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockUseCasesMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUseCases)(nil).ListSessions), arg0, arg1)
+}
+
+// Login mocks base method.
+func (m *MockUseCases) Login(arg0 context.Context, arg1 pkgtypes.LoginCredentials) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockUseCasesMockRecorder) Login(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUseCases)(nil).Login), arg0, arg1)
+}
+
+// Logout mocks base method.
+func (m *MockUseCases) Logout(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUseCasesMockRecorder) Logout(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUseCases)(nil).Logout), arg0, arg1, arg2)
+}
+
+// Refresh mocks base method.
+func (m *MockUseCases) Refresh(arg0 context.Context, arg1 string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockUseCasesMockRecorder) Refresh(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockUseCases)(nil).Refresh), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUseCases) ResetPassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUseCasesMockRecorder) ResetPassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUseCases)(nil).ResetPassword), arg0, arg1, arg2)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockUseCases) RevokeAllSessions(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockUseCasesMockRecorder) RevokeAllSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockUseCases)(nil).RevokeAllSessions), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUseCases) RevokeSession(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUseCasesMockRecorder) RevokeSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUseCases)(nil).RevokeSession), arg0, arg1, arg2)
+}
+
+// StartOAuth mocks base method.
+func (m *MockUseCases) StartOAuth(arg0 context.Context, arg1 string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartOAuth", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartOAuth indicates an expected call of StartOAuth.
+func (mr *MockUseCasesMockRecorder) StartOAuth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOAuth", reflect.TypeOf((*MockUseCases)(nil).StartOAuth), arg0, arg1)
+}
+
+// MockTokenStore is a mock of TokenStore interface.
+type MockTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenStoreMockRecorder
+}
+
+// MockTokenStoreMockRecorder is the mock recorder for MockTokenStore.
+type MockTokenStoreMockRecorder struct {
+	mock *MockTokenStore
+}
+
+// NewMockTokenStore creates a new mock instance.
+func NewMockTokenStore(ctrl *gomock.Controller) *MockTokenStore {
+	mock := &MockTokenStore{ctrl: ctrl}
+	mock.recorder = &MockTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenStore) EXPECT() *MockTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// ConsumeOAuthState mocks base method.
+func (m *MockTokenStore) ConsumeOAuthState(arg0 context.Context, arg1 string) (*domain.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOAuthState", arg0, arg1)
+	ret0, _ := ret[0].(*domain.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
+func (mr *MockTokenStoreMockRecorder) ConsumeOAuthState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockTokenStore)(nil).ConsumeOAuthState), arg0, arg1)
+}
+
+// ConsumeResetToken mocks base method.
+func (m *MockTokenStore) ConsumeResetToken(arg0 context.Context, arg1 string) (*domain.ResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeResetToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeResetToken indicates an expected call of ConsumeResetToken.
+func (mr *MockTokenStoreMockRecorder) ConsumeResetToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeResetToken", reflect.TypeOf((*MockTokenStore)(nil).ConsumeResetToken), arg0, arg1)
+}
+
+// DeleteSession mocks base method.
+func (m *MockTokenStore) DeleteSession(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockTokenStoreMockRecorder) DeleteSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockTokenStore)(nil).DeleteSession), arg0, arg1, arg2)
+}
+
+// DenyToken mocks base method.
+func (m *MockTokenStore) DenyToken(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyToken indicates an expected call of DenyToken.
+func (mr *MockTokenStoreMockRecorder) DenyToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyToken", reflect.TypeOf((*MockTokenStore)(nil).DenyToken), arg0, arg1, arg2)
+}
+
+// GetRefreshToken mocks base method.
+func (m *MockTokenStore) GetRefreshToken(arg0 context.Context, arg1 string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MockTokenStoreMockRecorder) GetRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MockTokenStore)(nil).GetRefreshToken), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockTokenStore) GetSession(arg0 context.Context, arg1 string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockTokenStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockTokenStore)(nil).GetSession), arg0, arg1)
+}
+
+// IsFamilyRevoked mocks base method.
+func (m *MockTokenStore) IsFamilyRevoked(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFamilyRevoked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFamilyRevoked indicates an expected call of IsFamilyRevoked.
+func (mr *MockTokenStoreMockRecorder) IsFamilyRevoked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFamilyRevoked", reflect.TypeOf((*MockTokenStore)(nil).IsFamilyRevoked), arg0, arg1)
+}
+
+// IsTokenDenied mocks base method.
+func (m *MockTokenStore) IsTokenDenied(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenDenied", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenDenied indicates an expected call of IsTokenDenied.
+func (mr *MockTokenStoreMockRecorder) IsTokenDenied(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenDenied", reflect.TypeOf((*MockTokenStore)(nil).IsTokenDenied), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockTokenStore) ListSessions(arg0 context.Context, arg1 string) ([]domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockTokenStoreMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockTokenStore)(nil).ListSessions), arg0, arg1)
+}
+
+// MarkUsed mocks base method.
+func (m *MockTokenStore) MarkUsed(arg0 context.Context, arg1 string, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockTokenStoreMockRecorder) MarkUsed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockTokenStore)(nil).MarkUsed), arg0, arg1, arg2)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockTokenStore) RevokeFamily(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockTokenStoreMockRecorder) RevokeFamily(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockTokenStore)(nil).RevokeFamily), arg0, arg1, arg2)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockTokenStore) RevokeUserTokens(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockTokenStoreMockRecorder) RevokeUserTokens(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockTokenStore)(nil).RevokeUserTokens), arg0, arg1, arg2)
+}
+
+// SaveOAuthState mocks base method.
+func (m *MockTokenStore) SaveOAuthState(arg0 context.Context, arg1 string, arg2 *domain.OAuthState, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOAuthState", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOAuthState indicates an expected call of SaveOAuthState.
+func (mr *MockTokenStoreMockRecorder) SaveOAuthState(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthState", reflect.TypeOf((*MockTokenStore)(nil).SaveOAuthState), arg0, arg1, arg2, arg3)
+}
+
+// SaveRefreshToken mocks base method.
+func (m *MockTokenStore) SaveRefreshToken(arg0 context.Context, arg1 *domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRefreshToken indicates an expected call of SaveRefreshToken.
+func (mr *MockTokenStoreMockRecorder) SaveRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRefreshToken", reflect.TypeOf((*MockTokenStore)(nil).SaveRefreshToken), arg0, arg1)
+}
+
+// SaveResetToken mocks base method.
+func (m *MockTokenStore) SaveResetToken(arg0 context.Context, arg1 string, arg2 *domain.ResetToken, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResetToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResetToken indicates an expected call of SaveResetToken.
+func (mr *MockTokenStoreMockRecorder) SaveResetToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResetToken", reflect.TypeOf((*MockTokenStore)(nil).SaveResetToken), arg0, arg1, arg2, arg3)
+}
+
+// SaveSession mocks base method.
+func (m *MockTokenStore) SaveSession(arg0 context.Context, arg1 *domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSession indicates an expected call of SaveSession.
+func (mr *MockTokenStoreMockRecorder) SaveSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSession", reflect.TypeOf((*MockTokenStore)(nil).SaveSession), arg0, arg1)
+}
+
+// UserTokensRevokedAt mocks base method.
+func (m *MockTokenStore) UserTokensRevokedAt(arg0 context.Context, arg1 string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserTokensRevokedAt", arg0, arg1)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserTokensRevokedAt indicates an expected call of UserTokensRevokedAt.
+func (mr *MockTokenStoreMockRecorder) UserTokensRevokedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserTokensRevokedAt", reflect.TypeOf((*MockTokenStore)(nil).UserTokensRevokedAt), arg0, arg1)
+}
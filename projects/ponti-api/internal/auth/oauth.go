@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgoauth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+var errInvalidOAuthState = pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid or expired oauth state", nil)
+
+// StartOAuth begins an authorization code flow with PKCE. The state is random and
+// single use; only its hash is stored, together with the code verifier.
+func (u *useCases) StartOAuth(ctx context.Context, provider string) (string, string, error) {
+	service, err := u.oauthProvider(provider)
+	if err != nil {
+		return "", "", err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate oauth state", err)
+	}
+	state := hex.EncodeToString(raw)
+	verifier := pkgoauth2.GenerateVerifier()
+	if err := u.store.SaveOAuthState(ctx, hashToken(state), &domain.OAuthState{
+		Provider: provider,
+		Verifier: verifier,
+		IssuedAt: time.Now(),
+	}, u.options.OAuthStateTTL); err != nil {
+		return "", "", err
+	}
+	return service.GetAuthCodeURL(state, pkgoauth2.WithPKCE(verifier)), state, nil
+}
+
+// CompleteOAuth redeems the state, exchanges the code with the PKCE verifier and
+// issues our own tokens for the user linked to the external account, creating
+// the user on the first login.
+func (u *useCases) CompleteOAuth(ctx context.Context, provider, state, code string) (*domain.TokenPair, error) {
+	service, err := u.oauthProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	if state == "" || code == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "state and code are required", nil)
+	}
+
+	record, err := u.store.ConsumeOAuthState(ctx, hashToken(state))
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return nil, errInvalidOAuthState
+		}
+		return nil, err
+	}
+	if record.Provider != provider {
+		return nil, errInvalidOAuthState
+	}
+
+	token, err := service.ExchangeCode(ctx, code, pkgoauth2.WithPKCE(record.Verifier))
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "failed to exchange authorization code", err)
+	}
+	info, err := service.GetUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to get user info from "+provider, err)
+	}
+
+	usr, err := u.users.ResolveExternalAccount(ctx, &userdom.ExternalAccount{
+		Provider:      provider,
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if u.options.RequireVerifiedEmail && !usr.EmailValidated {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthorization, "email has not been verified", nil)
+	}
+	if err := u.users.RecordLogin(ctx, usr.ID); err != nil {
+		log.Printf("[Auth] failed to record login of user %s: %v", usr.ID, err)
+	}
+	return u.issue(ctx, usr, uuid.New().String())
+}
+
+func (u *useCases) oauthProvider(name string) (pkgoauth2.Service, error) {
+	service, ok := u.options.OAuthProviders[name]
+	if !ok || service == nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "unknown oauth provider "+name, nil)
+	}
+	return service, nil
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgoauth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2"
+	pkgxaouth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2/xoauth2"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/mocks"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	usermocks "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// fakeProvider is a minimal OAuth2 authorization server. It only accepts the
+// code it issued, and only with the verifier matching the challenge it was sent.
+type fakeProvider struct {
+	*httptest.Server
+	challenge string
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	p := &fakeProvider{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "good-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != p.challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"provider-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer provider-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pkgoauth2.UserInfo{Subject: "ext-1", Email: "a@b.com", EmailVerified: true})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func newOAuthTestUseCases(t *testing.T, provider *fakeProvider) (UseCases, *usermocks.MockUseCases, *mocks.MockTokenStore) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	jwtService, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+	require.NoError(t, err)
+	service, err := pkgxaouth2.NewService(&pkgxaouth2.Config{BaseConfig: pkgoauth2.BaseConfig{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		AuthURL:      provider.URL + "/authorize",
+		TokenURL:     provider.URL + "/token",
+		UserInfoURL:  provider.URL + "/userinfo",
+		RedirectURL:  "http://localhost/api/v1/auth/oauth/fake/callback",
+		Scopes:       []string{"openid", "email"},
+	}})
+	require.NoError(t, err)
+
+	users := usermocks.NewMockUseCases(ctrl)
+	store := mocks.NewMockTokenStore(ctrl)
+	return NewUseCases(users, jwtService, store, &fakeMailer{}, Options{
+		TenantClaim:    "tenant_id",
+		OAuthProviders: map[string]pkgoauth2.Service{"fake": service},
+	}), users, store
+}
+
+// startOAuth runs StartOAuth and hands the PKCE challenge to the fake provider,
+// as the browser would when following the redirect.
+func startOAuth(t *testing.T, u UseCases, store *mocks.MockTokenStore, provider *fakeProvider) (string, *domain.OAuthState) {
+	var saved *domain.OAuthState
+	store.EXPECT().SaveOAuthState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, hash string, st *domain.OAuthState, _ any) error {
+			saved = st
+			return nil
+		})
+
+	authURL, state, err := u.StartOAuth(context.Background(), "fake")
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, state, query.Get("state"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.NotContains(t, authURL, saved.Verifier)
+	provider.challenge = query.Get("code_challenge")
+	return state, saved
+}
+
+func TestCompleteOAuth(t *testing.T) {
+	usr := &userdom.User{ID: "u1", EmailValidated: true}
+
+	t.Run("first login resolves the external account and issues tokens", func(t *testing.T) {
+		provider := newFakeProvider(t)
+		u, users, store := newOAuthTestUseCases(t, provider)
+		state, saved := startOAuth(t, u, store, provider)
+
+		store.EXPECT().ConsumeOAuthState(gomock.Any(), hashToken(state)).Return(saved, nil)
+		users.EXPECT().ResolveExternalAccount(gomock.Any(), &userdom.ExternalAccount{
+			Provider: "fake", Subject: "ext-1", Email: "a@b.com", EmailVerified: true,
+		}).Return(usr, nil)
+		users.EXPECT().RecordLogin(gomock.Any(), "u1").Return(nil)
+		store.EXPECT().SaveRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+		expectNewSession(t, store, "u1", domain.Client{})
+
+		tokens, err := u.CompleteOAuth(context.Background(), "fake", state, "good-code")
+		require.NoError(t, err)
+		assert.NotEmpty(t, tokens.AccessToken)
+		assert.NotEmpty(t, tokens.RefreshToken)
+	})
+
+	t.Run("wrong PKCE verifier is rejected by the provider", func(t *testing.T) {
+		provider := newFakeProvider(t)
+		u, _, store := newOAuthTestUseCases(t, provider)
+		state, saved := startOAuth(t, u, store, provider)
+
+		tampered := *saved
+		tampered.Verifier = pkgoauth2.GenerateVerifier()
+		store.EXPECT().ConsumeOAuthState(gomock.Any(), hashToken(state)).Return(&tampered, nil)
+
+		_, err := u.CompleteOAuth(context.Background(), "fake", state, "good-code")
+		assertErrType(t, err, pkgtypes.ErrAuthentication)
+	})
+
+	t.Run("state issued for another provider", func(t *testing.T) {
+		provider := newFakeProvider(t)
+		u, _, store := newOAuthTestUseCases(t, provider)
+		state, saved := startOAuth(t, u, store, provider)
+
+		other := *saved
+		other.Provider = "other"
+		store.EXPECT().ConsumeOAuthState(gomock.Any(), hashToken(state)).Return(&other, nil)
+
+		_, err := u.CompleteOAuth(context.Background(), "fake", state, "good-code")
+		assertErrType(t, err, pkgtypes.ErrAuthentication)
+	})
+
+	t.Run("unknown or already used state", func(t *testing.T) {
+		provider := newFakeProvider(t)
+		u, _, store := newOAuthTestUseCases(t, provider)
+
+		store.EXPECT().ConsumeOAuthState(gomock.Any(), hashToken("replayed")).
+			Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "oauth state already used", nil))
+
+		_, err := u.CompleteOAuth(context.Background(), "fake", "replayed", "good-code")
+		assertErrType(t, err, pkgtypes.ErrAuthentication)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		provider := newFakeProvider(t)
+		u, _, _ := newOAuthTestUseCases(t, provider)
+
+		_, _, err := u.StartOAuth(context.Background(), "nope")
+		assertErrType(t, err, pkgtypes.ErrNotFound)
+	})
+}
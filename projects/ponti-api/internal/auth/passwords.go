@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+var errInvalidResetToken = pkgtypes.NewError(pkgtypes.ErrValidation, "invalid or expired reset token", nil)
+
+// ForgotPassword emails a short-lived reset link. To avoid revealing which
+// addresses are registered, unknown emails return no error and send nothing.
+func (u *useCases) ForgotPassword(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "email is required", nil)
+	}
+	if u.mailer == nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "password reset is not configured", nil)
+	}
+
+	usr, err := u.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate reset token", err)
+	}
+	token := hex.EncodeToString(raw)
+	if err := u.store.SaveResetToken(ctx, hashToken(token), &domain.ResetToken{
+		UserID:   usr.ID,
+		IssuedAt: time.Now(),
+	}, u.options.ResetTTL); err != nil {
+		return err
+	}
+
+	separator := "?"
+	if strings.Contains(u.options.ResetURL, "?") {
+		separator = "&"
+	}
+	link := u.options.ResetURL + separator + "token=" + url.QueryEscape(token)
+	body := fmt.Sprintf(
+		"Hola,\n\nPara elegir una nueva contraseña ingresá al siguiente link:\n\n%s\n\nEl link vence en %s. Si no lo pediste, ignorá este email.\n",
+		link, u.options.ResetTTL,
+	)
+	if err := u.mailer.SendEmail(ctx, usr.Credentials.Email, "Restablecé tu contraseña", body); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to send reset email", err)
+	}
+	return nil
+}
+
+// ResetPassword redeems a reset token. Tokens requested before the last password
+// change are rejected, and every session of the user is revoked.
+func (u *useCases) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if token == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "token is required", nil)
+	}
+	if err := pkgutils.ValidatePasswordComplexity(newPassword); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, err.Error(), nil)
+	}
+
+	record, err := u.store.ConsumeResetToken(ctx, hashToken(token))
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return errInvalidResetToken
+		}
+		return err
+	}
+	revokedAt, err := u.store.UserTokensRevokedAt(ctx, record.UserID)
+	if err != nil {
+		return err
+	}
+	if record.IssuedAt.Before(revokedAt) {
+		return errInvalidResetToken
+	}
+
+	return u.setPassword(ctx, record.UserID, newPassword)
+}
+
+// ChangePassword updates the password of an authenticated user after checking the
+// current one. Every refresh token of the user is revoked, so other devices must log in again.
+func (u *useCases) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	if userID == "" {
+		return pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing user", nil)
+	}
+	usr, err := u.users.GetUser(ctx, userID)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrAuthentication, "user no longer exists", err)
+	}
+	ok, err := pkgutils.VerifyPassword(currentPassword, usr.Credentials.Password)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to verify password", err)
+	}
+	if !ok {
+		return pkgtypes.NewErrorWithContext(pkgtypes.ErrValidation, "current password is incorrect", nil,
+			map[string]any{"field": "current_password"})
+	}
+	if currentPassword == newPassword {
+		return pkgtypes.NewErrorWithContext(pkgtypes.ErrValidation, "new password must differ from the current one", nil,
+			map[string]any{"field": "new_password"})
+	}
+	if err := pkgutils.ValidatePasswordComplexity(newPassword); err != nil {
+		return pkgtypes.NewErrorWithContext(pkgtypes.ErrValidation, err.Error(), nil,
+			map[string]any{"field": "new_password"})
+	}
+
+	return u.setPassword(ctx, userID, newPassword)
+}
+
+// setPassword stores the new password and revokes the user's refresh tokens.
+func (u *useCases) setPassword(ctx context.Context, userID, password string) error {
+	if err := u.users.UpdatePassword(ctx, userID, password); err != nil {
+		return err
+	}
+	if err := u.store.RevokeUserTokens(ctx, userID, time.Now()); err != nil {
+		log.Printf("[Auth] password of user %s changed but its sessions could not be revoked: %v", userID, err)
+		return err
+	}
+	return nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
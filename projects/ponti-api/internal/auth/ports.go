@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+type UseCases interface {
+	Login(context.Context, pkgtypes.LoginCredentials) (*domain.TokenPair, error)
+	Refresh(context.Context, string) (*domain.TokenPair, error)
+	// Logout takes the refresh token and, optionally, the access token to denylist.
+	Logout(context.Context, string, string) error
+	// ForgotPassword emails a reset link; unknown emails are silently ignored.
+	ForgotPassword(context.Context, string) error
+	// ResetPassword sets a new password using a token sent by ForgotPassword.
+	ResetPassword(context.Context, string, string) error
+	// ChangePassword requires the user's current password.
+	ChangePassword(context.Context, string, string, string) error
+	// StartOAuth returns the provider's authorization URL and the state bound to it.
+	StartOAuth(context.Context, string) (string, string, error)
+	// CompleteOAuth exchanges the authorization code and logs in the linked user.
+	CompleteOAuth(context.Context, string, string, string) (*domain.TokenPair, error)
+
+	// ListSessions returns the user's active sessions.
+	ListSessions(context.Context, string) ([]domain.Session, error)
+	// RevokeSession ends one session of the user; other users' sessions are not found.
+	RevokeSession(context.Context, string, string) error
+	// RevokeAllSessions ends every session of the user.
+	RevokeAllSessions(context.Context, string) error
+	// ForceLogout ends every session of a user of the caller's organization.
+	ForceLogout(context.Context, string) error
+}
+
+// TokenStore keeps refresh tokens, their families and sessions in Redis.
+type TokenStore interface {
+	SaveRefreshToken(context.Context, *domain.RefreshToken) error
+	// GetRefreshToken returns ErrNotFound when the token is unknown or expired.
+	GetRefreshToken(context.Context, string) (*domain.RefreshToken, error)
+	// MarkUsed returns false if the token had already been used.
+	MarkUsed(context.Context, string, time.Time) (bool, error)
+	RevokeFamily(context.Context, string, time.Time) error
+	IsFamilyRevoked(context.Context, string) (bool, error)
+	// RevokeUserTokens invalidates every refresh token issued to the user before the given time.
+	RevokeUserTokens(context.Context, string, time.Time) error
+	// UserTokensRevokedAt returns the zero time if the user's tokens were never revoked.
+	UserTokensRevokedAt(context.Context, string) (time.Time, error)
+
+	SaveSession(context.Context, *domain.Session) error
+	// GetSession returns ErrNotFound when the session is unknown or expired.
+	GetSession(context.Context, string) (*domain.Session, error)
+	ListSessions(context.Context, string) ([]domain.Session, error)
+	DeleteSession(context.Context, string, string) error
+	// DenyToken rejects the access token with the given jti until it expires.
+	DenyToken(context.Context, string, time.Time) error
+	IsTokenDenied(context.Context, string) (bool, error)
+
+	SaveResetToken(context.Context, string, *domain.ResetToken, time.Duration) error
+	// ConsumeResetToken returns ErrNotFound when the token is unknown, expired or already used.
+	ConsumeResetToken(context.Context, string) (*domain.ResetToken, error)
+
+	SaveOAuthState(context.Context, string, *domain.OAuthState, time.Duration) error
+	// ConsumeOAuthState returns ErrNotFound when the state is unknown, expired or already used.
+	ConsumeOAuthState(context.Context, string) (*domain.OAuthState, error)
+}
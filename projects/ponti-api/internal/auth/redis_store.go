@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+)
+
+const tokenKeyPrefix = "ponti-api:auth"
+
+type tokenStore struct {
+	cache  pkgredis.Cache
+	maxTTL time.Duration
+}
+
+// NewTokenStore creates a TokenStore backed by Redis. maxTTL is the refresh token
+// lifetime; per-user revocations are kept that long.
+func NewTokenStore(c pkgredis.Cache, maxTTL time.Duration) TokenStore {
+	return &tokenStore{cache: c, maxTTL: maxTTL}
+}
+
+func refreshKey(id string) string      { return tokenKeyPrefix + ":refresh:" + id }
+func usedKey(id string) string         { return tokenKeyPrefix + ":refresh:" + id + ":used" }
+func familyKey(familyID string) string { return tokenKeyPrefix + ":family:" + familyID + ":revoked" }
+func userRevokedKey(userID string) string {
+	return tokenKeyPrefix + ":user:" + userID + ":revoked_at"
+}
+func resetKey(hash string) string     { return tokenKeyPrefix + ":reset:" + hash }
+func resetUsedKey(hash string) string { return tokenKeyPrefix + ":reset:" + hash + ":used" }
+func sessionKey(id string) string     { return tokenKeyPrefix + ":session:" + id }
+func userSessionsKey(userID string) string {
+	return tokenKeyPrefix + ":user:" + userID + ":sessions"
+}
+func deniedKey(jti string) string     { return tokenKeyPrefix + ":denied:" + jti }
+func oauthKey(hash string) string     { return tokenKeyPrefix + ":oauth:" + hash }
+func oauthUsedKey(hash string) string { return tokenKeyPrefix + ":oauth:" + hash + ":used" }
+
+func (s *tokenStore) SaveRefreshToken(ctx context.Context, t *domain.RefreshToken) error {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to encode refresh token", err)
+	}
+	if err := s.cache.Set(ctx, refreshKey(t.ID), raw, time.Until(t.ExpiresAt)); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to store refresh token", err)
+	}
+	return nil
+}
+
+func (s *tokenStore) GetRefreshToken(ctx context.Context, id string) (*domain.RefreshToken, error) {
+	raw, err := s.cache.Get(ctx, refreshKey(id))
+	if errors.Is(err, redis.Nil) {
+		return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "refresh token not found", nil)
+	}
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to read refresh token", err)
+	}
+	var t domain.RefreshToken
+	if err := json.Unmarshal([]byte(raw), &t); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to decode refresh token", err)
+	}
+	return &t, nil
+}
+
+// MarkUsed is atomic (SETNX), so two concurrent refreshes with the same token
+// cannot both succeed.
+func (s *tokenStore) MarkUsed(ctx context.Context, id string, until time.Time) (bool, error) {
+	first, err := s.cache.SetNX(ctx, usedKey(id), "1", ttlUntil(until))
+	if err != nil {
+		return false, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to rotate refresh token", err)
+	}
+	return first, nil
+}
+
+func (s *tokenStore) RevokeFamily(ctx context.Context, familyID string, until time.Time) error {
+	if err := s.cache.Set(ctx, familyKey(familyID), "1", ttlUntil(until)); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, fmt.Sprintf("failed to revoke token family %s", familyID), err)
+	}
+	return nil
+}
+
+func (s *tokenStore) IsFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	revoked, err := s.cache.Exists(ctx, familyKey(familyID))
+	if err != nil {
+		return false, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to check token family", err)
+	}
+	return revoked, nil
+}
+
+// RevokeUserTokens keeps the marker for maxTTL, the longest a refresh token
+// issued before the revocation can live.
+func (s *tokenStore) RevokeUserTokens(ctx context.Context, userID string, at time.Time) error {
+	if err := s.cache.Set(ctx, userRevokedKey(userID), strconv.FormatInt(at.UnixNano(), 10), s.maxTTL); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, fmt.Sprintf("failed to revoke tokens of user %s", userID), err)
+	}
+	return nil
+}
+
+func (s *tokenStore) UserTokensRevokedAt(ctx context.Context, userID string) (time.Time, error) {
+	raw, err := s.cache.Get(ctx, userRevokedKey(userID))
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to check user tokens", err)
+	}
+	nanos, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return time.Time{}, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to decode user revocation", err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// SaveSession stores the session until its refresh token expires and indexes it
+// under the user; the index lives as long as the longest refresh token.
+func (s *tokenStore) SaveSession(ctx context.Context, session *domain.Session) error {
+	raw, err := json.Marshal(session)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to encode session", err)
+	}
+	if err := s.cache.Set(ctx, sessionKey(session.ID), raw, ttlUntil(session.ExpiresAt)); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to store session", err)
+	}
+	index := userSessionsKey(session.UserID)
+	pipe := s.cache.Client().TxPipeline()
+	pipe.SAdd(ctx, index, session.ID)
+	pipe.Expire(ctx, index, s.maxTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to index session", err)
+	}
+	return nil
+}
+
+func (s *tokenStore) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	raw, err := s.cache.Get(ctx, sessionKey(id))
+	if errors.Is(err, redis.Nil) {
+		return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "session not found", nil)
+	}
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to read session", err)
+	}
+	var session domain.Session
+	if err := json.Unmarshal([]byte(raw), &session); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to decode session", err)
+	}
+	return &session, nil
+}
+
+// ListSessions drops from the index the sessions that already expired.
+func (s *tokenStore) ListSessions(ctx context.Context, userID string) ([]domain.Session, error) {
+	index := userSessionsKey(userID)
+	ids, err := s.cache.Client().SMembers(ctx, index).Result()
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to list sessions", err)
+	}
+	sessions := make([]domain.Session, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if pkgtypes.IsNotFound(err) {
+			s.cache.Client().SRem(ctx, index, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt) })
+	return sessions, nil
+}
+
+func (s *tokenStore) DeleteSession(ctx context.Context, userID, id string) error {
+	if err := s.cache.Delete(ctx, sessionKey(id)); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to delete session", err)
+	}
+	if err := s.cache.Client().SRem(ctx, userSessionsKey(userID), id).Err(); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to delete session", err)
+	}
+	return nil
+}
+
+func (s *tokenStore) DenyToken(ctx context.Context, jti string, until time.Time) error {
+	if err := s.cache.Set(ctx, deniedKey(jti), "1", ttlUntil(until)); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to revoke access token", err)
+	}
+	return nil
+}
+
+func (s *tokenStore) IsTokenDenied(ctx context.Context, jti string) (bool, error) {
+	denied, err := s.cache.Exists(ctx, deniedKey(jti))
+	if err != nil {
+		return false, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to check access token", err)
+	}
+	return denied, nil
+}
+
+func (s *tokenStore) SaveResetToken(ctx context.Context, hash string, t *domain.ResetToken, ttl time.Duration) error {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to encode reset token", err)
+	}
+	if err := s.cache.Set(ctx, resetKey(hash), raw, ttl); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to store reset token", err)
+	}
+	return nil
+}
+
+// ConsumeResetToken claims the token with SETNX, so a reset token can only be
+// redeemed once even under concurrent requests.
+func (s *tokenStore) ConsumeResetToken(ctx context.Context, hash string) (*domain.ResetToken, error) {
+	raw, err := s.consume(ctx, resetKey(hash), resetUsedKey(hash), "reset token")
+	if err != nil {
+		return nil, err
+	}
+	var t domain.ResetToken
+	if err := json.Unmarshal([]byte(raw), &t); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to decode reset token", err)
+	}
+	return &t, nil
+}
+
+func (s *tokenStore) SaveOAuthState(ctx context.Context, hash string, st *domain.OAuthState, ttl time.Duration) error {
+	raw, err := json.Marshal(st)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to encode oauth state", err)
+	}
+	if err := s.cache.Set(ctx, oauthKey(hash), raw, ttl); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to store oauth state", err)
+	}
+	return nil
+}
+
+// ConsumeOAuthState makes a state single use, like ConsumeResetToken.
+func (s *tokenStore) ConsumeOAuthState(ctx context.Context, hash string) (*domain.OAuthState, error) {
+	raw, err := s.consume(ctx, oauthKey(hash), oauthUsedKey(hash), "oauth state")
+	if err != nil {
+		return nil, err
+	}
+	var st domain.OAuthState
+	if err := json.Unmarshal([]byte(raw), &st); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to decode oauth state", err)
+	}
+	return &st, nil
+}
+
+// consume reads a one-time value and claims it with SETNX on usedKey.
+func (s *tokenStore) consume(ctx context.Context, key, usedKey, what string) (string, error) {
+	raw, err := s.cache.Get(ctx, key)
+	if errors.Is(err, redis.Nil) {
+		return "", pkgtypes.NewError(pkgtypes.ErrNotFound, what+" not found", nil)
+	}
+	if err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to read "+what, err)
+	}
+	ttl, err := s.cache.TTL(ctx, key)
+	if err != nil || ttl <= 0 {
+		ttl = time.Minute
+	}
+	first, err := s.cache.SetNX(ctx, usedKey, "1", ttl)
+	if err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to consume "+what, err)
+	}
+	if !first {
+		return "", pkgtypes.NewError(pkgtypes.ErrNotFound, what+" already used", nil)
+	}
+	if err := s.cache.Delete(ctx, key); err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to consume "+what, err)
+	}
+	return raw, nil
+}
+
+// ttlUntil keeps markers at least a minute so they outlive clock skew.
+func ttlUntil(t time.Time) time.Duration {
+	if d := time.Until(t); d > time.Minute {
+		return d
+	}
+	return time.Minute
+}
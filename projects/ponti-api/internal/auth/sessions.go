@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	orgdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+var errSessionNotFound = pkgtypes.NewError(pkgtypes.ErrNotFound, "session not found", nil)
+
+type clientKey struct{}
+
+// WithClient attaches the device and IP of the caller, recorded on the session
+// started or refreshed with ctx.
+func WithClient(ctx context.Context, client domain.Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+func clientFromContext(ctx context.Context) domain.Client {
+	client, _ := ctx.Value(clientKey{}).(domain.Client)
+	return client
+}
+
+// touchSession creates the session of a new family or updates the last seen
+// time and expiry of an existing one.
+func (u *useCases) touchSession(ctx context.Context, userID, familyID string, expiresAt time.Time) error {
+	now := time.Now()
+	session, err := u.store.GetSession(ctx, familyID)
+	if err != nil {
+		if !pkgtypes.IsNotFound(err) {
+			return err
+		}
+		session = &domain.Session{ID: familyID, UserID: userID, CreatedAt: now}
+	}
+	client := clientFromContext(ctx)
+	if client.Device != "" {
+		session.Device = client.Device
+	}
+	if client.IP != "" {
+		session.IP = client.IP
+	}
+	session.LastSeenAt = now
+	session.ExpiresAt = expiresAt
+	return u.store.SaveSession(ctx, session)
+}
+
+// ListSessions returns the user's active sessions, most recently used first.
+func (u *useCases) ListSessions(ctx context.Context, userID string) ([]domain.Session, error) {
+	if userID == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing user", nil)
+	}
+	return u.store.ListSessions(ctx, userID)
+}
+
+// RevokeSession ends one of the user's sessions: its refresh tokens stop working
+// and its access tokens are rejected by the denylist.
+func (u *useCases) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	session, err := u.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return errSessionNotFound
+		}
+		return err
+	}
+	// Other users' sessions look the same as missing ones.
+	if session.UserID != userID {
+		return errSessionNotFound
+	}
+	return u.endSession(ctx, session.UserID, session.ID, session.ExpiresAt)
+}
+
+// RevokeAllSessions logs the user out everywhere, including the current session.
+func (u *useCases) RevokeAllSessions(ctx context.Context, userID string) error {
+	if userID == "" {
+		return pkgtypes.NewError(pkgtypes.ErrAuthentication, "missing user", nil)
+	}
+	if err := u.store.RevokeUserTokens(ctx, userID, time.Now()); err != nil {
+		return err
+	}
+	// The user-wide marker already rejects every token; revoking the families
+	// too closes the window of tokens issued in the same second.
+	sessions, err := u.store.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := u.endSession(ctx, userID, session.ID, session.ExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForceLogout revokes every session of a user of the caller's organization.
+func (u *useCases) ForceLogout(ctx context.Context, userID string) error {
+	usr, err := u.users.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if tenantID, ok := pkgtypes.TenantIDFromContext(ctx); ok {
+		orgID := usr.OrganizationID
+		if orgID == 0 {
+			orgID = orgdom.DefaultOrganizationID
+		}
+		if orgID != tenantID {
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, "user not found", nil)
+		}
+	}
+	log.Printf("[Auth] forcing logout of user %s", userID)
+	return u.RevokeAllSessions(ctx, userID)
+}
+
+func (u *useCases) endSession(ctx context.Context, userID, sessionID string, expiresAt time.Time) error {
+	if err := u.store.RevokeFamily(ctx, sessionID, expiresAt); err != nil {
+		return err
+	}
+	return u.store.DeleteSession(ctx, userID, sessionID)
+}
+
+type denylist struct {
+	store TokenStore
+}
+
+// NewDenylist creates the check used by the JWT middleware to reject access
+// tokens that are still within their expiry but were revoked: by jti on logout,
+// by session (sid) when it was revoked, or by user after a password change or
+// forced logout.
+func NewDenylist(store TokenStore) pkgutils.TokenDenylist {
+	return &denylist{store: store}
+}
+
+func (d *denylist) IsRevoked(ctx context.Context, claims jwt.MapClaims) (bool, error) {
+	if jti, _ := claims["jti"].(string); jti != "" {
+		denied, err := d.store.IsTokenDenied(ctx, jti)
+		if err != nil || denied {
+			return denied, err
+		}
+	}
+	if sid, _ := claims[mdw.SessionClaim].(string); sid != "" {
+		revoked, err := d.store.IsFamilyRevoked(ctx, sid)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+	subject, _ := claims.GetSubject()
+	issuedAt, _ := claims.GetIssuedAt()
+	if subject == "" || issuedAt == nil {
+		return false, nil
+	}
+	revokedAt, err := d.store.UserTokensRevokedAt(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+	// iat has second precision: a token from the second of the revocation is
+	// kept, and is caught by its sid instead.
+	return issuedAt.Before(revokedAt.Truncate(time.Second)), nil
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/mocks"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+func TestRevokeSession(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(store *mocks.MockTokenStore)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "own session is revoked and removed",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().GetSession(gomock.Any(), "s1").
+					Return(&domain.Session{ID: "s1", UserID: "u1", ExpiresAt: expiresAt}, nil)
+				store.EXPECT().RevokeFamily(gomock.Any(), "s1", expiresAt).Return(nil)
+				store.EXPECT().DeleteSession(gomock.Any(), "u1", "s1").Return(nil)
+			},
+		},
+		{
+			name: "another user's session is not found",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().GetSession(gomock.Any(), "s1").
+					Return(&domain.Session{ID: "s1", UserID: "u2", ExpiresAt: expiresAt}, nil)
+			},
+			wantErr: pkgtypes.ErrNotFound,
+		},
+		{
+			name: "unknown session",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().GetSession(gomock.Any(), "s1").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "session not found", nil))
+			},
+			wantErr: pkgtypes.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _, store := newTestUseCases(t, false)
+			tt.setup(store)
+
+			err := u.RevokeSession(ctx, "u1", "s1")
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestForceLogout(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		user    *userdom.User
+		setup   func(store *mocks.MockTokenStore)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "revokes every session of a user of the organization",
+			user: &userdom.User{ID: "u2", OrganizationID: 7},
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().RevokeUserTokens(gomock.Any(), "u2", gomock.Any()).Return(nil)
+				store.EXPECT().ListSessions(gomock.Any(), "u2").Return([]domain.Session{
+					{ID: "s1", UserID: "u2", ExpiresAt: expiresAt},
+					{ID: "s2", UserID: "u2", ExpiresAt: expiresAt},
+				}, nil)
+				for _, id := range []string{"s1", "s2"} {
+					store.EXPECT().RevokeFamily(gomock.Any(), id, expiresAt).Return(nil)
+					store.EXPECT().DeleteSession(gomock.Any(), "u2", id).Return(nil)
+				}
+			},
+		},
+		{
+			name:    "users of other organizations are not found",
+			user:    &userdom.User{ID: "u2", OrganizationID: 8},
+			setup:   func(*mocks.MockTokenStore) {},
+			wantErr: pkgtypes.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, users, store := newTestUseCases(t, false)
+			users.EXPECT().GetUser(gomock.Any(), "u2").Return(tt.user, nil)
+			tt.setup(store)
+
+			err := u.ForceLogout(pkgtypes.WithTenantID(context.Background(), 7), "u2")
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLogoutDenylistsAccessToken(t *testing.T) {
+	ctx := context.Background()
+	u, _, store := newTestUseCases(t, false)
+	jwtService, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+	require.NoError(t, err)
+	tokens, err := jwtService.GenerateTokens(ctx, "u1", 0, 0)
+	require.NoError(t, err)
+
+	record := &domain.RefreshToken{ID: tokens.RefreshTokenID, UserID: "u1", FamilyID: "fam", ExpiresAt: tokens.RefreshExpiresAt}
+	store.EXPECT().GetRefreshToken(gomock.Any(), tokens.RefreshTokenID).Return(record, nil)
+	store.EXPECT().DenyToken(gomock.Any(), tokens.AccessTokenID, gomock.Any()).Return(nil)
+	store.EXPECT().RevokeFamily(gomock.Any(), "fam", record.ExpiresAt).Return(nil)
+	store.EXPECT().DeleteSession(gomock.Any(), "u1", "fam").Return(nil)
+
+	require.NoError(t, u.Logout(ctx, tokens.RefreshToken, tokens.AccessToken))
+}
+
+func TestDenylist(t *testing.T) {
+	ctx := context.Background()
+	issuedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	claims := jwt.MapClaims{"jti": "j1", "sid": "s1", "sub": "u1", "iat": float64(issuedAt.Unix())}
+
+	tests := []struct {
+		name  string
+		setup func(store *mocks.MockTokenStore)
+		want  bool
+	}{
+		{
+			name: "active token",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().IsTokenDenied(gomock.Any(), "j1").Return(false, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "s1").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(time.Time{}, nil)
+			},
+		},
+		{
+			name: "denylisted jti",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().IsTokenDenied(gomock.Any(), "j1").Return(true, nil)
+			},
+			want: true,
+		},
+		{
+			name: "revoked session",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().IsTokenDenied(gomock.Any(), "j1").Return(false, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "s1").Return(true, nil)
+			},
+			want: true,
+		},
+		{
+			name: "issued before the user was logged out",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().IsTokenDenied(gomock.Any(), "j1").Return(false, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "s1").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(issuedAt.Add(2*time.Second), nil)
+			},
+			want: true,
+		},
+		{
+			name: "issued after the user was logged out",
+			setup: func(store *mocks.MockTokenStore) {
+				store.EXPECT().IsTokenDenied(gomock.Any(), "j1").Return(false, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "s1").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(issuedAt.Add(-time.Second), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocks.NewMockTokenStore(ctrl)
+			tt.setup(store)
+
+			revoked, err := NewDenylist(store).IsRevoked(ctx, claims)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, revoked)
+		})
+	}
+}
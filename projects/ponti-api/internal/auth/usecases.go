@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgoauth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	orgdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+type useCases struct {
+	users   user.UseCases
+	jwt     pkgjwt.Service
+	store   TokenStore
+	mailer  notification.UseCases
+	options Options
+}
+
+// Options configures the auth use cases.
+type Options struct {
+	// TenantClaim is the access token claim carrying the user's organization; it
+	// must match the one read by the Tenant middleware.
+	TenantClaim string
+	// RequireVerifiedEmail blocks the login of users that have not verified their email.
+	RequireVerifiedEmail bool
+	// ResetTTL is how long a password reset token is valid.
+	ResetTTL time.Duration
+	// ResetURL is the base of the reset link; the token is appended as ?token=.
+	ResetURL string
+	// OAuthProviders are the external identity providers, by the name used in the
+	// /auth/oauth/:provider routes.
+	OAuthProviders map[string]pkgoauth2.Service
+	// OAuthStateTTL is how long a user has to complete an OAuth2 login.
+	OAuthStateTTL time.Duration
+}
+
+// NewUseCases creates the auth use cases.
+func NewUseCases(users user.UseCases, jwt pkgjwt.Service, store TokenStore, mailer notification.UseCases, opts Options) UseCases {
+	if opts.ResetTTL <= 0 {
+		opts.ResetTTL = 15 * time.Minute
+	}
+	if opts.OAuthStateTTL <= 0 {
+		opts.OAuthStateTTL = 10 * time.Minute
+	}
+	return &useCases{
+		users:   users,
+		jwt:     jwt,
+		store:   store,
+		mailer:  mailer,
+		options: opts,
+	}
+}
+
+var errInvalidCredentials = pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid credentials", nil)
+
+// Login verifies the credentials and starts a new refresh token family.
+func (u *useCases) Login(ctx context.Context, credentials pkgtypes.LoginCredentials) (*domain.TokenPair, error) {
+	if credentials.Email == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "email is required", nil)
+	}
+	usr, err := u.users.GetUserByEmail(ctx, credentials.Email)
+	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) && appErr.Type == pkgtypes.ErrNotFound {
+			return nil, errInvalidCredentials
+		}
+		return nil, err
+	}
+	ok, err := pkgutils.VerifyPassword(credentials.Password, usr.Credentials.Password)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to verify password", err)
+	}
+	if !ok {
+		return nil, errInvalidCredentials
+	}
+	if u.options.RequireVerifiedEmail && !usr.EmailValidated {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthorization, "email has not been verified", nil)
+	}
+	if err := u.users.RecordLogin(ctx, usr.ID); err != nil {
+		log.Printf("[Auth] failed to record login of user %s: %v", usr.ID, err)
+	}
+	return u.issue(ctx, usr, uuid.New().String())
+}
+
+// Refresh rotates a refresh token: the presented token is spent and a new pair is
+// issued in the same family. Presenting an already spent token means it leaked,
+// so the whole family is revoked and the legitimate holder must log in again.
+func (u *useCases) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	record, err := u.lookup(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := u.store.IsFamilyRevoked(ctx, record.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		revokedAt, err := u.store.UserTokensRevokedAt(ctx, record.UserID)
+		if err != nil {
+			return nil, err
+		}
+		revoked = record.IssuedAt.Before(revokedAt)
+	}
+	if revoked {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "session has been revoked", nil)
+	}
+
+	first, err := u.store.MarkUsed(ctx, record.ID, record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !first {
+		log.Printf("[Auth] refresh token reuse detected for user %s; revoking family %s", record.UserID, record.FamilyID)
+		if err := u.store.RevokeFamily(ctx, record.FamilyID, record.ExpiresAt); err != nil {
+			return nil, err
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "refresh token has already been used", nil)
+	}
+
+	usr, err := u.users.GetUser(ctx, record.UserID)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "user no longer exists", err)
+	}
+	return u.issue(ctx, usr, record.FamilyID)
+}
+
+// Logout ends the session of the given refresh token. The access token, when
+// given, is denylisted until it expires. Unknown or expired tokens are ignored so
+// logging out twice is not an error.
+func (u *useCases) Logout(ctx context.Context, refreshToken, accessToken string) error {
+	record, err := u.lookup(ctx, refreshToken)
+	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) && appErr.Type == pkgtypes.ErrAuthentication {
+			return nil
+		}
+		return err
+	}
+	if accessToken != "" {
+		claims, err := u.jwt.ValidateToken(ctx, accessToken)
+		if err == nil && claims.Type == pkgutils.AccessTokenType && claims.ID != "" && claims.Subject == record.UserID {
+			if err := u.store.DenyToken(ctx, claims.ID, claims.ExpiresAt); err != nil {
+				return err
+			}
+		}
+	}
+	return u.endSession(ctx, record.UserID, record.FamilyID, record.ExpiresAt)
+}
+
+// lookup validates the refresh token signature and returns its stored record.
+func (u *useCases) lookup(ctx context.Context, refreshToken string) (*domain.RefreshToken, error) {
+	claims, err := u.jwt.ValidateToken(ctx, refreshToken)
+	if err != nil || claims.ID == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid refresh token", err)
+	}
+	if claims.Type != pkgutils.RefreshTokenType {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid refresh token", nil)
+	}
+	record, err := u.store.GetRefreshToken(ctx, claims.ID)
+	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) && appErr.Type == pkgtypes.ErrNotFound {
+			return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid refresh token", err)
+		}
+		return nil, err
+	}
+	if record.UserID != claims.Subject {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid refresh token", nil)
+	}
+	return record, nil
+}
+
+func (u *useCases) issue(ctx context.Context, usr *userdom.User, familyID string) (*domain.TokenPair, error) {
+	tenantID := usr.OrganizationID
+	if tenantID == 0 {
+		tenantID = orgdom.DefaultOrganizationID
+	}
+	claims := map[string]any{
+		u.options.TenantClaim: tenantID,
+		mdw.SessionClaim:      familyID,
+	}
+	tokens, err := u.jwt.GenerateTokensWithClaims(ctx, usr.ID, claims, 0, 0)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate tokens", err)
+	}
+	if err := u.store.SaveRefreshToken(ctx, &domain.RefreshToken{
+		ID:        tokens.RefreshTokenID,
+		UserID:    usr.ID,
+		FamilyID:  familyID,
+		IssuedAt:  time.Now(),
+		ExpiresAt: tokens.RefreshExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	if err := u.touchSession(ctx, usr.ID, familyID, tokens.RefreshExpiresAt); err != nil {
+		return nil, err
+	}
+	return &domain.TokenPair{
+		AccessToken:      tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		TokenType:        tokens.TokenType,
+		AccessExpiresAt:  tokens.AccessExpiresAt,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// TokenPair is what a successful login or refresh returns.
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	TokenType        string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+// RefreshToken is the server-side record of an issued refresh token. Every token
+// obtained by rotating a login's refresh token shares the login's FamilyID, so a
+// replayed token can revoke the whole chain.
+type RefreshToken struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	FamilyID  string    `json:"family_id"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ResetToken is the server-side record of a password reset token. Only the
+// token's hash is stored.
+type ResetToken struct {
+	UserID   string    `json:"user_id"`
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+// OAuthState is the server-side record of an OAuth2 login in progress, keyed by
+// the hash of the state parameter. Verifier is the PKCE code verifier.
+type OAuthState struct {
+	Provider string    `json:"provider"`
+	Verifier string    `json:"verifier"`
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+// Session is a login as the user sees it: one per refresh token family, so its ID
+// is the FamilyID and access tokens carry it in the sid claim. LastSeenAt is
+// updated on every refresh.
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Client identifies where a login or refresh comes from.
+type Client struct {
+	Device string
+	IP     string
+}
@@ -0,0 +1,360 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/mocks"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth/usecases/domain"
+	usermocks "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// fakeMailer records the emails sent by the use cases.
+type fakeMailer struct {
+	bodies []string
+}
+
+func (f *fakeMailer) SendEmail(_ context.Context, _, _, body string) error {
+	f.bodies = append(f.bodies, body)
+	return nil
+}
+
+func newTestUseCases(t *testing.T, requireVerifiedEmail bool) (UseCases, *usermocks.MockUseCases, *mocks.MockTokenStore) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	jwtService, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+	require.NoError(t, err)
+
+	users := usermocks.NewMockUseCases(ctrl)
+	store := mocks.NewMockTokenStore(ctrl)
+	return NewUseCases(users, jwtService, store, &fakeMailer{}, Options{
+		TenantClaim:          "tenant_id",
+		RequireVerifiedEmail: requireVerifiedEmail,
+	}), users, store
+}
+
+// expectNewSession expects the session of a new family to be created with the
+// client of the request.
+func expectNewSession(t *testing.T, store *mocks.MockTokenStore, userID string, client domain.Client) {
+	store.EXPECT().GetSession(gomock.Any(), gomock.Any()).
+		Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "session not found", nil))
+	store.EXPECT().SaveSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s *domain.Session) error {
+			assert.Equal(t, userID, s.UserID)
+			assert.NotEmpty(t, s.ID)
+			assert.Equal(t, client, domain.Client{Device: s.Device, IP: s.IP})
+			assert.False(t, s.CreatedAt.IsZero())
+			return nil
+		})
+}
+
+func assertErrType(t *testing.T, err error, want pkgtypes.ErrorType) {
+	t.Helper()
+	var appErr *pkgtypes.Error
+	require.True(t, errors.As(err, &appErr), "expected *pkgtypes.Error, got %v", err)
+	assert.Equal(t, want, appErr.Type)
+}
+
+func TestLogin(t *testing.T) {
+	client := domain.Client{Device: "Mozilla/5.0", IP: "10.0.0.1"}
+	ctx := WithClient(context.Background(), client)
+	hash, err := pkgutils.HashPassword("S3cret!pass", 4)
+	require.NoError(t, err)
+	usr := &userdom.User{ID: "u1", Credentials: userdom.Credentials{Email: "a@b.com", Password: hash}}
+
+	tests := []struct {
+		name            string
+		password        string
+		requireVerified bool
+		setup           func(users *usermocks.MockUseCases, store *mocks.MockTokenStore)
+		wantErr         pkgtypes.ErrorType
+	}{
+		{
+			name:     "valid credentials start a new family and session",
+			password: "S3cret!pass",
+			setup: func(users *usermocks.MockUseCases, store *mocks.MockTokenStore) {
+				users.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(usr, nil)
+				users.EXPECT().RecordLogin(gomock.Any(), "u1").Return(nil)
+				store.EXPECT().SaveRefreshToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rt *domain.RefreshToken) error {
+						assert.Equal(t, "u1", rt.UserID)
+						assert.NotEmpty(t, rt.FamilyID)
+						return nil
+					})
+				expectNewSession(t, store, "u1", client)
+			},
+		},
+		{
+			name:     "wrong password",
+			password: "wrong",
+			setup: func(users *usermocks.MockUseCases, _ *mocks.MockTokenStore) {
+				users.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(usr, nil)
+			},
+			wantErr: pkgtypes.ErrAuthentication,
+		},
+		{
+			name:            "unverified email is blocked when required",
+			password:        "S3cret!pass",
+			requireVerified: true,
+			setup: func(users *usermocks.MockUseCases, _ *mocks.MockTokenStore) {
+				users.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(usr, nil)
+			},
+			wantErr: pkgtypes.ErrAuthorization,
+		},
+		{
+			name:     "unknown email does not reveal the user is missing",
+			password: "S3cret!pass",
+			setup: func(users *usermocks.MockUseCases, _ *mocks.MockTokenStore) {
+				users.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "user not found", nil))
+			},
+			wantErr: pkgtypes.ErrAuthentication,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, users, store := newTestUseCases(t, tt.requireVerified)
+			tt.setup(users, store)
+
+			pair, err := u.Login(ctx, pkgtypes.LoginCredentials{Email: "a@b.com", Password: tt.password})
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, pair.AccessToken)
+			assert.NotEmpty(t, pair.RefreshToken)
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	usr := &userdom.User{ID: "u1"}
+
+	tests := []struct {
+		name    string
+		setup   func(users *usermocks.MockUseCases, store *mocks.MockTokenStore, record *domain.RefreshToken)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "rotates within the same family",
+			setup: func(users *usermocks.MockUseCases, store *mocks.MockTokenStore, record *domain.RefreshToken) {
+				store.EXPECT().GetRefreshToken(gomock.Any(), record.ID).Return(record, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "fam").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(time.Time{}, nil)
+				store.EXPECT().MarkUsed(gomock.Any(), record.ID, record.ExpiresAt).Return(true, nil)
+				users.EXPECT().GetUser(gomock.Any(), "u1").Return(usr, nil)
+				store.EXPECT().SaveRefreshToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rt *domain.RefreshToken) error {
+						assert.Equal(t, "fam", rt.FamilyID)
+						assert.NotEqual(t, record.ID, rt.ID)
+						return nil
+					})
+				createdAt := time.Now().Add(-time.Hour)
+				store.EXPECT().GetSession(gomock.Any(), "fam").Return(&domain.Session{
+					ID: "fam", UserID: "u1", Device: "Mozilla/5.0", IP: "10.0.0.1",
+					CreatedAt: createdAt, LastSeenAt: createdAt,
+				}, nil)
+				store.EXPECT().SaveSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *domain.Session) error {
+						assert.Equal(t, createdAt, s.CreatedAt)
+						assert.True(t, s.LastSeenAt.After(createdAt))
+						assert.Equal(t, "Mozilla/5.0", s.Device, "kept when the refresh has no client")
+						return nil
+					})
+			},
+		},
+		{
+			name: "reusing a spent token revokes the family",
+			setup: func(_ *usermocks.MockUseCases, store *mocks.MockTokenStore, record *domain.RefreshToken) {
+				store.EXPECT().GetRefreshToken(gomock.Any(), record.ID).Return(record, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "fam").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(time.Time{}, nil)
+				store.EXPECT().MarkUsed(gomock.Any(), record.ID, record.ExpiresAt).Return(false, nil)
+				store.EXPECT().RevokeFamily(gomock.Any(), "fam", record.ExpiresAt).Return(nil)
+			},
+			wantErr: pkgtypes.ErrAuthentication,
+		},
+		{
+			name: "tokens issued before a password change are rejected",
+			setup: func(_ *usermocks.MockUseCases, store *mocks.MockTokenStore, record *domain.RefreshToken) {
+				store.EXPECT().GetRefreshToken(gomock.Any(), record.ID).Return(record, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "fam").Return(false, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(record.IssuedAt.Add(time.Second), nil)
+			},
+			wantErr: pkgtypes.ErrAuthentication,
+		},
+		{
+			name: "revoked family is rejected",
+			setup: func(_ *usermocks.MockUseCases, store *mocks.MockTokenStore, record *domain.RefreshToken) {
+				store.EXPECT().GetRefreshToken(gomock.Any(), record.ID).Return(record, nil)
+				store.EXPECT().IsFamilyRevoked(gomock.Any(), "fam").Return(true, nil)
+			},
+			wantErr: pkgtypes.ErrAuthentication,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, users, store := newTestUseCases(t, false)
+			jwtService, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+			require.NoError(t, err)
+			tokens, err := jwtService.GenerateTokens(ctx, "u1", 0, 0)
+			require.NoError(t, err)
+
+			record := &domain.RefreshToken{
+				ID:        tokens.RefreshTokenID,
+				UserID:    "u1",
+				FamilyID:  "fam",
+				IssuedAt:  time.Now().Add(-time.Minute),
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			tt.setup(users, store, record)
+
+			pair, err := u.Refresh(ctx, tokens.RefreshToken)
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, tokens.RefreshToken, pair.RefreshToken)
+		})
+	}
+}
+
+func TestRefreshRejectsAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	u, _, _ := newTestUseCases(t, false)
+	jwtService, err := pkgjwt.Bootstrap("test-secret", 15, 60)
+	require.NoError(t, err)
+	tokens, err := jwtService.GenerateTokensWithClaims(ctx, "u1", map[string]any{pkgutils.SessionClaim: "fam"}, 0, 0)
+	require.NoError(t, err)
+
+	_, err = u.Refresh(ctx, tokens.AccessToken)
+	assertErrType(t, err, pkgtypes.ErrAuthentication)
+
+	claims, err := jwtService.ValidateToken(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, pkgutils.RefreshTokenType, claims.Type)
+	assert.Equal(t, "fam", claims.Session)
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+	hash, err := pkgutils.HashPassword("S3cret!pass", 4)
+	require.NoError(t, err)
+	usr := &userdom.User{ID: "u1", Credentials: userdom.Credentials{Password: hash}}
+
+	tests := []struct {
+		name    string
+		current string
+		next    string
+		setup   func(users *usermocks.MockUseCases, store *mocks.MockTokenStore)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name:    "revokes every refresh token of the user",
+			current: "S3cret!pass",
+			next:    "N3w!password",
+			setup: func(users *usermocks.MockUseCases, store *mocks.MockTokenStore) {
+				users.EXPECT().UpdatePassword(gomock.Any(), "u1", "N3w!password").Return(nil)
+				store.EXPECT().RevokeUserTokens(gomock.Any(), "u1", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "wrong current password",
+			current: "nope",
+			next:    "N3w!password",
+			setup:   func(*usermocks.MockUseCases, *mocks.MockTokenStore) {},
+			wantErr: pkgtypes.ErrValidation,
+		},
+		{
+			name:    "weak new password",
+			current: "S3cret!pass",
+			next:    "weak",
+			setup:   func(*usermocks.MockUseCases, *mocks.MockTokenStore) {},
+			wantErr: pkgtypes.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, users, store := newTestUseCases(t, false)
+			users.EXPECT().GetUser(gomock.Any(), "u1").Return(usr, nil)
+			tt.setup(users, store)
+
+			err := u.ChangePassword(ctx, "u1", tt.current, tt.next)
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	issued := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		setup   func(users *usermocks.MockUseCases, store *mocks.MockTokenStore)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "sets the password and revokes sessions",
+			setup: func(users *usermocks.MockUseCases, store *mocks.MockTokenStore) {
+				store.EXPECT().ConsumeResetToken(gomock.Any(), hashToken("tok")).
+					Return(&domain.ResetToken{UserID: "u1", IssuedAt: issued}, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(time.Time{}, nil)
+				users.EXPECT().UpdatePassword(gomock.Any(), "u1", "N3w!password").Return(nil)
+				store.EXPECT().RevokeUserTokens(gomock.Any(), "u1", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "used or expired token",
+			setup: func(_ *usermocks.MockUseCases, store *mocks.MockTokenStore) {
+				store.EXPECT().ConsumeResetToken(gomock.Any(), hashToken("tok")).
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "reset token not found", nil))
+			},
+			wantErr: pkgtypes.ErrValidation,
+		},
+		{
+			name: "token requested before the last password change",
+			setup: func(_ *usermocks.MockUseCases, store *mocks.MockTokenStore) {
+				store.EXPECT().ConsumeResetToken(gomock.Any(), hashToken("tok")).
+					Return(&domain.ResetToken{UserID: "u1", IssuedAt: issued}, nil)
+				store.EXPECT().UserTokensRevokedAt(gomock.Any(), "u1").Return(issued.Add(time.Second), nil)
+			},
+			wantErr: pkgtypes.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, users, store := newTestUseCases(t, false)
+			tt.setup(users, store)
+
+			err := u.ResetPassword(ctx, "tok", "N3w!password")
+			if tt.wantErr != "" {
+				assertErrType(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
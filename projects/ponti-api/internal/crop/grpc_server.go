@@ -0,0 +1,94 @@
+package crop
+
+import (
+	"context"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/grpc/pb"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
+)
+
+// GrpcServer expone los casos de uso de cultivos como CropService. Los errores
+// del dominio los traduce a status el interceptor del servidor.
+type GrpcServer struct {
+	pb.UnimplementedCropServiceServer
+	ucs UseCases
+}
+
+// NewGrpcServer crea el servidor gRPC de cultivos.
+func NewGrpcServer(ucs UseCases) *GrpcServer {
+	return &GrpcServer{ucs: ucs}
+}
+
+// Register registra CropService en el servidor gRPC.
+func (s *GrpcServer) Register(ctx context.Context, server pkggrpcserver.Server) {
+	server.RegisterService(ctx, &pb.CropService_ServiceDesc, s)
+}
+
+func (s *GrpcServer) CreateCrop(ctx context.Context, req *pb.CreateCropRequest) (*pb.CreateCropResponse, error) {
+	if req.GetCrop() == nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "crop is required", nil)
+	}
+	crop := FromProto(req.GetCrop())
+	crop.ID = 0
+	id, err := s.ucs.CreateCrop(ctx, crop)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateCropResponse{Id: id}, nil
+}
+
+func (s *GrpcServer) ListCrops(ctx context.Context, _ *pb.ListCropsRequest) (*pb.ListCropsResponse, error) {
+	crops, err := s.ucs.ListCrops(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListCropsResponse{Crops: make([]*pb.Crop, 0, len(crops))}
+	for i := range crops {
+		res.Crops = append(res.Crops, ToProto(&crops[i]))
+	}
+	return res, nil
+}
+
+func (s *GrpcServer) GetCrop(ctx context.Context, req *pb.GetCropRequest) (*pb.GetCropResponse, error) {
+	crop, err := s.ucs.GetCrop(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCropResponse{Crop: ToProto(crop)}, nil
+}
+
+func (s *GrpcServer) UpdateCrop(ctx context.Context, req *pb.UpdateCropRequest) (*pb.UpdateCropResponse, error) {
+	if req.GetCrop().GetId() == 0 {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "crop id is required", nil)
+	}
+	if err := s.ucs.UpdateCrop(ctx, FromProto(req.GetCrop())); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateCropResponse{}, nil
+}
+
+func (s *GrpcServer) DeleteCrop(ctx context.Context, req *pb.DeleteCropRequest) (*pb.DeleteCropResponse, error) {
+	if err := s.ucs.DeleteCrop(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteCropResponse{}, nil
+}
+
+// ToProto mapea un cultivo del dominio al mensaje gRPC. Lo usan también los
+// servidores de lotes, campos y proyectos.
+func ToProto(c *domain.Crop) *pb.Crop {
+	if c == nil {
+		return nil
+	}
+	return &pb.Crop{Id: c.ID, Name: c.Name}
+}
+
+// FromProto mapea el mensaje gRPC a un cultivo del dominio.
+func FromProto(c *pb.Crop) *domain.Crop {
+	if c == nil {
+		return &domain.Crop{}
+	}
+	return &domain.Crop{ID: c.GetId(), Name: c.GetName()}
+}
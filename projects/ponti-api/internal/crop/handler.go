@@ -33,31 +33,36 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/crops"
-	publicPrefix := apiBase + "/public"
+	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
+	protected := router.Group(protectedPrefix)
 	{
-		public.POST("", h.CreateCrop)
-		public.GET("", h.ListCrops)
-		public.GET("/:id", h.GetCrop)
-		public.PUT("/:id", h.UpdateCrop)
-		public.DELETE("/:id", h.DeleteCrop)
+		protected.Use(h.mws.Protected...)
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("crop:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("crop:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateCrop)
+		read.GET("", h.ListCrops)
+		read.GET("/:id", h.GetCrop)
+		write.PUT("/:id", h.UpdateCrop)
+		write.DELETE("/:id", h.DeleteCrop)
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) CreateCrop(c *gin.Context) {
 	var req dto.CreateCrop
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newID, err := h.ucs.CreateCrop(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -71,8 +76,7 @@ func (h *Handler) CreateCrop(c *gin.Context) {
 func (h *Handler) ListCrops(c *gin.Context) {
 	crops, err := h.ucs.ListCrops(c.Request.Context())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, crops)
@@ -82,14 +86,13 @@ func (h *Handler) ListCrops(c *gin.Context) {
 func (h *Handler) GetCrop(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid crop id"})
+		c.Error(types.NewInvalidIDError("invalid crop id", err))
 		return
 	}
 
 	crop, err := h.ucs.GetCrop(c.Request.Context(), id)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -100,18 +103,17 @@ func (h *Handler) GetCrop(c *gin.Context) {
 func (h *Handler) UpdateCrop(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid crop id"})
+		c.Error(types.NewInvalidIDError("invalid crop id", err))
 		return
 	}
 	var req dto.Crop
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid payload"})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	req.ID = id
 	if err := h.ucs.UpdateCrop(c.Request.Context(), req.ToDomain()); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Crop updated successfully"})
@@ -121,12 +123,11 @@ func (h *Handler) UpdateCrop(c *gin.Context) {
 func (h *Handler) DeleteCrop(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid crop id"})
+		c.Error(types.NewInvalidIDError("invalid crop id", err))
 		return
 	}
 	if err := h.ucs.DeleteCrop(c.Request.Context(), id); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Crop deleted successfully"})
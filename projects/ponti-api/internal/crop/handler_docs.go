@@ -0,0 +1,42 @@
+package crop
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Crop ID")
+
+	pkgswagger.Describe(h.CreateCrop, pkgswagger.Operation{
+		Summary:   "Create a crop",
+		Request:   dto.CreateCrop{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateCropResponse{}},
+	})
+	pkgswagger.Describe(h.ListCrops, pkgswagger.Operation{
+		Summary:   "List crops",
+		Responses: map[int]any{http.StatusOK: []domain.Crop{}},
+	})
+	pkgswagger.Describe(h.GetCrop, pkgswagger.Operation{
+		Summary:    "Get a crop",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Crop{}},
+	})
+	pkgswagger.Describe(h.UpdateCrop, pkgswagger.Operation{
+		Summary:    "Update a crop",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.Crop{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteCrop, pkgswagger.Operation{
+		Summary:    "Delete a crop",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
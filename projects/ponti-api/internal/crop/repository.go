@@ -51,6 +51,9 @@ func (r *repository) GetCrop(ctx context.Context, id int64) (*domain.Crop, error
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Crop{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("crop with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get crop", err)
@@ -70,6 +73,9 @@ func (r *repository) UpdateCrop(ctx context.Context, c *domain.Crop) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update crop", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Crop{}, c.ID); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("crop with id %d does not exist", c.ID), nil)
 	}
 	return nil
@@ -82,6 +88,9 @@ func (r *repository) DeleteCrop(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete crop", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Crop{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("crop with id %d does not exist", id), nil)
 	}
 	return nil
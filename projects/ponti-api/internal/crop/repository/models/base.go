@@ -3,11 +3,14 @@ package models
 import (
 	"time"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
 )
 
 // Crop represents a type of crop.
 type Crop struct {
+	pkggorm.TenantScoped
+
 	ID        int64     `gorm:"primaryKey" json:"id"`
 	Name      string    `gorm:"size:50;not null" json:"name"`
 	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at"`
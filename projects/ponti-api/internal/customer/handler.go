@@ -36,24 +36,25 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/customers"
-	publicPrefix := apiBase + "/public"
 	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreateCustomer)       // Crear un customer
-		public.GET("", h.ListCustomers)         // Listar todos los customers
-		public.GET("/:id", h.GetCustomer)       // Obtener un customer por ID
-		public.PUT("/:id", h.UpdateCustomer)    // Actualizar un customer
-		public.DELETE("/:id", h.DeleteCustomer) // Eliminar un customer
-	}
-
 	// Rutas protegidas.
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
 		protected.GET("/ping", h.ProtectedPing) // Endpoint de prueba protegido
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("customer:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("customer:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateCustomer) // Crear un customer
+		read.GET("", h.ListCustomers)                                   // Listar todos los customers
+		read.GET("/:id", h.GetCustomer)                                 // Obtener un customer por ID
+		write.PUT("/:id", h.UpdateCustomer)                             // Actualizar un customer
+		write.DELETE("/:id", h.DeleteCustomer)                          // Eliminar un customer
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -66,16 +67,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreateCustomer(c *gin.Context) {
 	var req dto.CreateCustomer
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newID, err := h.ucs.CreateCustomer(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -89,8 +88,7 @@ func (h *Handler) CreateCustomer(c *gin.Context) {
 func (h *Handler) ListCustomers(c *gin.Context) {
 	customers, err := h.ucs.ListCustomers(c.Request.Context())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, customers)
@@ -100,14 +98,13 @@ func (h *Handler) ListCustomers(c *gin.Context) {
 func (h *Handler) GetCustomer(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid customer id"})
+		c.Error(types.NewInvalidIDError("invalid customer id", err))
 		return
 	}
 
 	customer, err := h.ucs.GetCustomer(c.Request.Context(), id)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -118,18 +115,17 @@ func (h *Handler) GetCustomer(c *gin.Context) {
 func (h *Handler) UpdateCustomer(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid customer id"})
+		c.Error(types.NewInvalidIDError("invalid customer id", err))
 		return
 	}
 	var req dto.Customer
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid payload"})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	req.ID = id
 	if err := h.ucs.UpdateCustomer(c.Request.Context(), req.ToDomain()); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Customer updated successfully"})
@@ -139,12 +135,11 @@ func (h *Handler) UpdateCustomer(c *gin.Context) {
 func (h *Handler) DeleteCustomer(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid customer id"})
+		c.Error(types.NewInvalidIDError("invalid customer id", err))
 		return
 	}
 	if err := h.ucs.DeleteCustomer(c.Request.Context(), id); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Customer deleted successfully"})
@@ -0,0 +1,46 @@
+package customer
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Customer ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateCustomer, pkgswagger.Operation{
+		Summary:   "Create a customer",
+		Request:   dto.CreateCustomer{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateCustomerResponse{}},
+	})
+	pkgswagger.Describe(h.ListCustomers, pkgswagger.Operation{
+		Summary:   "List customers",
+		Responses: map[int]any{http.StatusOK: []domain.Customer{}},
+	})
+	pkgswagger.Describe(h.GetCustomer, pkgswagger.Operation{
+		Summary:    "Get a customer",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Customer{}},
+	})
+	pkgswagger.Describe(h.UpdateCustomer, pkgswagger.Operation{
+		Summary:    "Update a customer",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.Customer{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteCustomer, pkgswagger.Operation{
+		Summary:    "Delete a customer",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
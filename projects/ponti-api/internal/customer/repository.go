@@ -52,6 +52,9 @@ func (r *repository) GetCustomer(ctx context.Context, id int64) (*domain.Custome
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Customer{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("customer with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get customer", err)
@@ -71,6 +74,9 @@ func (r *repository) UpdateCustomer(ctx context.Context, c *domain.Customer) err
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update customer", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Customer{}, c.ID); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("customer with id %d does not exist", c.ID), nil)
 	}
 	return nil
@@ -83,6 +89,9 @@ func (r *repository) DeleteCustomer(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete customer", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Customer{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("customer with id %d does not exist", id), nil)
 	}
 	return nil
@@ -1,10 +1,13 @@
 package models
 
 import (
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
 )
 
 type Customer struct {
+	pkggorm.TenantScoped
+
 	ID   int64  `gorm:"primaryKey"`
 	Name string `gorm:"type:varchar(100);not null"`
 	Type string `gorm:"type:varchar(100);not null"`
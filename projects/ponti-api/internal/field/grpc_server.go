@@ -0,0 +1,112 @@
+package field
+
+import (
+	"context"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/grpc/pb"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/usecases/domain"
+	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
+	lotpb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/grpc/pb"
+)
+
+// GrpcServer expone los casos de uso de campos como FieldService. Los errores
+// del dominio los traduce a status el interceptor del servidor.
+type GrpcServer struct {
+	pb.UnimplementedFieldServiceServer
+	ucs UseCases
+}
+
+// NewGrpcServer crea el servidor gRPC de campos.
+func NewGrpcServer(ucs UseCases) *GrpcServer {
+	return &GrpcServer{ucs: ucs}
+}
+
+// Register registra FieldService en el servidor gRPC.
+func (s *GrpcServer) Register(ctx context.Context, server pkggrpcserver.Server) {
+	server.RegisterService(ctx, &pb.FieldService_ServiceDesc, s)
+}
+
+func (s *GrpcServer) CreateField(ctx context.Context, req *pb.CreateFieldRequest) (*pb.CreateFieldResponse, error) {
+	if req.GetField() == nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "field is required", nil)
+	}
+	field := FromProto(req.GetField())
+	field.ID = 0
+	id, err := s.ucs.CreateField(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateFieldResponse{Id: id}, nil
+}
+
+func (s *GrpcServer) ListFields(ctx context.Context, _ *pb.ListFieldsRequest) (*pb.ListFieldsResponse, error) {
+	fields, err := s.ucs.ListFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListFieldsResponse{Fields: make([]*pb.Field, 0, len(fields))}
+	for i := range fields {
+		res.Fields = append(res.Fields, ToProto(&fields[i]))
+	}
+	return res, nil
+}
+
+func (s *GrpcServer) GetField(ctx context.Context, req *pb.GetFieldRequest) (*pb.GetFieldResponse, error) {
+	field, err := s.ucs.GetField(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFieldResponse{Field: ToProto(field)}, nil
+}
+
+func (s *GrpcServer) UpdateField(ctx context.Context, req *pb.UpdateFieldRequest) (*pb.UpdateFieldResponse, error) {
+	if req.GetField().GetId() == 0 {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "field id is required", nil)
+	}
+	if err := s.ucs.UpdateField(ctx, FromProto(req.GetField())); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateFieldResponse{}, nil
+}
+
+func (s *GrpcServer) DeleteField(ctx context.Context, req *pb.DeleteFieldRequest) (*pb.DeleteFieldResponse, error) {
+	if err := s.ucs.DeleteField(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteFieldResponse{}, nil
+}
+
+// ToProto mapea un campo del dominio, con sus lotes, al mensaje gRPC.
+func ToProto(f *domain.Field) *pb.Field {
+	if f == nil {
+		return nil
+	}
+	field := &pb.Field{
+		Id:          f.ID,
+		Name:        f.Name,
+		LeaseTypeId: f.LeaseTypeID,
+		Lots:        make([]*lotpb.Lot, 0, len(f.Lots)),
+	}
+	for i := range f.Lots {
+		field.Lots = append(field.Lots, lot.ToProto(&f.Lots[i]))
+	}
+	return field
+}
+
+// FromProto mapea el mensaje gRPC a un campo del dominio.
+func FromProto(f *pb.Field) *domain.Field {
+	if f == nil {
+		return &domain.Field{}
+	}
+	field := &domain.Field{
+		ID:          f.GetId(),
+		Name:        f.GetName(),
+		LeaseTypeID: f.GetLeaseTypeId(),
+	}
+	for _, l := range f.GetLots() {
+		field.Lots = append(field.Lots, *lot.FromProto(l))
+	}
+	return field
+}
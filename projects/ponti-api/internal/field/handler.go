@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
 
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
 	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
@@ -35,24 +36,25 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/fields"
-	publicPrefix := apiBase + "/public"
 	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreateField)       // Create a field
-		public.GET("", h.ListFields)         // List all fields
-		public.GET("/:id", h.GetField)       // Get a field by ID
-		public.PUT("/:id", h.UpdateField)    // Update a field
-		public.DELETE("/:id", h.DeleteField) // Delete a field
-	}
-
 	// Protected routes.
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
 		protected.GET("/ping", h.ProtectedPing) // Protected test endpoint
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("field:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("field:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateField) // Create a field
+		read.GET("", h.ListFields)                                   // List all fields
+		read.GET("/:id", h.GetField)                                 // Get a field by ID
+		write.PUT("/:id", h.UpdateField)                             // Update a field
+		write.DELETE("/:id", h.DeleteField)                          // Delete a field
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -61,16 +63,25 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 	})
 }
 
+func fieldID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return 0, false
+	}
+	return id, true
+}
+
 // CreateField handles POST /fields
 func (h *Handler) CreateField(c *gin.Context) {
 	var req dto.CreateFieldRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: err.Error()})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	id, err := h.ucs.CreateField(c.Request.Context(), req.ToDomain())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusCreated, dto.CreateFieldResponse{Message: "Field created", ID: id})
@@ -80,7 +91,7 @@ func (h *Handler) CreateField(c *gin.Context) {
 func (h *Handler) ListFields(c *gin.Context) {
 	fields, err := h.ucs.ListFields(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	dtos := make([]dto.Field, len(fields))
@@ -92,10 +103,13 @@ func (h *Handler) ListFields(c *gin.Context) {
 
 // GetField handles GET /fields/:id
 func (h *Handler) GetField(c *gin.Context) {
-	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, ok := fieldID(c)
+	if !ok {
+		return
+	}
 	f, err := h.ucs.GetField(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, dto.FromDomain(*f))
@@ -103,16 +117,19 @@ func (h *Handler) GetField(c *gin.Context) {
 
 // UpdateField handles PUT /fields/:id
 func (h *Handler) UpdateField(c *gin.Context) {
-	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, ok := fieldID(c)
+	if !ok {
+		return
+	}
 	var req dto.UpdateField
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: err.Error()})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	dom := req.ToDomain()
 	dom.ID = id
 	if err := h.ucs.UpdateField(c.Request.Context(), dom); err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Field updated"})
@@ -120,9 +137,12 @@ func (h *Handler) UpdateField(c *gin.Context) {
 
 // DeleteField handles DELETE /fields/:id
 func (h *Handler) DeleteField(c *gin.Context) {
-	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, ok := fieldID(c)
+	if !ok {
+		return
+	}
 	if err := h.ucs.DeleteField(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Field deleted"})
@@ -0,0 +1,45 @@
+package field
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Field ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateField, pkgswagger.Operation{
+		Summary:   "Create a field",
+		Request:   dto.CreateFieldRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateFieldResponse{}},
+	})
+	pkgswagger.Describe(h.ListFields, pkgswagger.Operation{
+		Summary:   "List fields",
+		Responses: map[int]any{http.StatusOK: []dto.Field{}},
+	})
+	pkgswagger.Describe(h.GetField, pkgswagger.Operation{
+		Summary:    "Get a field",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: dto.Field{}},
+	})
+	pkgswagger.Describe(h.UpdateField, pkgswagger.Operation{
+		Summary:    "Update a field",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdateField{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteField, pkgswagger.Operation{
+		Summary:    "Delete a field",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
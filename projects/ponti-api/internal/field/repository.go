@@ -12,15 +12,18 @@ import (
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/repository/models"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/usecases/domain"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
+	outboxdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
 )
 
 type repository struct {
-	db gorm.Repository
+	db     gorm.Repository
+	outbox outbox.Repository
 }
 
 // NewRepository creates a new GORM repository for Field.
-func NewRepository(db gorm.Repository) Repository {
-	return &repository{db: db}
+func NewRepository(db gorm.Repository, ob outbox.Repository) Repository {
+	return &repository{db: db, outbox: ob}
 }
 
 // CreateField persists a Field and returns its autogenerated ID.
@@ -54,6 +57,9 @@ func (r *repository) GetField(ctx context.Context, id int64) (*domain.Field, err
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Field{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("field with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get field", err)
@@ -67,17 +73,38 @@ func (r *repository) UpdateField(ctx context.Context, f *domain.Field) error {
 		return pkgtypes.NewError(pkgtypes.ErrValidation, "field is nil", nil)
 	}
 	model := models.FromDomain(f)
-	result := r.db.Client().WithContext(ctx).
-		Model(&models.Field{}).
-		Where("id = ?", f.ID).
-		Updates(model)
-	if result.Error != nil {
-		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update field", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("field with id %d does not exist", f.ID), nil)
-	}
-	return nil
+	return r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		result := tx.
+			Model(&models.Field{}).
+			Where("id = ?", f.ID).
+			Updates(model)
+		if result.Error != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update field", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			if err := gorm.CrossTenantError(tx, &models.Field{}, f.ID); err != nil {
+				return err
+			}
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("field with id %d does not exist", f.ID), nil)
+		}
+
+		var updated models.Field
+		if err := tx.Where("id = ?", f.ID).First(&updated).Error; err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to reload field", err)
+		}
+		event, err := outboxdom.NewEvent("field", f.ID, outboxdom.EventFieldUpdated, outboxdom.FieldUpdated{
+			FieldID:     updated.ID,
+			Name:        updated.Name,
+			LeaseTypeID: updated.LeaseTypeID,
+		})
+		if err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to build field event", err)
+		}
+		if err := r.outbox.Add(tx, event); err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to record field event", err)
+		}
+		return nil
+	})
 }
 
 // DeleteField deletes a field by its ID.
@@ -88,6 +115,9 @@ func (r *repository) DeleteField(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete field", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Field{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("field with id %d does not exist", id), nil)
 	}
 	return nil
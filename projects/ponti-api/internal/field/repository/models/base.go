@@ -3,12 +3,15 @@ package models
 import (
 	"time"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	cropdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/usecases/domain"
 	lotdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
 )
 
 type Field struct {
+	pkggorm.TenantScoped
+
 	ID          int64     `gorm:"primaryKey;autoIncrement;column:id"`
 	ProjectID   int64     `gorm:"index;column:project_id"`
 	Name        string    `gorm:"size:100;not null;column:name"`
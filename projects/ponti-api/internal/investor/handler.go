@@ -36,24 +36,25 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/investors"
-	publicPrefix := apiBase + "/public"
 	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreateInvestor)       // Create an investor
-		public.GET("", h.ListInvestors)         // List all investors
-		public.GET("/:id", h.GetInvestor)       // Get an investor by ID
-		public.PUT("/:id", h.UpdateInvestor)    // Update an investor
-		public.DELETE("/:id", h.DeleteInvestor) // Delete an investor
-	}
-
 	// Protected routes.
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
 		protected.GET("/ping", h.ProtectedPing) // Protected test endpoint
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("investor:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("investor:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateInvestor) // Create an investor
+		read.GET("", h.ListInvestors)                                   // List all investors
+		read.GET("/:id", h.GetInvestor)                                 // Get an investor by ID
+		write.PUT("/:id", h.UpdateInvestor)                             // Update an investor
+		write.DELETE("/:id", h.DeleteInvestor)                          // Delete an investor
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -66,16 +67,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreateInvestor(c *gin.Context) {
 	var req dto.CreateInvestor
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newID, err := h.ucs.CreateInvestor(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -89,8 +88,7 @@ func (h *Handler) CreateInvestor(c *gin.Context) {
 func (h *Handler) ListInvestors(c *gin.Context) {
 	investors, err := h.ucs.ListInvestors(c.Request.Context())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, investors)
@@ -100,14 +98,13 @@ func (h *Handler) ListInvestors(c *gin.Context) {
 func (h *Handler) GetInvestor(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid investor id"})
+		c.Error(types.NewInvalidIDError("invalid investor id", err))
 		return
 	}
 
 	investor, err := h.ucs.GetInvestor(c.Request.Context(), id)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -118,18 +115,17 @@ func (h *Handler) GetInvestor(c *gin.Context) {
 func (h *Handler) UpdateInvestor(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid investor id"})
+		c.Error(types.NewInvalidIDError("invalid investor id", err))
 		return
 	}
 	var req dto.Investor
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid payload"})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	req.ID = id
 	if err := h.ucs.UpdateInvestor(c.Request.Context(), req.ToDomain()); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Investor updated successfully"})
@@ -139,12 +135,11 @@ func (h *Handler) UpdateInvestor(c *gin.Context) {
 func (h *Handler) DeleteInvestor(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid investor id"})
+		c.Error(types.NewInvalidIDError("invalid investor id", err))
 		return
 	}
 	if err := h.ucs.DeleteInvestor(c.Request.Context(), id); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Investor deleted successfully"})
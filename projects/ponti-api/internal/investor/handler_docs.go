@@ -0,0 +1,46 @@
+package investor
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Investor ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateInvestor, pkgswagger.Operation{
+		Summary:   "Create an investor",
+		Request:   dto.CreateInvestor{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateInvestorResponse{}},
+	})
+	pkgswagger.Describe(h.ListInvestors, pkgswagger.Operation{
+		Summary:   "List investors",
+		Responses: map[int]any{http.StatusOK: []domain.Investor{}},
+	})
+	pkgswagger.Describe(h.GetInvestor, pkgswagger.Operation{
+		Summary:    "Get an investor",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Investor{}},
+	})
+	pkgswagger.Describe(h.UpdateInvestor, pkgswagger.Operation{
+		Summary:    "Update an investor",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.Investor{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteInvestor, pkgswagger.Operation{
+		Summary:    "Delete an investor",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
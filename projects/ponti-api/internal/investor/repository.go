@@ -11,16 +11,20 @@ import (
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/repository/models"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/usecases/domain"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
+	outboxdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
 )
 
 type repository struct {
-	db gorm.Repository
+	db     gorm.Repository
+	outbox outbox.Repository
 }
 
 // NewRepository creates a new Investor repository instance.
-func NewRepository(db gorm.Repository) Repository {
+func NewRepository(db gorm.Repository, ob outbox.Repository) Repository {
 	return &repository{
-		db: db,
+		db:     db,
+		outbox: ob,
 	}
 }
 
@@ -29,8 +33,14 @@ func (r *repository) CreateInvestor(ctx context.Context, inv *domain.Investor) (
 		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "investor is nil", nil)
 	}
 	model := models.FromDomain(inv)
-	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
-		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create investor", err)
+	err := r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create investor", err)
+		}
+		return r.recordContribution(tx, model)
+	})
+	if err != nil {
+		return 0, err
 	}
 	return model.ID, nil
 }
@@ -52,6 +62,9 @@ func (r *repository) GetInvestor(ctx context.Context, id int64) (*domain.Investo
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Investor{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("investor with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get investor", err)
@@ -63,15 +76,53 @@ func (r *repository) UpdateInvestor(ctx context.Context, inv *domain.Investor) e
 	if inv == nil {
 		return pkgtypes.NewError(pkgtypes.ErrValidation, "investor is nil", nil)
 	}
-	result := r.db.Client().WithContext(ctx).
-		Model(&models.Investor{}).
-		Where("id = ?", inv.ID).
-		Updates(models.FromDomain(inv))
-	if result.Error != nil {
-		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update investor", result.Error)
+	return r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		var before models.Investor
+		if err := tx.Where("id = ?", inv.ID).First(&before).Error; err != nil {
+			if errors.Is(err, gorm0.ErrRecordNotFound) {
+				if err := gorm.CrossTenantError(tx, &models.Investor{}, inv.ID); err != nil {
+					return err
+				}
+				return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("investor with id %d does not exist", inv.ID), err)
+			}
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get investor", err)
+		}
+
+		result := tx.
+			Model(&models.Investor{}).
+			Where("id = ?", inv.ID).
+			Updates(models.FromDomain(inv))
+		if result.Error != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update investor", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("investor with id %d does not exist", inv.ID), nil)
+		}
+
+		var after models.Investor
+		if err := tx.Where("id = ?", inv.ID).First(&after).Error; err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to reload investor", err)
+		}
+		if before.Contributions == after.Contributions && before.ContributionDate.Equal(after.ContributionDate) {
+			return nil
+		}
+		return r.recordContribution(tx, &after)
+	})
+}
+
+// recordContribution writes an InvestorContributionRecorded event within tx.
+func (r *repository) recordContribution(tx *gorm0.DB, m *models.Investor) error {
+	event, err := outboxdom.NewEvent("investor", m.ID, outboxdom.EventInvestorContributionRecorded, outboxdom.InvestorContributionRecorded{
+		InvestorID:       m.ID,
+		FieldID:          m.FieldID,
+		Contributions:    m.Contributions,
+		ContributionDate: m.ContributionDate,
+	})
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to build investor event", err)
 	}
-	if result.RowsAffected == 0 {
-		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("investor with id %d does not exist", inv.ID), nil)
+	if err := r.outbox.Add(tx, event); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to record investor event", err)
 	}
 	return nil
 }
@@ -83,6 +134,9 @@ func (r *repository) DeleteInvestor(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete investor", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Investor{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("investor with id %d does not exist", id), nil)
 	}
 	return nil
@@ -3,10 +3,13 @@ package models
 import (
 	"time"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/usecases/domain"
 )
 
 type Investor struct {
+	pkggorm.TenantScoped
+
 	ID               int64     `gorm:"primaryKey"`
 	Name             string    `gorm:"type:varchar(255);not null"`
 	FieldID          int64     `gorm:"not null"`
@@ -0,0 +1,119 @@
+package lot
+
+import (
+	"context"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	crop "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/grpc/pb"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
+)
+
+// GrpcServer expone los casos de uso de lotes como LotService. Los errores del
+// dominio los traduce a status el interceptor del servidor.
+type GrpcServer struct {
+	pb.UnimplementedLotServiceServer
+	ucs UseCases
+}
+
+// NewGrpcServer crea el servidor gRPC de lotes.
+func NewGrpcServer(ucs UseCases) *GrpcServer {
+	return &GrpcServer{ucs: ucs}
+}
+
+// Register registra LotService en el servidor gRPC.
+func (s *GrpcServer) Register(ctx context.Context, server pkggrpcserver.Server) {
+	server.RegisterService(ctx, &pb.LotService_ServiceDesc, s)
+}
+
+func (s *GrpcServer) CreateLot(ctx context.Context, req *pb.CreateLotRequest) (*pb.CreateLotResponse, error) {
+	if req.GetLot() == nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "lot is required", nil)
+	}
+	lot := FromProto(req.GetLot())
+	lot.ID = 0
+	id, err := s.ucs.CreateLot(ctx, lot)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateLotResponse{Id: id}, nil
+}
+
+// ListLots envía los lotes de a uno, filtrados por campo si se indica. Se corta
+// si el cliente cancela el stream.
+func (s *GrpcServer) ListLots(req *pb.ListLotsRequest, stream pb.LotService_ListLotsServer) error {
+	lots, err := s.ucs.ListLots(stream.Context())
+	if err != nil {
+		return err
+	}
+	for i := range lots {
+		if req.GetFieldId() != 0 && lots[i].FieldID != req.GetFieldId() {
+			continue
+		}
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		if err := stream.Send(ToProto(&lots[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *GrpcServer) GetLot(ctx context.Context, req *pb.GetLotRequest) (*pb.GetLotResponse, error) {
+	lot, err := s.ucs.GetLot(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLotResponse{Lot: ToProto(lot)}, nil
+}
+
+func (s *GrpcServer) UpdateLot(ctx context.Context, req *pb.UpdateLotRequest) (*pb.UpdateLotResponse, error) {
+	if req.GetLot().GetId() == 0 {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "lot id is required", nil)
+	}
+	if err := s.ucs.UpdateLot(ctx, FromProto(req.GetLot())); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateLotResponse{}, nil
+}
+
+func (s *GrpcServer) DeleteLot(ctx context.Context, req *pb.DeleteLotRequest) (*pb.DeleteLotResponse, error) {
+	if err := s.ucs.DeleteLot(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteLotResponse{}, nil
+}
+
+// ToProto mapea un lote del dominio al mensaje gRPC.
+func ToProto(l *domain.Lot) *pb.Lot {
+	if l == nil {
+		return nil
+	}
+	return &pb.Lot{
+		Id:           l.ID,
+		Name:         l.Name,
+		FieldId:      l.FieldID,
+		Hectares:     l.Hectares,
+		PreviousCrop: crop.ToProto(&l.PreviousCrop),
+		CurrentCrop:  crop.ToProto(&l.CurrentCrop),
+		Season:       l.Season,
+	}
+}
+
+// FromProto mapea el mensaje gRPC a un lote del dominio.
+func FromProto(l *pb.Lot) *domain.Lot {
+	if l == nil {
+		return &domain.Lot{}
+	}
+	return &domain.Lot{
+		ID:           l.GetId(),
+		Name:         l.GetName(),
+		FieldID:      l.GetFieldId(),
+		Hectares:     l.GetHectares(),
+		PreviousCrop: *crop.FromProto(l.GetPreviousCrop()),
+		CurrentCrop:  *crop.FromProto(l.GetCurrentCrop()),
+		Season:       l.GetSeason(),
+	}
+}
@@ -0,0 +1,156 @@
+package lot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	pkgcgrpcclient "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/client"
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	cropdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/grpc/pb"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/mocks"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
+)
+
+const testClientToken = "reports-token-0123456789"
+
+// newLotClient serves the lot gRPC server over an in-memory listener with the same
+// interceptors as production, and returns a client using the pkg client interceptors
+// and the given token. The server knows one client, of organization 7.
+func newLotClient(t *testing.T, ucs UseCases, token string) pb.LotServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	clients := []pkggrpcserver.Client{{Name: "reports", TenantID: 7, Token: testClientToken}}
+	srv := grpc.NewServer(pkggrpcserver.Interceptors(clients)...)
+	pb.RegisterLotServiceServer(srv, NewGrpcServer(ucs))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	opts := append(pkgcgrpcclient.Interceptors(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+	)
+	if token != "" {
+		opts = append(opts, pkgcgrpcclient.WithToken(token))
+	}
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewLotServiceClient(conn)
+}
+
+func TestGrpcServer(t *testing.T) {
+	lots := []domain.Lot{
+		{ID: 1, Name: "Norte", FieldID: 10, Hectares: 50, CurrentCrop: cropdom.Crop{ID: 3, Name: "Soja"}},
+		{ID: 2, Name: "Sur", FieldID: 20, Hectares: 30},
+		{ID: 3, Name: "Este", FieldID: 10, Hectares: 12.5},
+	}
+
+	t.Run("ListLots streams the lots of a field with the client's tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+		ucs.EXPECT().ListLots(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]domain.Lot, error) {
+			tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, int64(7), tenantID)
+			return lots, nil
+		})
+
+		stream, err := newLotClient(t, ucs, testClientToken).ListLots(context.Background(), &pb.ListLotsRequest{FieldId: 10})
+		require.NoError(t, err)
+
+		var got []*pb.Lot
+		for {
+			lot, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			got = append(got, lot)
+		}
+		if assert.Len(t, got, 2) {
+			assert.Equal(t, "Norte", got[0].GetName())
+			assert.Equal(t, "Soja", got[0].GetCurrentCrop().GetName())
+			assert.Equal(t, "Este", got[1].GetName())
+		}
+	})
+
+	t.Run("ListLots errors reach the client as domain errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+		ucs.EXPECT().ListLots(gomock.Any()).Return(nil, pkgtypes.NewError(pkgtypes.ErrAuthorization, "tenant required", nil))
+
+		stream, err := newLotClient(t, ucs, testClientToken).ListLots(context.Background(), &pb.ListLotsRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.True(t, pkgtypes.IsAuthorizationError(err), "got %v", err)
+	})
+
+	tests := []struct {
+		name    string
+		err     error
+		isError func(error) bool
+	}{
+		{name: "not found", err: pkgtypes.NewError(pkgtypes.ErrNotFound, "lot 9 not found", nil), isError: pkgtypes.IsNotFound},
+		{name: "validation", err: pkgtypes.NewError(pkgtypes.ErrValidation, "invalid id", nil), isError: pkgtypes.IsValidationError},
+		{name: "wrapped conflict", err: errors.Join(errors.New("context"), pkgtypes.NewError(pkgtypes.ErrConflict, "duplicated", nil)), isError: pkgtypes.IsConflict},
+	}
+	for _, tt := range tests {
+		t.Run("GetLot maps "+tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ucs := mocks.NewMockUseCases(ctrl)
+			ucs.EXPECT().GetLot(gomock.Any(), int64(9)).Return(nil, tt.err)
+
+			_, err := newLotClient(t, ucs, testClientToken).GetLot(context.Background(), &pb.GetLotRequest{Id: 9})
+			assert.True(t, tt.isError(err), "got %v", err)
+		})
+	}
+
+	t.Run("another organization in the metadata is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+
+		ctx := pkgtypes.WithTenantID(context.Background(), 9)
+		stream, err := newLotClient(t, ucs, testClientToken).ListLots(ctx, &pb.ListLotsRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.True(t, pkgtypes.IsAuthorizationError(err), "got %v", err)
+	})
+
+	authTests := []struct {
+		name  string
+		token string
+		md    []string
+	}{
+		{name: "without a token"},
+		{name: "with an unknown token", token: "someone-else-0123456789"},
+		{name: "with only tenant metadata", md: []string{pkggrpcserver.TenantMetadataKey, "7"}},
+	}
+	for _, tt := range authTests {
+		t.Run("calls "+tt.name+" are rejected", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ucs := mocks.NewMockUseCases(ctrl)
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), tt.md...)
+			_, err := newLotClient(t, ucs, tt.token).GetLot(ctx, &pb.GetLotRequest{Id: 9})
+			assert.True(t, pkgtypes.IsAuthenticationError(err), "got %v", err)
+
+			stream, err := newLotClient(t, ucs, tt.token).ListLots(ctx, &pb.ListLotsRequest{})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.True(t, pkgtypes.IsAuthenticationError(err), "got %v", err)
+		})
+	}
+}
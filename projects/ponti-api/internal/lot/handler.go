@@ -32,23 +32,24 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/lots"
-	publicPrefix := apiBase + "/public"
 	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreateLot)
-		public.GET("", h.ListLots)
-		public.GET("/:id", h.GetLot)
-		public.PUT("/:id", h.UpdateLot)
-		public.DELETE("/:id", h.DeleteLot)
-	}
-
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
 		protected.GET("/ping", h.ProtectedPing)
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("lot:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("lot:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateLot)
+		read.GET("", h.ListLots)
+		read.GET("/:id", h.GetLot)
+		write.PUT("/:id", h.UpdateLot)
+		write.DELETE("/:id", h.DeleteLot)
 	}
+
+	h.describeRoutes()
 }
 
 // ProtectedPing is a test endpoint for protected routes.
@@ -60,16 +61,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreateLot(c *gin.Context) {
 	var req dto.CreateLot
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
 	dom := req.Lot.ToDomain()
 	newID, err := h.ucs.CreateLot(c.Request.Context(), dom)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -80,8 +79,7 @@ func (h *Handler) CreateLot(c *gin.Context) {
 func (h *Handler) ListLots(c *gin.Context) {
 	lots, err := h.ucs.ListLots(c.Request.Context())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, lots)
@@ -91,14 +89,13 @@ func (h *Handler) ListLots(c *gin.Context) {
 func (h *Handler) GetLot(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid lot id"})
+		c.Error(types.NewInvalidIDError("invalid lot id", err))
 		return
 	}
 
 	lot, err := h.ucs.GetLot(c.Request.Context(), id)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -109,20 +106,18 @@ func (h *Handler) GetLot(c *gin.Context) {
 func (h *Handler) UpdateLot(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid lot id"})
+		c.Error(types.NewInvalidIDError("invalid lot id", err))
 		return
 	}
 	var req dto.UpdateLot
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	dom := req.Lot.ToDomain()
 	dom.ID = id
 	if err := h.ucs.UpdateLot(c.Request.Context(), dom); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Lot updated successfully"})
@@ -132,12 +127,11 @@ func (h *Handler) UpdateLot(c *gin.Context) {
 func (h *Handler) DeleteLot(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid lot id"})
+		c.Error(types.NewInvalidIDError("invalid lot id", err))
 		return
 	}
 	if err := h.ucs.DeleteLot(c.Request.Context(), id); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Lot deleted successfully"})
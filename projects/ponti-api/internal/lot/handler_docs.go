@@ -0,0 +1,46 @@
+package lot
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Lot ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateLot, pkgswagger.Operation{
+		Summary:   "Create a lot",
+		Request:   dto.CreateLot{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateLotResponse{}},
+	})
+	pkgswagger.Describe(h.ListLots, pkgswagger.Operation{
+		Summary:   "List lots",
+		Responses: map[int]any{http.StatusOK: []domain.Lot{}},
+	})
+	pkgswagger.Describe(h.GetLot, pkgswagger.Operation{
+		Summary:    "Get a lot",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Lot{}},
+	})
+	pkgswagger.Describe(h.UpdateLot, pkgswagger.Operation{
+		Summary:    "Update a lot",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdateLot{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteLot, pkgswagger.Operation{
+		Summary:    "Delete a lot",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
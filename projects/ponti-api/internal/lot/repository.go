@@ -11,14 +11,17 @@ import (
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/repository/models"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
+	outboxdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
 )
 
 type repository struct {
-	db gorm.Repository
+	db     gorm.Repository
+	outbox outbox.Repository
 }
 
-func NewRepository(db gorm.Repository) Repository {
-	return &repository{db: db}
+func NewRepository(db gorm.Repository, ob outbox.Repository) Repository {
+	return &repository{db: db, outbox: ob}
 }
 
 // CreateLot persists a Lot and returns its autogenerated ID.
@@ -26,8 +29,12 @@ func (r *repository) CreateLot(ctx context.Context, l *domain.Lot) (int64, error
 	if l == nil {
 		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "lot is nil", nil)
 	}
+	db := r.db.Client().WithContext(ctx)
+	if err := gorm.EnsureSameTenant(db, "fields", l.FieldID); err != nil {
+		return 0, err
+	}
 	model := models.FromDomain(l)
-	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+	if err := db.Create(model).Error; err != nil {
 		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create lot", err)
 	}
 	return model.ID, nil
@@ -52,6 +59,9 @@ func (r *repository) GetLot(ctx context.Context, id int64) (*domain.Lot, error)
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Lot{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("lot with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get lot", err)
@@ -64,17 +74,59 @@ func (r *repository) UpdateLot(ctx context.Context, l *domain.Lot) error {
 	if l == nil {
 		return pkgtypes.NewError(pkgtypes.ErrValidation, "lot is nil", nil)
 	}
-	result := r.db.Client().WithContext(ctx).
-		Model(&models.Lot{}).
-		Where("id = ?", l.ID).
-		Updates(models.FromDomain(l))
-	if result.Error != nil {
-		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update lot", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("lot with id %d does not exist", l.ID), nil)
-	}
-	return nil
+	return r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		var before models.Lot
+		if err := tx.Where("id = ?", l.ID).First(&before).Error; err != nil {
+			if errors.Is(err, gorm0.ErrRecordNotFound) {
+				if err := gorm.CrossTenantError(tx, &models.Lot{}, l.ID); err != nil {
+					return err
+				}
+				return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("lot with id %d does not exist", l.ID), err)
+			}
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get lot", err)
+		}
+		if l.FieldID != 0 {
+			if err := gorm.EnsureSameTenant(tx, "fields", l.FieldID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.
+			Model(&models.Lot{}).
+			Where("id = ?", l.ID).
+			Updates(models.FromDomain(l))
+		if result.Error != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update lot", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("lot with id %d does not exist", l.ID), nil)
+		}
+
+		var after models.Lot
+		if err := tx.Where("id = ?", l.ID).First(&after).Error; err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to reload lot", err)
+		}
+		if before.PreviousCropID == after.PreviousCropID && before.CurrentCropID == after.CurrentCropID {
+			return nil
+		}
+
+		event, err := outboxdom.NewEvent("lot", l.ID, outboxdom.EventLotCropChanged, outboxdom.LotCropChanged{
+			LotID:             after.ID,
+			FieldID:           after.FieldID,
+			Season:            after.Season,
+			OldPreviousCropID: before.PreviousCropID,
+			OldCurrentCropID:  before.CurrentCropID,
+			NewPreviousCropID: after.PreviousCropID,
+			NewCurrentCropID:  after.CurrentCropID,
+		})
+		if err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to build lot event", err)
+		}
+		if err := r.outbox.Add(tx, event); err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to record lot event", err)
+		}
+		return nil
+	})
 }
 
 // DeleteLot deletes a lot by its ID.
@@ -85,6 +137,9 @@ func (r *repository) DeleteLot(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete lot", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Lot{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("lot with id %d does not exist", id), nil)
 	}
 	return nil
@@ -3,12 +3,15 @@ package models
 import (
 	"time"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	cropdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot/usecases/domain"
 )
 
 // Lot is the GORM model for a land parcel, storing only foreign-key references.
 type Lot struct {
+	pkggorm.TenantScoped
+
 	ID             int64     `gorm:"primaryKey"`
 	Name           string    `gorm:"size:100;not null"`
 	FieldID        int64     `gorm:"not null;index;column:field_id"`
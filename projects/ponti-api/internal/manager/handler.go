@@ -36,24 +36,25 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/managers"
-	publicPrefix := apiBase + "/public"
 	protectedPrefix := apiBase + "/protected"
 
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreateManager)       // Crear un manager
-		public.GET("", h.ListManagers)         // Listar todos los customers
-		public.GET("/:id", h.GetManager)       // Obtener un manager por ID
-		public.PUT("/:id", h.UpdateManager)    // Actualizar un manager
-		public.DELETE("/:id", h.DeleteManager) // Eliminar un manager
-	}
-
 	// Rutas protegidas.
 	protected := router.Group(protectedPrefix)
 	{
 		protected.Use(h.mws.Protected...)
 		protected.GET("/ping", h.ProtectedPing) // Endpoint de prueba protegido
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("manager:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("manager:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateManager) // Crear un manager
+		read.GET("", h.ListManagers)                                   // Listar todos los customers
+		read.GET("/:id", h.GetManager)                                 // Obtener un manager por ID
+		write.PUT("/:id", h.UpdateManager)                             // Actualizar un manager
+		write.DELETE("/:id", h.DeleteManager)                          // Eliminar un manager
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -65,16 +66,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreateManager(c *gin.Context) {
 	var req dto.CreateManager
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newID, err := h.ucs.CreateManager(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -87,8 +86,7 @@ func (h *Handler) CreateManager(c *gin.Context) {
 func (h *Handler) ListManagers(c *gin.Context) {
 	customers, err := h.ucs.ListManagers(c.Request.Context())
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, customers)
@@ -97,14 +95,13 @@ func (h *Handler) ListManagers(c *gin.Context) {
 func (h *Handler) GetManager(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid manager id"})
+		c.Error(types.NewInvalidIDError("invalid manager id", err))
 		return
 	}
 
 	manager, err := h.ucs.GetManager(c.Request.Context(), id)
 	if err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -115,18 +112,17 @@ func (h *Handler) GetManager(c *gin.Context) {
 func (h *Handler) UpdateManager(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid manager id"})
+		c.Error(types.NewInvalidIDError("invalid manager id", err))
 		return
 	}
 	var req dto.Manager
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid payload"})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	req.ID = id
 	if err := h.ucs.UpdateManager(c.Request.Context(), req.ToDomain()); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Manager updated successfully"})
@@ -136,12 +132,11 @@ func (h *Handler) UpdateManager(c *gin.Context) {
 func (h *Handler) DeleteManager(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid manager id"})
+		c.Error(types.NewInvalidIDError("invalid manager id", err))
 		return
 	}
 	if err := h.ucs.DeleteManager(c.Request.Context(), id); err != nil {
-		apiErr, _ := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(map[string]any{"details": err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "Manager deleted successfully"})
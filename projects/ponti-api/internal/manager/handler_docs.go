@@ -0,0 +1,46 @@
+package manager
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Manager ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateManager, pkgswagger.Operation{
+		Summary:   "Create a manager",
+		Request:   dto.CreateManager{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateManagerResponse{}},
+	})
+	pkgswagger.Describe(h.ListManagers, pkgswagger.Operation{
+		Summary:   "List managers",
+		Responses: map[int]any{http.StatusOK: []domain.Manager{}},
+	})
+	pkgswagger.Describe(h.GetManager, pkgswagger.Operation{
+		Summary:    "Get a manager",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Manager{}},
+	})
+	pkgswagger.Describe(h.UpdateManager, pkgswagger.Operation{
+		Summary:    "Update a manager",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.Manager{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteManager, pkgswagger.Operation{
+		Summary:    "Delete a manager",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
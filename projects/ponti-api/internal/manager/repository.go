@@ -51,6 +51,9 @@ func (r *repository) GetManager(ctx context.Context, id int64) (*domain.Manager,
 	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Manager{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("manager with id %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get manager", err)
@@ -70,6 +73,9 @@ func (r *repository) UpdateManager(ctx context.Context, c *domain.Manager) error
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update manager", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Manager{}, c.ID); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("manager with id %d does not exist", c.ID), nil)
 	}
 	return nil
@@ -82,6 +88,9 @@ func (r *repository) DeleteManager(ctx context.Context, id int64) error {
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete manager", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Manager{}, id); err != nil {
+			return err
+		}
 		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("manager with id %d does not exist", id), nil)
 	}
 	return nil
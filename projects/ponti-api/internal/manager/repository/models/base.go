@@ -1,10 +1,13 @@
 package models
 
 import (
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/usecases/domain"
 )
 
 type Manager struct {
+	pkggorm.TenantScoped
+
 	ID   int64  `gorm:"primaryKey;autoIncrement"`
 	Name string `gorm:"column:name;type:varchar(100);not null"`
 	Type string `gorm:"column:type;type:varchar(50);not null"`
@@ -39,7 +39,8 @@ func (h *Handler) Routes() {
 	// Rutas públicas
 	public := router.Group(publicPrefix)
 	{
-		public.POST("", h.SendEmail)
+		// Sends arbitrary email: limited per IP.
+		public.POST("", h.mws.RateLimit("public"), h.SendEmail)
 	}
 
 	validated := router.Group(validatedPrefix)
@@ -56,6 +57,8 @@ func (h *Handler) Routes() {
 
 		protected.GET("/ping", h.ProtectedPing)
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -75,15 +78,13 @@ func (h *Handler) SendEmail(c *gin.Context) {
 
 	var req dto.EmailVerification
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	if err := h.ucs.SendEmail(ctx, req.ToDomain().Address, req.ToDomain().Subject, req.ToDomain().Body); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
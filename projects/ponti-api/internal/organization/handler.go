@@ -0,0 +1,95 @@
+package organization
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/handler/dto"
+)
+
+// Handler encapsulates dependencies for the Organization HTTP handler.
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+// NewHandler creates a new Organization handler.
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{ucs: u, gsv: s, mws: m}
+}
+
+// Routes registers HTTP routes for organizations. Organizations are managed
+// across tenants, so every route requires the platform:admin permission.
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/organizations"
+	protectedPrefix := apiBase + "/protected"
+
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(h.mws.RequirePermission("platform:admin"))
+		protected.Group("", h.mws.Idempotent...).POST("", h.CreateOrganization)
+		protected.GET("", h.ListOrganizations)
+		protected.GET("/:id", h.GetOrganization)
+	}
+
+	h.describeRoutes()
+}
+
+// CreateOrganization handles POST /organizations
+func (h *Handler) CreateOrganization(c *gin.Context) {
+	var req dto.CreateOrganization
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	newID, err := h.ucs.CreateOrganization(c.Request.Context(), req.Organization.ToDomain())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateOrganizationResponse{Message: "Organization created successfully", ID: newID})
+}
+
+// ListOrganizations handles GET /organizations
+func (h *Handler) ListOrganizations(c *gin.Context) {
+	list, err := h.ucs.ListOrganizations(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	result := make([]dto.Organization, 0, len(list))
+	for _, o := range list {
+		result = append(result, *dto.FromDomain(o))
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// GetOrganization handles GET /organizations/:id
+func (h *Handler) GetOrganization(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid organization id", err))
+		return
+	}
+
+	org, err := h.ucs.GetOrganization(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(*org))
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+// Organization is the DTO for an organization.
+type Organization struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name" binding:"required"`
+}
+
+// ToDomain converts the DTO into a domain.Organization.
+func (o Organization) ToDomain() *domain.Organization {
+	return &domain.Organization{
+		ID:   o.ID,
+		Name: o.Name,
+	}
+}
+
+// FromDomain converts a domain.Organization into a DTO.
+func FromDomain(d domain.Organization) *Organization {
+	return &Organization{
+		ID:   d.ID,
+		Name: d.Name,
+	}
+}
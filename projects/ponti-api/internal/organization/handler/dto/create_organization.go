@@ -0,0 +1,12 @@
+package dto
+
+// CreateOrganization is the DTO for creating an organization.
+type CreateOrganization struct {
+	Organization
+}
+
+// CreateOrganizationResponse is the response DTO for CreateOrganization.
+type CreateOrganizationResponse struct {
+	Message string `json:"message"`
+	ID      int64  `json:"id"`
+}
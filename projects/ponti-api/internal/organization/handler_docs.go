@@ -0,0 +1,27 @@
+package organization
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	pkgswagger.Describe(h.CreateOrganization, pkgswagger.Operation{
+		Summary:   "Create an organization",
+		Request:   dto.CreateOrganization{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateOrganizationResponse{}},
+	})
+	pkgswagger.Describe(h.ListOrganizations, pkgswagger.Operation{
+		Summary:   "List organizations",
+		Responses: map[int]any{http.StatusOK: []dto.Organization{}},
+	})
+	pkgswagger.Describe(h.GetOrganization, pkgswagger.Operation{
+		Summary:    "Get an organization",
+		Parameters: []pkgswagger.Parameter{pkgswagger.PathParam("id", "integer", "Organization ID")},
+		Responses:  map[int]any{http.StatusOK: dto.Organization{}},
+	})
+}
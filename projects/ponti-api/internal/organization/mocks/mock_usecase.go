@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/organization/ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreateOrganization mocks base method.
+func (m *MockUseCases) CreateOrganization(arg0 context.Context, arg1 *domain.Organization) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockUseCasesMockRecorder) CreateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockUseCases)(nil).CreateOrganization), arg0, arg1)
+}
+
+// GetOrganization mocks base method.
+func (m *MockUseCases) GetOrganization(arg0 context.Context, arg1 int64) (*domain.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockUseCasesMockRecorder) GetOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockUseCases)(nil).GetOrganization), arg0, arg1)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockUseCases) ListOrganizations(arg0 context.Context) ([]domain.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", arg0)
+	ret0, _ := ret[0].([]domain.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockUseCasesMockRecorder) ListOrganizations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockUseCases)(nil).ListOrganizations), arg0)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateOrganization mocks base method.
+func (m *MockRepository) CreateOrganization(arg0 context.Context, arg1 *domain.Organization) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockRepositoryMockRecorder) CreateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockRepository)(nil).CreateOrganization), arg0, arg1)
+}
+
+// EnsureDefault mocks base method.
+func (m *MockRepository) EnsureDefault(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDefault", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureDefault indicates an expected call of EnsureDefault.
+func (mr *MockRepositoryMockRecorder) EnsureDefault(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDefault", reflect.TypeOf((*MockRepository)(nil).EnsureDefault), arg0)
+}
+
+// GetOrganization mocks base method.
+func (m *MockRepository) GetOrganization(arg0 context.Context, arg1 int64) (*domain.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockRepositoryMockRecorder) GetOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockRepository)(nil).GetOrganization), arg0, arg1)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockRepository) ListOrganizations(arg0 context.Context) ([]domain.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", arg0)
+	ret0, _ := ret[0].([]domain.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockRepositoryMockRecorder) ListOrganizations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockRepository)(nil).ListOrganizations), arg0)
+}
@@ -0,0 +1,21 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+type UseCases interface {
+	CreateOrganization(context.Context, *domain.Organization) (int64, error)
+	ListOrganizations(context.Context) ([]domain.Organization, error)
+	GetOrganization(context.Context, int64) (*domain.Organization, error)
+}
+
+type Repository interface {
+	CreateOrganization(context.Context, *domain.Organization) (int64, error)
+	ListOrganizations(context.Context) ([]domain.Organization, error)
+	GetOrganization(context.Context, int64) (*domain.Organization, error)
+	// EnsureDefault creates the default organization that owns pre-tenancy data.
+	EnsureDefault(context.Context) error
+}
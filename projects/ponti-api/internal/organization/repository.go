@@ -0,0 +1,79 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/repository/models"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+// NewRepository creates a new GORM repository for Organization.
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{db: db}
+}
+
+// CreateOrganization persists an organization and returns its autogenerated ID.
+func (r *repository) CreateOrganization(ctx context.Context, o *domain.Organization) (int64, error) {
+	if o == nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "organization is nil", nil)
+	}
+	model := models.FromDomain(o)
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create organization", err)
+	}
+	return model.ID, nil
+}
+
+// ListOrganizations returns all organizations.
+func (r *repository) ListOrganizations(ctx context.Context) ([]domain.Organization, error) {
+	var list []models.Organization
+	if err := r.db.Client().WithContext(ctx).Order("id").Find(&list).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list organizations", err)
+	}
+	result := make([]domain.Organization, 0, len(list))
+	for _, m := range list {
+		result = append(result, *m.ToDomain())
+	}
+	return result, nil
+}
+
+// GetOrganization retrieves an organization by its ID.
+func (r *repository) GetOrganization(ctx context.Context, id int64) (*domain.Organization, error) {
+	var model models.Organization
+	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("organization with id %d not found", id), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get organization", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// EnsureDefault inserts the default organization if it does not exist yet.
+func (r *repository) EnsureDefault(ctx context.Context) error {
+	model := models.Organization{ID: domain.DefaultOrganizationID, Name: "default"}
+	if err := r.db.Client().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create default organization", err)
+	}
+	// Explicit IDs do not advance the sequence, so move it past the default row.
+	if err := r.db.Client().WithContext(ctx).Exec(
+		"SELECT setval(pg_get_serial_sequence('organizations', 'id'), GREATEST((SELECT MAX(id) FROM organizations), 1))",
+	).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to sync organizations sequence", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+// Organization is the GORM model for a tenant. It is not tenant scoped itself.
+type Organization struct {
+	ID        int64     `gorm:"primaryKey"`
+	Name      string    `gorm:"size:150;not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (m *Organization) ToDomain() *domain.Organization {
+	return &domain.Organization{
+		ID:   m.ID,
+		Name: m.Name,
+	}
+}
+
+func FromDomain(d *domain.Organization) *Organization {
+	return &Organization{
+		ID:   d.ID,
+		Name: d.Name,
+	}
+}
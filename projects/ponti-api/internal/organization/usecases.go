@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"context"
+	"strings"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+)
+
+type useCases struct {
+	repo Repository
+}
+
+func NewUseCases(repo Repository) UseCases {
+	return &useCases{repo: repo}
+}
+
+func (u *useCases) CreateOrganization(ctx context.Context, o *domain.Organization) (int64, error) {
+	o.Name = strings.TrimSpace(o.Name)
+	if o.Name == "" {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "organization name is required", nil)
+	}
+	return u.repo.CreateOrganization(ctx, o)
+}
+
+func (u *useCases) ListOrganizations(ctx context.Context) ([]domain.Organization, error) {
+	return u.repo.ListOrganizations(ctx)
+}
+
+func (u *useCases) GetOrganization(ctx context.Context, id int64) (*domain.Organization, error) {
+	return u.repo.GetOrganization(ctx, id)
+}
@@ -0,0 +1,10 @@
+package domain
+
+// DefaultOrganizationID owns every row created before organizations existed.
+const DefaultOrganizationID int64 = 1
+
+// Organization is a tenant: an agronomy firm whose data is isolated from the rest.
+type Organization struct {
+	ID   int64
+	Name string
+}
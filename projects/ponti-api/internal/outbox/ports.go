@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	gorm0 "gorm.io/gorm"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
+)
+
+// Repository persists outbox events. Add must be called with the transaction
+// that writes the aggregate change so both commit or roll back together.
+type Repository interface {
+	Add(tx *gorm0.DB, events ...*domain.Event) error
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]domain.Event, error)
+	MarkPublished(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryAt time.Time, maxAttempts int) error
+	Requeue(ctx context.Context, id int64) error
+	Skip(ctx context.Context, id int64) error
+}
+
+// Publisher delivers an event to the message broker.
+type Publisher interface {
+	Publish(ctx context.Context, event *domain.Event) error
+}
+
+// Relay moves pending events from the outbox to the broker.
+type Relay interface {
+	Run(ctx context.Context) error
+	Flush(ctx context.Context) (int, error)
+}
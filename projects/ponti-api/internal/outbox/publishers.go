@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pkginmemory "github.com/alphacodinggroup/ponti-backend/pkg/brokers/inmemory"
+	pkgafka "github.com/alphacodinggroup/ponti-backend/pkg/brokers/kafka"
+	pkgrabbit "github.com/alphacodinggroup/ponti-backend/pkg/brokers/rabbitmq/amqp091/producer"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
+)
+
+// rabbitPublisher routes each event by its type on the configured exchange.
+type rabbitPublisher struct {
+	producer pkgrabbit.Producer
+}
+
+func NewRabbitPublisher(p pkgrabbit.Producer) Publisher {
+	return &rabbitPublisher{producer: p}
+}
+
+func (p *rabbitPublisher) Publish(ctx context.Context, e *domain.Event) error {
+	corrID := strconv.FormatInt(e.ID, 10)
+	if _, err := p.producer.Produce(ctx, string(e.Type), "", corrID, e.ToEnvelope()); err != nil {
+		return fmt.Errorf("publish event %d to rabbitmq: %w", e.ID, err)
+	}
+	return nil
+}
+
+// kafkaPublisher writes every event to one topic keyed by aggregate, so the
+// partitioner keeps events of the same aggregate in order.
+type kafkaPublisher struct {
+	service pkgafka.Service
+	topic   string
+}
+
+func NewKafkaPublisher(s pkgafka.Service, topic string) Publisher {
+	return &kafkaPublisher{service: s, topic: topic}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, e *domain.Event) error {
+	value, err := json.Marshal(e.ToEnvelope())
+	if err != nil {
+		return fmt.Errorf("marshal event %d: %w", e.ID, err)
+	}
+	if err := p.service.Publish(ctx, p.topic, []byte(e.OrderingKey()), value); err != nil {
+		return fmt.Errorf("publish event %d to kafka: %w", e.ID, err)
+	}
+	return nil
+}
+
+// localPublisher publishes to the in-process broker, one topic per event type.
+type localPublisher struct {
+	broker pkginmemory.Broker
+}
+
+func NewLocalPublisher(b pkginmemory.Broker) Publisher {
+	return &localPublisher{broker: b}
+}
+
+func (p *localPublisher) Publish(ctx context.Context, e *domain.Event) error {
+	value, err := json.Marshal(e.ToEnvelope())
+	if err != nil {
+		return fmt.Errorf("marshal event %d: %w", e.ID, err)
+	}
+	return p.broker.Publish(ctx, string(e.Type), []byte(e.OrderingKey()), value)
+}
@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RelayConfig tunes the relay loop.
+type RelayConfig struct {
+	BatchSize    int           // events claimed per round
+	PollInterval time.Duration // wait between rounds when the outbox is empty
+	Lease        time.Duration // how long a claimed event stays reserved
+	MaxAttempts  int           // attempts before an event is parked as failed
+	RetryBackoff time.Duration // base delay, doubled on every failed attempt
+}
+
+type relay struct {
+	repo      Repository
+	publisher Publisher
+	config    RelayConfig
+}
+
+// NewRelay creates a relay that publishes outbox events with at-least-once delivery.
+func NewRelay(repo Repository, publisher Publisher, cfg RelayConfig) Relay {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 30 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = time.Second
+	}
+	return &relay{
+		repo:      repo,
+		publisher: publisher,
+		config:    cfg,
+	}
+}
+
+// Run polls the outbox until the context is cancelled.
+func (r *relay) Run(ctx context.Context) error {
+	log.Println("Starting outbox relay...")
+	for {
+		n, err := r.Flush(ctx)
+		if err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+
+		// Keep draining while there is work; otherwise wait for the next tick.
+		wait := r.config.PollInterval
+		if n > 0 && err == nil {
+			wait = 0
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("Outbox relay stopped.")
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Flush claims one batch and publishes it, returning how many events were published.
+func (r *relay) Flush(ctx context.Context) (int, error) {
+	events, err := r.repo.ClaimPending(ctx, r.config.BatchSize, r.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for i := range events {
+		e := &events[i]
+		if err := r.publisher.Publish(ctx, e); err != nil {
+			retryAt := time.Now().UTC().Add(r.backoff(e.Attempts))
+			if markErr := r.repo.MarkFailed(ctx, e.ID, err, retryAt, r.config.MaxAttempts); markErr != nil {
+				log.Printf("outbox relay: event %d publish failed (%v) and could not be marked: %v", e.ID, err, markErr)
+			}
+			continue
+		}
+		// If this fails the lease expires and the event is published again (at-least-once).
+		if err := r.repo.MarkPublished(ctx, e.ID); err != nil {
+			log.Printf("outbox relay: event %d published but not marked: %v", e.ID, err)
+			continue
+		}
+		published++
+	}
+	return published, nil
+}
+
+func (r *relay) backoff(attempts int) time.Duration {
+	if attempts > 10 {
+		attempts = 10
+	}
+	return r.config.RetryBackoff * time.Duration(1<<attempts)
+}
@@ -0,0 +1,236 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	gorm0 "gorm.io/gorm"
+
+	pkginmemory "github.com/alphacodinggroup/ponti-backend/pkg/brokers/inmemory"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRepository mimics the claim semantics of the SQL repository: only the
+// oldest unpublished event of each aggregate can be claimed, and a parked
+// event blocks the rest of its aggregate.
+type fakeRepository struct {
+	events []*domain.Event
+}
+
+func (f *fakeRepository) Add(_ *gorm0.DB, events ...*domain.Event) error {
+	for _, e := range events {
+		e.ID = int64(len(f.events) + 1)
+		f.events = append(f.events, e)
+	}
+	return nil
+}
+
+func (f *fakeRepository) ClaimPending(_ context.Context, limit int, _ time.Duration) ([]domain.Event, error) {
+	heads := map[string]bool{}
+	var claimed []domain.Event
+	for _, e := range f.events {
+		if e.Status == domain.StatusPublished || e.Status == domain.StatusSkipped {
+			continue
+		}
+		if heads[e.OrderingKey()] {
+			continue
+		}
+		heads[e.OrderingKey()] = true
+		if e.Status == domain.StatusFailed {
+			continue
+		}
+		e.Status = domain.StatusProcessing
+		claimed = append(claimed, *e)
+		if len(claimed) == limit {
+			break
+		}
+	}
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i].ID < claimed[j].ID })
+	return claimed, nil
+}
+
+func (f *fakeRepository) MarkPublished(_ context.Context, id int64) error {
+	f.events[id-1].Status = domain.StatusPublished
+	f.events[id-1].PublishedAt = time.Now()
+	return nil
+}
+
+func (f *fakeRepository) MarkFailed(_ context.Context, id int64, cause error, _ time.Time, maxAttempts int) error {
+	e := f.events[id-1]
+	e.Attempts++
+	e.LastError = cause.Error()
+	e.Status = domain.StatusPending
+	if e.Attempts >= maxAttempts {
+		e.Status = domain.StatusFailed
+	}
+	return nil
+}
+
+func (f *fakeRepository) Requeue(_ context.Context, id int64) error {
+	f.events[id-1].Status = domain.StatusPending
+	f.events[id-1].Attempts = 0
+	return nil
+}
+
+func (f *fakeRepository) Skip(_ context.Context, id int64) error {
+	f.events[id-1].Status = domain.StatusSkipped
+	return nil
+}
+
+func mustEvent(t *testing.T, aggregate string, id int64, eventType domain.EventType) *domain.Event {
+	t.Helper()
+	e, err := domain.NewEvent(aggregate, id, eventType, map[string]int64{"id": id})
+	assert.NoError(t, err)
+	return e
+}
+
+func TestRelayFlush(t *testing.T) {
+	tests := []struct {
+		name          string
+		failOnce      map[int64]bool
+		wantRounds    int
+		wantOrder     []int64
+		wantStatusEnd domain.Status
+	}{
+		{
+			name:          "publishes every event in aggregate order",
+			wantRounds:    2,
+			wantOrder:     []int64{1, 3, 2},
+			wantStatusEnd: domain.StatusPublished,
+		},
+		{
+			name:          "retries a failed event before later events of the same aggregate",
+			failOnce:      map[int64]bool{1: true},
+			wantRounds:    3,
+			wantOrder:     []int64{3, 1, 2},
+			wantStatusEnd: domain.StatusPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{}
+			assert.NoError(t, repo.Add(nil,
+				mustEvent(t, "lot", 10, domain.EventLotCropChanged),
+				mustEvent(t, "lot", 10, domain.EventLotCropChanged),
+				mustEvent(t, "field", 7, domain.EventFieldUpdated),
+			))
+
+			broker, err := pkginmemory.Bootstrap()
+			assert.NoError(t, err)
+			defer broker.Close()
+
+			var order []int64
+			failed := map[int64]bool{}
+			record := func(_ context.Context, msg pkginmemory.Message) error {
+				var env domain.Envelope
+				if err := json.Unmarshal(msg.Value, &env); err != nil {
+					return err
+				}
+				if tt.failOnce[env.ID] && !failed[env.ID] {
+					failed[env.ID] = true
+					return errors.New("broker unavailable")
+				}
+				order = append(order, env.ID)
+				return nil
+			}
+			broker.Subscribe(string(domain.EventLotCropChanged), record)
+			broker.Subscribe(string(domain.EventFieldUpdated), record)
+
+			relay := NewRelay(repo, NewLocalPublisher(broker), RelayConfig{MaxAttempts: 3})
+			rounds := 0
+			for ; rounds < 10; rounds++ {
+				n, err := relay.Flush(context.Background())
+				assert.NoError(t, err)
+				if n == 0 && len(order) == len(repo.events) {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.wantRounds, rounds)
+			assert.Equal(t, tt.wantOrder, order)
+			for _, e := range repo.events {
+				assert.Equal(t, tt.wantStatusEnd, e.Status)
+			}
+		})
+	}
+}
+
+func TestRelayParkedEventBlocksItsAggregate(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolve    func(Repository) error
+		wantOrder  []int64
+		wantStatus []domain.Status
+	}{
+		{
+			name:       "requeued event is published before the newer one",
+			resolve:    func(r Repository) error { return r.Requeue(context.Background(), 1) },
+			wantOrder:  []int64{3, 1, 2},
+			wantStatus: []domain.Status{domain.StatusPublished, domain.StatusPublished, domain.StatusPublished},
+		},
+		{
+			name:       "skipped event lets the newer one through",
+			resolve:    func(r Repository) error { return r.Skip(context.Background(), 1) },
+			wantOrder:  []int64{3, 2},
+			wantStatus: []domain.Status{domain.StatusSkipped, domain.StatusPublished, domain.StatusPublished},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{}
+			assert.NoError(t, repo.Add(nil,
+				mustEvent(t, "lot", 10, domain.EventLotCropChanged),
+				mustEvent(t, "lot", 10, domain.EventLotCropChanged),
+				mustEvent(t, "field", 7, domain.EventFieldUpdated),
+			))
+
+			broker, err := pkginmemory.Bootstrap()
+			assert.NoError(t, err)
+			defer broker.Close()
+
+			var order []int64
+			brokerDown := true
+			record := func(_ context.Context, msg pkginmemory.Message) error {
+				var env domain.Envelope
+				if err := json.Unmarshal(msg.Value, &env); err != nil {
+					return err
+				}
+				if env.ID == 1 && brokerDown {
+					return errors.New("broker unavailable")
+				}
+				order = append(order, env.ID)
+				return nil
+			}
+			broker.Subscribe(string(domain.EventLotCropChanged), record)
+			broker.Subscribe(string(domain.EventFieldUpdated), record)
+
+			relay := NewRelay(repo, NewLocalPublisher(broker), RelayConfig{MaxAttempts: 1})
+			flush := func() {
+				for round := 0; round < 5; round++ {
+					_, err := relay.Flush(context.Background())
+					assert.NoError(t, err)
+				}
+			}
+
+			flush()
+			assert.Equal(t, []int64{3}, order)
+			assert.Equal(t, domain.StatusFailed, repo.events[0].Status)
+			assert.Equal(t, domain.StatusPending, repo.events[1].Status, "the newer event waits behind the parked one")
+
+			brokerDown = false
+			assert.NoError(t, tt.resolve(repo))
+			flush()
+			assert.Equal(t, tt.wantOrder, order)
+			for i, e := range repo.events {
+				assert.Equal(t, tt.wantStatus[i], e.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	gorm0 "gorm.io/gorm"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/repository/models"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
+)
+
+// claimPendingSQL claims, for each aggregate, only its oldest unfinished event. While
+// that event is pending, being published or parked as failed, later events of the
+// same aggregate are not selectable, which keeps delivery ordered per aggregate
+// across relay instances. A parked event blocks its aggregate until it is requeued
+// or skipped. Events whose lease expired (relay crashed mid-publish) become
+// claimable again.
+const claimPendingSQL = `
+UPDATE outbox_events SET status = ?, locked_until = ?, updated_at = ?
+WHERE id IN (
+	SELECT o.id FROM outbox_events o
+	WHERE o.id IN (
+		SELECT MIN(id) FROM outbox_events
+		WHERE status IN (?, ?, ?)
+		GROUP BY aggregate_type, aggregate_id
+	)
+	AND (
+		(o.status = ? AND o.available_at <= ?)
+		OR (o.status = ? AND o.locked_until < ?)
+	)
+	ORDER BY o.id
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+type repository struct {
+	db gorm.Repository
+}
+
+// NewRepository creates a new GORM repository for the outbox.
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{db: db}
+}
+
+// Add inserts the events using the caller's transaction.
+func (r *repository) Add(tx *gorm0.DB, events ...*domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	list := make([]*models.OutboxEvent, 0, len(events))
+	for _, e := range events {
+		list = append(list, models.FromDomain(e))
+	}
+	if err := tx.Create(&list).Error; err != nil {
+		return fmt.Errorf("failed to add outbox events: %w", err)
+	}
+	for i, m := range list {
+		events[i].ID = m.ID
+	}
+	return nil
+}
+
+// ClaimPending marks up to limit events as processing for the given lease and returns them ordered by ID.
+func (r *repository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]domain.Event, error) {
+	now := time.Now().UTC()
+	var list []models.OutboxEvent
+	err := r.db.Client().WithContext(ctx).Raw(claimPendingSQL,
+		domain.StatusProcessing, now.Add(lease), now,
+		domain.StatusPending, domain.StatusProcessing, domain.StatusFailed,
+		domain.StatusPending, now,
+		domain.StatusProcessing, now,
+		limit,
+	).Scan(&list).Error
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to claim outbox events", err)
+	}
+
+	result := make([]domain.Event, 0, len(list))
+	for _, m := range list {
+		result = append(result, *m.ToDomain())
+	}
+	// RETURNING does not guarantee any order.
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+// MarkPublished records a successful delivery.
+func (r *repository) MarkPublished(ctx context.Context, id int64) error {
+	now := time.Now().UTC()
+	err := r.db.Client().WithContext(ctx).
+		Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":       domain.StatusPublished,
+			"published_at": now,
+			"locked_until": nil,
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to mark outbox event %d as published", id), err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery. The event goes back to pending until
+// maxAttempts is reached, after which it is parked as failed.
+func (r *repository) MarkFailed(ctx context.Context, id int64, cause error, retryAt time.Time, maxAttempts int) error {
+	err := r.db.Client().WithContext(ctx).
+		Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":     gorm0.Expr("attempts + 1"),
+			"last_error":   cause.Error(),
+			"locked_until": nil,
+			"available_at": retryAt,
+			"status": gorm0.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE ? END",
+				maxAttempts, domain.StatusFailed, domain.StatusPending),
+		}).Error
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to mark outbox event %d as failed", id), err)
+	}
+	return nil
+}
+
+// Requeue puts a parked event back to pending with its attempts reset, so it is
+// published again before the later events of its aggregate.
+func (r *repository) Requeue(ctx context.Context, id int64) error {
+	return r.resolveFailed(ctx, id, map[string]any{
+		"status":       domain.StatusPending,
+		"attempts":     0,
+		"available_at": time.Now().UTC(),
+	})
+}
+
+// Skip discards a parked event, unblocking the later events of its aggregate.
+func (r *repository) Skip(ctx context.Context, id int64) error {
+	return r.resolveFailed(ctx, id, map[string]any{"status": domain.StatusSkipped})
+}
+
+func (r *repository) resolveFailed(ctx context.Context, id int64, updates map[string]any) error {
+	res := r.db.Client().WithContext(ctx).
+		Model(&models.OutboxEvent{}).
+		Where("id = ? AND status = ?", id, domain.StatusFailed).
+		Updates(updates)
+	if res.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to update outbox event %d", id), res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("outbox event %d is not parked as failed", id), nil)
+	}
+	return nil
+}
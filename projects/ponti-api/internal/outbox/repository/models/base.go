@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
+)
+
+// OutboxEvent is the GORM model for the transactional outbox.
+type OutboxEvent struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement;column:id"`
+	AggregateType string     `gorm:"size:50;not null;index:idx_outbox_aggregate,priority:1;column:aggregate_type"`
+	AggregateID   string     `gorm:"size:64;not null;index:idx_outbox_aggregate,priority:2;column:aggregate_id"`
+	EventType     string     `gorm:"size:100;not null;column:event_type"`
+	Payload       []byte     `gorm:"type:jsonb;not null;column:payload"`
+	Status        string     `gorm:"size:20;not null;default:pending;index;column:status"`
+	Attempts      int        `gorm:"not null;default:0;column:attempts"`
+	LastError     string     `gorm:"type:text;column:last_error"`
+	AvailableAt   time.Time  `gorm:"not null;column:available_at"`
+	LockedUntil   *time.Time `gorm:"column:locked_until"`
+	OccurredAt    time.Time  `gorm:"not null;column:occurred_at"`
+	PublishedAt   *time.Time `gorm:"column:published_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+func FromDomain(d *domain.Event) *OutboxEvent {
+	status := d.Status
+	if status == "" {
+		status = domain.StatusPending
+	}
+	return &OutboxEvent{
+		ID:            d.ID,
+		AggregateType: d.AggregateType,
+		AggregateID:   d.AggregateID,
+		EventType:     string(d.Type),
+		Payload:       d.Payload,
+		Status:        string(status),
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		AvailableAt:   d.OccurredAt,
+		OccurredAt:    d.OccurredAt,
+	}
+}
+
+func (m *OutboxEvent) ToDomain() *domain.Event {
+	d := &domain.Event{
+		ID:            m.ID,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		Type:          domain.EventType(m.EventType),
+		Payload:       m.Payload,
+		OccurredAt:    m.OccurredAt,
+		Status:        domain.Status(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+	}
+	if m.PublishedAt != nil {
+		d.PublishedAt = *m.PublishedAt
+	}
+	return d
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventProjectCreated               EventType = "ProjectCreated"
+	EventFieldUpdated                 EventType = "FieldUpdated"
+	EventLotCropChanged               EventType = "LotCropChanged"
+	EventInvestorContributionRecorded EventType = "InvestorContributionRecorded"
+)
+
+type Status string
+
+const (
+	StatusPending    Status = "pending"    // waiting to be published
+	StatusProcessing Status = "processing" // claimed by a relay
+	StatusPublished  Status = "published"  // acknowledged by the broker
+	StatusFailed     Status = "failed"     // gave up after max attempts; blocks its aggregate
+	StatusSkipped    Status = "skipped"    // discarded by an operator; unblocks its aggregate
+)
+
+type Event struct {
+	ID            int64
+	AggregateType string
+	AggregateID   string
+	Type          EventType
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+	Status        Status
+	Attempts      int
+	LastError     string
+	PublishedAt   time.Time
+}
+
+// Envelope is the message body delivered to the broker.
+type Envelope struct {
+	ID            int64           `json:"id"`
+	Type          EventType       `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEvent builds a pending event serializing the payload as JSON.
+func NewEvent(aggregateType string, aggregateID int64, eventType EventType, payload any) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+	return &Event{
+		AggregateType: aggregateType,
+		AggregateID:   strconv.FormatInt(aggregateID, 10),
+		Type:          eventType,
+		Payload:       data,
+		OccurredAt:    time.Now().UTC(),
+		Status:        StatusPending,
+	}, nil
+}
+
+// ToEnvelope returns the broker representation of the event.
+func (e *Event) ToEnvelope() Envelope {
+	return Envelope{
+		ID:            e.ID,
+		Type:          e.Type,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		OccurredAt:    e.OccurredAt,
+		Payload:       e.Payload,
+	}
+}
+
+// OrderingKey groups events that must be delivered in order.
+func (e *Event) OrderingKey() string {
+	return e.AggregateType + ":" + e.AggregateID
+}
+
+type ProjectCreated struct {
+	ProjectID   int64   `json:"project_id"`
+	Name        string  `json:"name"`
+	CustomerID  int64   `json:"customer_id"`
+	ManagerIDs  []int64 `json:"manager_ids"`
+	InvestorIDs []int64 `json:"investor_ids"`
+	FieldIDs    []int64 `json:"field_ids"`
+}
+
+type FieldUpdated struct {
+	FieldID     int64  `json:"field_id"`
+	Name        string `json:"name"`
+	LeaseTypeID int64  `json:"lease_type_id"`
+}
+
+type LotCropChanged struct {
+	LotID             int64  `json:"lot_id"`
+	FieldID           int64  `json:"field_id"`
+	Season            string `json:"season"`
+	OldPreviousCropID int64  `json:"old_previous_crop_id"`
+	OldCurrentCropID  int64  `json:"old_current_crop_id"`
+	NewPreviousCropID int64  `json:"new_previous_crop_id"`
+	NewCurrentCropID  int64  `json:"new_current_crop_id"`
+}
+
+type InvestorContributionRecorded struct {
+	InvestorID       int64     `json:"investor_id"`
+	FieldID          int64     `json:"field_id"`
+	Contributions    float64   `json:"contributions"`
+	ContributionDate time.Time `json:"contribution_date"`
+}
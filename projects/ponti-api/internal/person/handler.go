@@ -32,20 +32,9 @@ func (h *Handler) Routes() {
 
 	apiVersion := h.gsv.GetApiVersion()
 	apiBase := "/api/" + apiVersion + "/person"
-	publicPrefix := apiBase + "/public"
 	validatedPrefix := apiBase + "/validated"
 	protectedPrefix := apiBase + "/protected"
 
-	// Rutas públicas
-	public := router.Group(publicPrefix)
-	{
-		public.POST("", h.CreatePerson)
-		public.GET("", h.ListPersons)
-		public.GET("/:id", h.GetPerson)
-		public.PUT("/:id", h.UpdatePerson)
-		public.DELETE("/:id", h.DeletePerson)
-	}
-
 	validated := router.Group(validatedPrefix)
 	{
 		// Aplicar middleware de validación de credenciales
@@ -60,13 +49,16 @@ func (h *Handler) Routes() {
 
 		protected.GET("/ping", h.ProtectedPing)
 
-		protected.POST("", h.CreatePerson)
-		protected.GET("", h.ListPersons)
-		protected.GET("/:id", h.GetPerson)
-		protected.PUT("/:id", h.UpdatePerson)
-		protected.DELETE("/:id", h.DeletePerson)
-
+		// Las personas pertenecen a una organización.
+		scoped := protected.Group("", h.mws.Tenant...)
+		scoped.Group("", h.mws.Idempotent...).POST("", h.CreatePerson)
+		scoped.GET("", h.ListPersons)
+		scoped.GET("/:id", h.GetPerson)
+		scoped.PUT("/:id", h.UpdatePerson)
+		scoped.DELETE("/:id", h.DeletePerson)
 	}
+
+	h.describeRoutes()
 }
 
 func (h *Handler) ProtectedPing(c *gin.Context) {
@@ -78,16 +70,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreatePerson(c *gin.Context) {
 	var req dto.CreatePerson
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newPersonID, err := h.ucs.CreatePerson(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -107,8 +97,7 @@ func (h *Handler) DeletePerson(c *gin.Context) {
 	// Llamar al caso de uso con la información
 	err := h.ucs.DeletePerson(c.Request.Context(), id, hardDelete)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -128,16 +117,14 @@ func (h *Handler) UpdatePerson(c *gin.Context) {
 	// Validamos el JSON de la solicitud en un DTO de actualización
 	var req dto.UpdatePerson
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	ctx := c.Request.Context()
 	if err := h.ucs.UpdatePerson(ctx, id, req.ToDomain()); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -151,8 +138,7 @@ func (h *Handler) GetPerson(c *gin.Context) {
 
 	person, err := h.ucs.GetPerson(c.Request.Context(), id)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -162,8 +148,7 @@ func (h *Handler) GetPerson(c *gin.Context) {
 func (h *Handler) ListPersons(c *gin.Context) {
 	persons, err := h.ucs.ListPersons(c.Request.Context())
 	if err != nil {
-		apiErr, code := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(code)
+		c.Error(err)
 		return
 	}
 
@@ -0,0 +1,49 @@
+package person
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "string", "Person ID")
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreatePerson, pkgswagger.Operation{
+		Summary:   "Create a person",
+		Request:   dto.CreatePerson{},
+		Responses: map[int]any{http.StatusCreated: dto.CreatePersonResponse{}},
+	})
+	pkgswagger.Describe(h.ListPersons, pkgswagger.Operation{
+		Summary:   "List persons",
+		Responses: map[int]any{http.StatusOK: []domain.Person{}},
+	})
+	pkgswagger.Describe(h.GetPerson, pkgswagger.Operation{
+		Summary:    "Get a person",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.Person{}},
+	})
+	pkgswagger.Describe(h.UpdatePerson, pkgswagger.Operation{
+		Summary:    "Update a person",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdatePerson{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeletePerson, pkgswagger.Operation{
+		Summary: "Delete a person",
+		Parameters: []pkgswagger.Parameter{
+			id,
+			pkgswagger.QueryParam("hardDelete", "boolean", "Remove the record instead of soft-deleting it", false),
+		},
+		Responses: map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
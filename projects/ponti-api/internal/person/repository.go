@@ -10,6 +10,7 @@ import (
 	"github.com/lib/pq"
 
 	pgdb "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/postgresql/pgxpool"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/repository/models"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/usecases/domain"
@@ -27,7 +28,7 @@ func NewPostgresRepository(r pgdb.Repository) Repository {
 
 func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Person) (string, error) {
 	if person == nil {
-		return "", errors.New("person is nil")
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "person is nil", nil)
 	}
 
 	// Convertir de domain.Person a models.Person.
@@ -35,6 +36,10 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 	if err != nil {
 		return "", err
 	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
 	// Generar un nuevo ID.
 	model.ID = uuid.New().String()
 
@@ -50,6 +55,7 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 			interests,
 			hobbies,
 			deleted,
+			tenant_id,
 			created_at,
 			updated_at,
 			deleted_at
@@ -64,6 +70,7 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 			$8,  -- interests
 			$9, -- hobbies
 			$10, -- deleted
+			$11, -- tenant_id
 			NOW(),
 			NOW(),
 			NULL
@@ -81,11 +88,12 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 		model.Interests,
 		model.Hobbies,
 		model.Deleted,
+		tenantID,
 	)
 	if err != nil {
 		// Verificar si se trata de una violación de restricción única.
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			return "", errors.New("person already exists")
+			return "", pkgtypes.NewError(pkgtypes.ErrConflict, "person already exists", err)
 		}
 		return "", fmt.Errorf("error creating person: %w", err)
 	}
@@ -94,6 +102,10 @@ func (r *postgresRepository) CreatePerson(ctx context.Context, person *domain.Pe
 }
 
 func (r *postgresRepository) ListPersons(ctx context.Context) ([]domain.Person, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `
 		SELECT
 			id,
@@ -110,9 +122,10 @@ func (r *postgresRepository) ListPersons(ctx context.Context) ([]domain.Person,
 			updated_at,
 			deleted_at
 		FROM people
+		WHERE tenant_id = $1
 		`
 
-	rows, err := r.postgresRepository.Pool().Query(ctx, query)
+	rows, err := r.postgresRepository.Pool().Query(ctx, query, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("error querying people: %w", err)
 	}
@@ -154,6 +167,10 @@ func (r *postgresRepository) ListPersons(ctx context.Context) ([]domain.Person,
 }
 
 func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.Person, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `
 		SELECT
 			id,
@@ -170,11 +187,11 @@ func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.
 			updated_at,
 			deleted_at
 		FROM people
-		WHERE id = $1
+		WHERE id = $1 AND tenant_id = $2
 		`
 
 	var pm models.Person
-	err := r.postgresRepository.Pool().QueryRow(ctx, query, id).Scan(
+	err = r.postgresRepository.Pool().QueryRow(ctx, query, id, tenantID).Scan(
 		&pm.ID,
 		&pm.FirstName,
 		&pm.LastName,
@@ -191,7 +208,7 @@ func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("person not found")
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("person with id %s not found", id), err)
 		}
 		if pqErr, ok := err.(*pq.Error); ok {
 			return nil, fmt.Errorf("database error: %w", pqErr)
@@ -208,6 +225,10 @@ func (r *postgresRepository) GetPerson(ctx context.Context, id string) (*domain.
 }
 
 func (r *postgresRepository) UpdatePerson(ctx context.Context, ID string, person *domain.Person) error {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
 	query := `
 		UPDATE people
 		SET
@@ -221,7 +242,7 @@ func (r *postgresRepository) UpdatePerson(ctx context.Context, ID string, person
 			hobbies = $8,
 			deleted = $9,
 			updated_at = NOW()
-		WHERE id = $10
+		WHERE id = $10 AND tenant_id = $11
 		`
 
 	result, err := r.postgresRepository.Pool().Exec(ctx, query,
@@ -235,6 +256,7 @@ func (r *postgresRepository) UpdatePerson(ctx context.Context, ID string, person
 		person.Hobbies,
 		person.Deleted,
 		ID,
+		tenantID,
 	)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
@@ -244,19 +266,23 @@ func (r *postgresRepository) UpdatePerson(ctx context.Context, ID string, person
 	}
 
 	if result.RowsAffected() == 0 {
-		return errors.New("person not found")
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, "person not found", nil)
 	}
 
 	return nil
 }
 
 func (r *postgresRepository) DeletePerson(ctx context.Context, id string, hardDelete bool) error {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
 	if hardDelete {
 		query := `
 			DELETE FROM people
-			WHERE id = $1
+			WHERE id = $1 AND tenant_id = $2
 			`
-		result, err := r.postgresRepository.Pool().Exec(ctx, query, id)
+		result, err := r.postgresRepository.Pool().Exec(ctx, query, id, tenantID)
 		if err != nil {
 			if pqErr, ok := err.(*pq.Error); ok {
 				return fmt.Errorf("database error: %w", pqErr)
@@ -265,7 +291,7 @@ func (r *postgresRepository) DeletePerson(ctx context.Context, id string, hardDe
 		}
 
 		if result.RowsAffected() == 0 {
-			return errors.New("person not found")
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, "person not found", nil)
 		}
 
 		return nil
@@ -274,9 +300,9 @@ func (r *postgresRepository) DeletePerson(ctx context.Context, id string, hardDe
 	query := `
 		UPDATE people
 		SET deleted = true, deleted_at = NOW()
-		WHERE id = $1
+		WHERE id = $1 AND tenant_id = $2
 		`
-	result, err := r.postgresRepository.Pool().Exec(ctx, query, id)
+	result, err := r.postgresRepository.Pool().Exec(ctx, query, id, tenantID)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			return fmt.Errorf("database error: %w", pqErr)
@@ -285,8 +311,19 @@ func (r *postgresRepository) DeletePerson(ctx context.Context, id string, hardDe
 	}
 
 	if result.RowsAffected() == 0 {
-		return errors.New("person not found")
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, "person not found", nil)
 	}
 
 	return nil
 }
+
+// tenantFromContext devuelve la organización de la request. Las personas se
+// consultan con SQL crudo, sin los callbacks de tenant de GORM, así que cada
+// consulta filtra por tenant_id explícitamente.
+func tenantFromContext(ctx context.Context) (int64, error) {
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return 0, pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required", nil)
+	}
+	return tenantID, nil
+}
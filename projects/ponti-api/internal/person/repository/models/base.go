@@ -7,10 +7,13 @@ import (
 	"github.com/lib/pq" // Para manejar arrays de texto en PostgreSQL.
 	"gorm.io/gorm"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person/usecases/domain"
 )
 
 type Person struct {
+	pkggorm.TenantScoped
+
 	ID         string         `gorm:"primaryKey;column:id"`
 	FirstName  string         `gorm:"column:first_name"`
 	LastName   string         `gorm:"column:last_name"`
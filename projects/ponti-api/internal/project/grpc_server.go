@@ -0,0 +1,211 @@
+package project
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	customerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
+	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
+	fieldpb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/grpc/pb"
+	investordom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/usecases/domain"
+	managerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/usecases/domain"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/grpc/pb"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+)
+
+// GrpcServer expone los casos de uso de proyectos como ProjectService. Las
+// llamadas no llevan usuario, así que no se filtran por membresía; el tenant
+// llega como metadata. Los errores del dominio los traduce a status el
+// interceptor del servidor.
+type GrpcServer struct {
+	pb.UnimplementedProjectServiceServer
+	ucs UseCases
+}
+
+// NewGrpcServer crea el servidor gRPC de proyectos.
+func NewGrpcServer(ucs UseCases) *GrpcServer {
+	return &GrpcServer{ucs: ucs}
+}
+
+// Register registra ProjectService en el servidor gRPC.
+func (s *GrpcServer) Register(ctx context.Context, server pkggrpcserver.Server) {
+	server.RegisterService(ctx, &pb.ProjectService_ServiceDesc, s)
+}
+
+func (s *GrpcServer) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	if req.GetProject() == nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "project is required", nil)
+	}
+	project := fromProtoProject(req.GetProject())
+	project.ID = 0
+	id, err := s.ucs.CreateProject(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateProjectResponse{Id: id}, nil
+}
+
+func (s *GrpcServer) ListProjects(ctx context.Context, _ *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	projects, err := s.ucs.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoProjects(projects), nil
+}
+
+func (s *GrpcServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	project, err := s.ucs.GetProject(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProjectResponse{Project: toProtoProject(project)}, nil
+}
+
+func (s *GrpcServer) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
+	if req.GetProject().GetId() == 0 {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "project id is required", nil)
+	}
+	if err := s.ucs.UpdateProject(ctx, fromProtoProject(req.GetProject())); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateProjectResponse{}, nil
+}
+
+func (s *GrpcServer) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	if err := s.ucs.DeleteProject(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+func (s *GrpcServer) ListProjectsByCustomerID(ctx context.Context, req *pb.ListProjectsByCustomerIDRequest) (*pb.ListProjectsResponse, error) {
+	projects, err := s.ucs.ListProjectsByCustomerID(ctx, req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoProjects(projects), nil
+}
+
+func (s *GrpcServer) ListMembers(ctx context.Context, req *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	members, err := s.ucs.ListMembers(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListMembersResponse{Members: make([]*pb.Member, 0, len(members))}
+	for _, m := range members {
+		member := &pb.Member{ProjectId: m.ProjectID, UserId: m.UserID, Role: string(m.Role)}
+		if !m.CreatedAt.IsZero() {
+			member.CreatedAt = timestamppb.New(m.CreatedAt)
+		}
+		res.Members = append(res.Members, member)
+	}
+	return res, nil
+}
+
+func (s *GrpcServer) AddMember(ctx context.Context, req *pb.AddMemberRequest) (*pb.AddMemberResponse, error) {
+	m := req.GetMember()
+	if err := s.ucs.AddMember(ctx, &domain.Member{
+		ProjectID: m.GetProjectId(),
+		UserID:    m.GetUserId(),
+		Role:      domain.MemberRole(m.GetRole()),
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.AddMemberResponse{}, nil
+}
+
+func (s *GrpcServer) RemoveMember(ctx context.Context, req *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
+	if err := s.ucs.RemoveMember(ctx, req.GetProjectId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveMemberResponse{}, nil
+}
+
+func (s *GrpcServer) InviteMember(ctx context.Context, req *pb.InviteMemberRequest) (*pb.InviteMemberResponse, error) {
+	id, err := s.ucs.InviteMember(ctx, req.GetProjectId(), req.GetEmail(), domain.MemberRole(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InviteMemberResponse{InvitationId: id}, nil
+}
+
+func (s *GrpcServer) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+	if err := s.ucs.AcceptInvitation(ctx, req.GetToken(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+	return &pb.AcceptInvitationResponse{}, nil
+}
+
+func toProtoProjects(projects []domain.Project) *pb.ListProjectsResponse {
+	res := &pb.ListProjectsResponse{Projects: make([]*pb.Project, 0, len(projects))}
+	for i := range projects {
+		res.Projects = append(res.Projects, toProtoProject(&projects[i]))
+	}
+	return res
+}
+
+func toProtoProject(p *domain.Project) *pb.Project {
+	project := &pb.Project{
+		Id:        p.ID,
+		Name:      p.Name,
+		Customer:  &pb.Customer{Id: p.Customer.ID, Name: p.Customer.Name, Type: p.Customer.Type},
+		Managers:  make([]*pb.Manager, 0, len(p.Managers)),
+		Investors: make([]*pb.Investor, 0, len(p.Investors)),
+		Fields:    make([]*fieldpb.Field, 0, len(p.Fields)),
+	}
+	for _, m := range p.Managers {
+		project.Managers = append(project.Managers, &pb.Manager{Id: m.ID, Name: m.Name, Type: m.Type})
+	}
+	for _, inv := range p.Investors {
+		investor := &pb.Investor{
+			Id:            inv.ID,
+			Name:          inv.Name,
+			FieldId:       inv.FieldID,
+			Contributions: inv.Contributions,
+			Percentage:    int32(inv.Percentage),
+		}
+		if !inv.ContributionDate.IsZero() {
+			investor.ContributionDate = timestamppb.New(inv.ContributionDate)
+		}
+		project.Investors = append(project.Investors, investor)
+	}
+	for i := range p.Fields {
+		project.Fields = append(project.Fields, field.ToProto(&p.Fields[i]))
+	}
+	return project
+}
+
+func fromProtoProject(p *pb.Project) *domain.Project {
+	project := &domain.Project{
+		ID:   p.GetId(),
+		Name: p.GetName(),
+		Customer: customerdom.Customer{
+			ID:   p.GetCustomer().GetId(),
+			Name: p.GetCustomer().GetName(),
+			Type: p.GetCustomer().GetType(),
+		},
+	}
+	for _, m := range p.GetManagers() {
+		project.Managers = append(project.Managers, managerdom.Manager{ID: m.GetId(), Name: m.GetName(), Type: m.GetType()})
+	}
+	for _, inv := range p.GetInvestors() {
+		investor := investordom.Investor{
+			ID:            inv.GetId(),
+			Name:          inv.GetName(),
+			FieldID:       inv.GetFieldId(),
+			Contributions: inv.GetContributions(),
+			Percentage:    int(inv.GetPercentage()),
+		}
+		if inv.GetContributionDate() != nil {
+			investor.ContributionDate = inv.GetContributionDate().AsTime()
+		}
+		project.Investors = append(project.Investors, investor)
+	}
+	for _, f := range p.GetFields() {
+		project.Fields = append(project.Fields, *field.FromProto(f))
+	}
+	return project
+}
@@ -1,13 +1,16 @@
 package project
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
 	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
 	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
 	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
 	"github.com/gin-gonic/gin"
 )
 
@@ -29,27 +32,67 @@ func (h *Handler) Routes() {
 	apiV := h.gsv.GetApiVersion()
 	base := "/api/" + apiV + "/projects"
 
+	protected := r.Group(base + "/protected")
+	{
+		protected.Use(h.mws.Protected...)
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("project:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("project:write"))
+		write.Group("", h.mws.Idempotent...).POST("", h.CreateProject) // Create a project
+		read.GET("", h.ListProjects)                                   // List all projects
+		read.GET("/customer/:id", h.ListProjectsByCustomerID)          // List projects by customer ID
+		read.GET("/:id", h.GetProject)                                 // Get a project by ID
+		write.PUT("/:id", h.UpdateProject)                             // Update a project
+		write.DELETE("/:id", h.DeleteProject)                          // Delete a project
+
+		read.GET("/:id/members", h.ListMembers)                                       // List project members
+		write.PUT("/:id/members/:user_id", h.AddMember)                               // Add or change a member
+		write.DELETE("/:id/members/:user_id", h.RemoveMember)                         // Remove a member
+		write.Group("", h.mws.Idempotent...).POST("/:id/invitations", h.InviteMember) // Invite by email
+	}
+
 	public := r.Group(base + "/public")
 	{
-		public.POST("", h.CreateProject)                        // Create a project
-		public.GET("", h.ListProjects)                          // List all projects
-		public.GET("/customer/:id", h.ListProjectsByCustomerID) // List projects by customer ID
-		public.GET("/:id", h.GetProject)                        // Get a project by ID
-		public.PUT("/:id", h.UpdateProject)                     // Update a project
-		public.DELETE("/:id", h.DeleteProject)                  // Delete a project
+		public.POST("/invitations/accept", h.AcceptInvitation) // Accept an invitation
+	}
+
+	h.describeRoutes()
+}
+
+// ManagePermission lets the holder access every project of the organization
+// without being a member.
+const ManagePermission = "project:manage"
+
+// requestContext returns the request context, unrestricted by membership when
+// the caller holds ManagePermission.
+func (h *Handler) requestContext(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	if mdw.HasPermission(c.GetStringSlice(mdw.PermissionsContextKey), ManagePermission) {
+		ctx = withAllProjects(ctx)
+	}
+	return ctx
+}
+
+func projectID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid project id", err))
+		return 0, false
 	}
+	return id, true
 }
 
 // CreateProject handles project creation.
 func (h *Handler) CreateProject(c *gin.Context) {
 	var req dto.CreateProject
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: err.Error()})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	pID, err := h.ucs.CreateProject(c.Request.Context(), req.ToDomain())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusCreated, dto.CreateProjectResponse{Message: "created", ProjectID: pID})
@@ -59,18 +102,18 @@ func (h *Handler) CreateProject(c *gin.Context) {
 func (h *Handler) ListProjectsByCustomerID(c *gin.Context) {
 	idStr := c.Param("id")
 	if idStr == "" {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "missing customer id in path"})
+		c.Error(types.NewMissingFieldError("id"))
 		return
 	}
 	customerID, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid customer id"})
+		c.Error(types.NewInvalidIDError("invalid customer id", err))
 		return
 	}
 
-	projects, err := h.ucs.ListProjectsByCustomerID(c.Request.Context(), customerID)
+	projects, err := h.ucs.ListProjectsByCustomerID(h.requestContext(c), customerID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	out := make([]dto.Project, 0, len(projects))
@@ -82,9 +125,9 @@ func (h *Handler) ListProjectsByCustomerID(c *gin.Context) {
 
 // ListProjects returns all projects.
 func (h *Handler) ListProjects(c *gin.Context) {
-	projects, err := h.ucs.ListProjects(c.Request.Context())
+	projects, err := h.ucs.ListProjects(h.requestContext(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	out := make([]dto.Project, 0, len(projects))
@@ -99,12 +142,12 @@ func (h *Handler) GetProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid project id"})
+		c.Error(types.NewInvalidIDError("invalid project id", err))
 		return
 	}
-	proj, err := h.ucs.GetProject(c.Request.Context(), id)
+	proj, err := h.ucs.GetProject(h.requestContext(c), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, types.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, dto.FromDomain(proj))
@@ -115,18 +158,18 @@ func (h *Handler) UpdateProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid project id"})
+		c.Error(types.NewInvalidIDError("invalid project id", err))
 		return
 	}
 	var req dto.UpdateProject
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: err.Error()})
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 	dom := req.ToDomain()
 	dom.ID = id
-	if err := h.ucs.UpdateProject(c.Request.Context(), dom); err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+	if err := h.ucs.UpdateProject(h.requestContext(c), dom); err != nil {
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "updated"})
@@ -137,12 +180,98 @@ func (h *Handler) DeleteProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "invalid project id"})
+		c.Error(types.NewInvalidIDError("invalid project id", err))
 		return
 	}
-	if err := h.ucs.DeleteProject(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: err.Error()})
+	if err := h.ucs.DeleteProject(h.requestContext(c), id); err != nil {
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, types.MessageResponse{Message: "deleted"})
 }
+
+// ListMembers returns the members of a project.
+func (h *Handler) ListMembers(c *gin.Context) {
+	id, ok := projectID(c)
+	if !ok {
+		return
+	}
+	members, err := h.ucs.ListMembers(h.requestContext(c), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	out := make([]dto.Member, 0, len(members))
+	for _, m := range members {
+		out = append(out, dto.MemberFromDomain(&m))
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// AddMember grants a user access to a project or changes its role.
+func (h *Handler) AddMember(c *gin.Context) {
+	id, ok := projectID(c)
+	if !ok {
+		return
+	}
+	var req dto.AddMember
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := h.ucs.AddMember(h.requestContext(c), &domain.Member{
+		ProjectID: id,
+		UserID:    c.Param("user_id"),
+		Role:      domain.MemberRole(req.Role),
+	}); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "member saved"})
+}
+
+// RemoveMember revokes a user's access to a project.
+func (h *Handler) RemoveMember(c *gin.Context) {
+	id, ok := projectID(c)
+	if !ok {
+		return
+	}
+	if err := h.ucs.RemoveMember(h.requestContext(c), id, c.Param("user_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "member removed"})
+}
+
+// InviteMember emails an invitation to join a project.
+func (h *Handler) InviteMember(c *gin.Context) {
+	id, ok := projectID(c)
+	if !ok {
+		return
+	}
+	var req dto.InviteMember
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	invitationID, err := h.ucs.InviteMember(h.requestContext(c), id, req.Email, domain.MemberRole(req.Role))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.InviteMemberResponse{Message: "invitation sent", InvitationID: invitationID})
+}
+
+// AcceptInvitation redeems an invitation token.
+func (h *Handler) AcceptInvitation(c *gin.Context) {
+	var req dto.AcceptInvitation
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := h.ucs.AcceptInvitation(c.Request.Context(), req.Token, req.Password); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "invitation accepted"})
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+)
+
+// AddMember DTO for granting a user access to a project.
+type AddMember struct {
+	Role string `json:"role" binding:"required,oneof=owner agronomist investor-viewer"`
+}
+
+// InviteMember DTO for inviting an email to a project.
+type InviteMember struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,oneof=owner agronomist investor-viewer"`
+}
+
+// AcceptInvitation DTO; the password is only required when the user does not exist yet.
+type AcceptInvitation struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password"`
+}
+
+// Member DTO for responses.
+type Member struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func MemberFromDomain(m *domain.Member) Member {
+	return Member{
+		UserID:    m.UserID,
+		Role:      string(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// Response
+type InviteMemberResponse struct {
+	Message      string `json:"message"`
+	InvitationID int64  `json:"invitation_id"`
+}
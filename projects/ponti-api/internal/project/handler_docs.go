@@ -0,0 +1,75 @@
+package project
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Project ID")
+	userID := pkgswagger.PathParam("user_id", "string", "User ID")
+
+	pkgswagger.Describe(h.CreateProject, pkgswagger.Operation{
+		Summary:   "Create a project",
+		Request:   dto.CreateProject{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateProjectResponse{}},
+	})
+	pkgswagger.Describe(h.ListProjects, pkgswagger.Operation{
+		Summary:   "List the projects visible to the current user",
+		Responses: map[int]any{http.StatusOK: []dto.Project{}},
+	})
+	pkgswagger.Describe(h.ListProjectsByCustomerID, pkgswagger.Operation{
+		Summary:    "List the projects of a customer",
+		Parameters: []pkgswagger.Parameter{pkgswagger.PathParam("id", "integer", "Customer ID")},
+		Responses:  map[int]any{http.StatusOK: []dto.Project{}},
+	})
+	pkgswagger.Describe(h.GetProject, pkgswagger.Operation{
+		Summary:    "Get a project",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: dto.Project{}},
+	})
+	pkgswagger.Describe(h.UpdateProject, pkgswagger.Operation{
+		Summary:    "Update a project",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdateProject{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteProject, pkgswagger.Operation{
+		Summary:    "Delete a project",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ListMembers, pkgswagger.Operation{
+		Summary:    "List project members",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: []dto.Member{}},
+	})
+	pkgswagger.Describe(h.AddMember, pkgswagger.Operation{
+		Summary:    "Add a member or change their role",
+		Parameters: []pkgswagger.Parameter{id, userID},
+		Request:    dto.AddMember{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.RemoveMember, pkgswagger.Operation{
+		Summary:    "Remove a member",
+		Parameters: []pkgswagger.Parameter{id, userID},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.InviteMember, pkgswagger.Operation{
+		Summary:    "Invite a member by email",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.InviteMember{},
+		Responses:  map[int]any{http.StatusCreated: dto.InviteMemberResponse{}},
+	})
+	pkgswagger.Describe(h.AcceptInvitation, pkgswagger.Operation{
+		Summary:     "Accept a project invitation",
+		Description: "Creates the user with the given password when the email is not registered.",
+		Request:     dto.AcceptInvitation{},
+		Responses:   map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
@@ -0,0 +1,260 @@
+package project
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgutils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	orgdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+type allProjectsKey struct{}
+
+// withAllProjects marks the context so membership checks are skipped. The handler
+// uses it for callers holding ManagePermission.
+func withAllProjects(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allProjectsKey{}, true)
+}
+
+// membershipScope returns the user whose memberships restrict access, or false
+// when the caller may see every project of the organization (internal calls or
+// callers marked with withAllProjects).
+func membershipScope(ctx context.Context) (string, bool) {
+	if all, _ := ctx.Value(allProjectsKey{}).(bool); all {
+		return "", false
+	}
+	return pkgtypes.UserIDFromContext(ctx)
+}
+
+// requireRole fails unless the caller is a member of the project with one of the
+// given roles (any role when none is given).
+func (u *useCases) requireRole(ctx context.Context, projectID int64, roles ...domain.MemberRole) error {
+	userID, scoped := membershipScope(ctx)
+	if !scoped {
+		return nil
+	}
+	member, err := u.repo.GetMember(ctx, projectID, userID)
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return pkgtypes.NewError(pkgtypes.ErrAuthorization, fmt.Sprintf("you are not a member of project %d", projectID), nil)
+		}
+		return err
+	}
+	if len(roles) > 0 && !slices.Contains(roles, member.Role) {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, fmt.Sprintf("role %s cannot perform this action on project %d", member.Role, projectID), nil)
+	}
+	return nil
+}
+
+func (u *useCases) ListMembers(ctx context.Context, projectID int64) ([]domain.Member, error) {
+	if err := u.requireRole(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return u.repo.ListMembers(ctx, projectID)
+}
+
+func (u *useCases) AddMember(ctx context.Context, member *domain.Member) error {
+	if member == nil || member.UserID == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "user_id is required", nil)
+	}
+	if !member.Role.Valid() {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("invalid project role %q", member.Role), nil)
+	}
+	if err := u.requireRole(ctx, member.ProjectID, domain.RoleOwner); err != nil {
+		return err
+	}
+	if err := u.ensureTenantUser(ctx, member.UserID); err != nil {
+		return err
+	}
+	if member.Role != domain.RoleOwner {
+		if err := u.ensureAnotherOwner(ctx, member.ProjectID, member.UserID); err != nil {
+			return err
+		}
+	}
+	return u.repo.AddMember(ctx, member)
+}
+
+// ensureTenantUser fails unless userID is a user of the caller's organization.
+// Users of other organizations are reported as not found, so their IDs cannot be probed.
+func (u *useCases) ensureTenantUser(ctx context.Context, userID string) error {
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required to add members", nil)
+	}
+	user, err := u.users.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	organizationID := user.OrganizationID
+	if organizationID == 0 {
+		organizationID = orgdom.DefaultOrganizationID
+	}
+	if organizationID != tenantID {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", userID), nil)
+	}
+	return nil
+}
+
+func (u *useCases) RemoveMember(ctx context.Context, projectID int64, userID string) error {
+	if err := u.requireRole(ctx, projectID, domain.RoleOwner); err != nil {
+		return err
+	}
+	if err := u.ensureAnotherOwner(ctx, projectID, userID); err != nil {
+		return err
+	}
+	return u.repo.RemoveMember(ctx, projectID, userID)
+}
+
+// ensureAnotherOwner prevents leaving a project without owners when userID loses
+// its owner role.
+func (u *useCases) ensureAnotherOwner(ctx context.Context, projectID int64, userID string) error {
+	members, err := u.repo.ListMembers(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	isOwner, owners := false, 0
+	for _, m := range members {
+		if m.Role == domain.RoleOwner {
+			owners++
+			isOwner = isOwner || m.UserID == userID
+		}
+	}
+	if isOwner && owners == 1 {
+		return pkgtypes.NewError(pkgtypes.ErrConflict, "a project must keep at least one owner", nil)
+	}
+	return nil
+}
+
+// InviteMember emails an invitation link to join the project. Only the hash of
+// the token is stored.
+func (u *useCases) InviteMember(ctx context.Context, projectID int64, email string, role domain.MemberRole) (int64, error) {
+	email = strings.TrimSpace(email)
+	if err := pkgutils.ValidateEmail(email); err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, err.Error(), nil)
+	}
+	if !role.Valid() {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("invalid project role %q", role), nil)
+	}
+	if u.mailer == nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrUnavailable, "invitations are not configured", nil)
+	}
+	if err := u.requireRole(ctx, projectID, domain.RoleOwner); err != nil {
+		return 0, err
+	}
+	proj, err := u.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate invitation token", err)
+	}
+	token := hex.EncodeToString(raw)
+	invitedBy, _ := pkgtypes.UserIDFromContext(ctx)
+	id, err := u.repo.CreateInvitation(ctx, &domain.Invitation{
+		ProjectID: projectID,
+		Email:     email,
+		Role:      role,
+		InvitedBy: invitedBy,
+		ExpiresAt: time.Now().Add(u.options.InvitationTTL),
+	}, hashInvitationToken(token))
+	if err != nil {
+		return 0, err
+	}
+
+	separator := "?"
+	if strings.Contains(u.options.InvitationURL, "?") {
+		separator = "&"
+	}
+	link := u.options.InvitationURL + separator + "token=" + url.QueryEscape(token)
+	body := fmt.Sprintf(
+		"Hola,\n\nTe invitaron a participar del proyecto %q como %s. Para aceptar ingresá al siguiente link:\n\n%s\n\nLa invitación vence en %s.\n",
+		proj.Name, role, link, u.options.InvitationTTL,
+	)
+	if err := u.mailer.SendEmail(ctx, email, "Invitación al proyecto "+proj.Name, body); err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrUnavailable, "failed to send invitation email", err)
+	}
+	return id, nil
+}
+
+// AcceptInvitation redeems an invitation token. If no user exists for the invited
+// email one is created in the project's organization with the given password.
+func (u *useCases) AcceptInvitation(ctx context.Context, token, password string) error {
+	if token == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "token is required", nil)
+	}
+	inv, err := u.repo.GetInvitationByToken(ctx, hashInvitationToken(token))
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return pkgtypes.NewError(pkgtypes.ErrValidation, "invalid invitation token", nil)
+		}
+		return err
+	}
+	if inv.AcceptedAt != nil {
+		return pkgtypes.NewError(pkgtypes.ErrConflict, "invitation has already been accepted", nil)
+	}
+	if time.Now().After(inv.ExpiresAt) {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "invitation has expired", nil)
+	}
+
+	// The invitee is not authenticated: everything below runs in the project's organization.
+	ctx = pkgtypes.WithTenantID(ctx, inv.TenantID)
+
+	userID, err := u.invitedUser(ctx, inv, password)
+	if err != nil {
+		return err
+	}
+	return u.repo.AcceptInvitation(ctx, inv.ID, &domain.Member{
+		ProjectID: inv.ProjectID,
+		UserID:    userID,
+		Role:      inv.Role,
+	})
+}
+
+// invitedUser returns the user of the invited email, creating it if needed.
+func (u *useCases) invitedUser(ctx context.Context, inv *domain.Invitation, password string) (string, error) {
+	existing, err := u.users.GetUserByEmail(ctx, inv.Email)
+	if err == nil {
+		organizationID := existing.OrganizationID
+		if organizationID == 0 {
+			organizationID = orgdom.DefaultOrganizationID
+		}
+		if organizationID != inv.TenantID {
+			return "", pkgtypes.NewError(pkgtypes.ErrConflict, "user belongs to another organization", nil)
+		}
+		return existing.ID, nil
+	}
+	if !pkgtypes.IsNotFound(err) {
+		return "", err
+	}
+
+	if password == "" {
+		return "", pkgtypes.NewErrorWithContext(pkgtypes.ErrValidation, "password is required to create the account", nil,
+			map[string]any{"field": "password"})
+	}
+	if err := pkgutils.ValidatePasswordComplexity(password); err != nil {
+		return "", pkgtypes.NewErrorWithContext(pkgtypes.ErrValidation, err.Error(), nil,
+			map[string]any{"field": "password"})
+	}
+	return u.users.CreateUser(ctx, &userdom.User{
+		Credentials:    userdom.Credentials{Email: inv.Email, Password: password},
+		OrganizationID: inv.TenantID,
+		UserType:       userdom.UserTypePerson,
+	})
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
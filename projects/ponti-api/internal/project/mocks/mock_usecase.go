@@ -35,6 +35,34 @@ func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockUseCases) AcceptInvitation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockUseCasesMockRecorder) AcceptInvitation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockUseCases)(nil).AcceptInvitation), arg0, arg1, arg2)
+}
+
+// AddMember mocks base method.
+func (m *MockUseCases) AddMember(arg0 context.Context, arg1 *domain.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockUseCasesMockRecorder) AddMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockUseCases)(nil).AddMember), arg0, arg1)
+}
+
 // CreateProject mocks base method.
 func (m *MockUseCases) CreateProject(arg0 context.Context, arg1 *domain.Project) (int64, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +107,36 @@ func (mr *MockUseCasesMockRecorder) GetProject(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockUseCases)(nil).GetProject), arg0, arg1)
 }
 
+// InviteMember mocks base method.
+func (m *MockUseCases) InviteMember(arg0 context.Context, arg1 int64, arg2 string, arg3 domain.MemberRole) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteMember", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteMember indicates an expected call of InviteMember.
+func (mr *MockUseCasesMockRecorder) InviteMember(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteMember", reflect.TypeOf((*MockUseCases)(nil).InviteMember), arg0, arg1, arg2, arg3)
+}
+
+// ListMembers mocks base method.
+func (m *MockUseCases) ListMembers(arg0 context.Context, arg1 int64) ([]domain.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockUseCasesMockRecorder) ListMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockUseCases)(nil).ListMembers), arg0, arg1)
+}
+
 // ListProjects mocks base method.
 func (m *MockUseCases) ListProjects(arg0 context.Context) ([]domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +167,20 @@ func (mr *MockUseCasesMockRecorder) ListProjectsByCustomerID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByCustomerID", reflect.TypeOf((*MockUseCases)(nil).ListProjectsByCustomerID), arg0, arg1)
 }
 
+// RemoveMember mocks base method.
+func (m *MockUseCases) RemoveMember(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockUseCasesMockRecorder) RemoveMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockUseCases)(nil).RemoveMember), arg0, arg1, arg2)
+}
+
 // UpdateProject mocks base method.
 func (m *MockUseCases) UpdateProject(arg0 context.Context, arg1 *domain.Project) error {
 	m.ctrl.T.Helper()
@@ -146,6 +218,49 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockRepository) AcceptInvitation(arg0 context.Context, arg1 int64, arg2 *domain.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockRepositoryMockRecorder) AcceptInvitation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockRepository)(nil).AcceptInvitation), arg0, arg1, arg2)
+}
+
+// AddMember mocks base method.
+func (m *MockRepository) AddMember(arg0 context.Context, arg1 *domain.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockRepositoryMockRecorder) AddMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockRepository)(nil).AddMember), arg0, arg1)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockRepository) CreateInvitation(arg0 context.Context, arg1 *domain.Invitation, arg2 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockRepositoryMockRecorder) CreateInvitation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockRepository)(nil).CreateInvitation), arg0, arg1, arg2)
+}
+
 // CreateProject mocks base method.
 func (m *MockRepository) CreateProject(arg0 context.Context, arg1 *domain.Project) (int64, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +290,36 @@ func (mr *MockRepositoryMockRecorder) DeleteProject(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockRepository)(nil).DeleteProject), arg0, arg1)
 }
 
+// GetInvitationByToken mocks base method.
+func (m *MockRepository) GetInvitationByToken(arg0 context.Context, arg1 string) (*domain.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationByToken", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitationByToken indicates an expected call of GetInvitationByToken.
+func (mr *MockRepositoryMockRecorder) GetInvitationByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationByToken", reflect.TypeOf((*MockRepository)(nil).GetInvitationByToken), arg0, arg1)
+}
+
+// GetMember mocks base method.
+func (m *MockRepository) GetMember(arg0 context.Context, arg1 int64, arg2 string) (*domain.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockRepositoryMockRecorder) GetMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockRepository)(nil).GetMember), arg0, arg1, arg2)
+}
+
 // GetProject mocks base method.
 func (m *MockRepository) GetProject(arg0 context.Context, arg1 int64) (*domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +335,21 @@ func (mr *MockRepositoryMockRecorder) GetProject(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockRepository)(nil).GetProject), arg0, arg1)
 }
 
+// ListMembers mocks base method.
+func (m *MockRepository) ListMembers(arg0 context.Context, arg1 int64) ([]domain.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockRepositoryMockRecorder) ListMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockRepository)(nil).ListMembers), arg0, arg1)
+}
+
 // ListProjects mocks base method.
 func (m *MockRepository) ListProjects(arg0 context.Context) ([]domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +380,35 @@ func (mr *MockRepositoryMockRecorder) ListProjectsByCustomerID(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByCustomerID", reflect.TypeOf((*MockRepository)(nil).ListProjectsByCustomerID), arg0, arg1)
 }
 
+// ListProjectsByMember mocks base method.
+func (m *MockRepository) ListProjectsByMember(arg0 context.Context, arg1 string) ([]domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsByMember", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsByMember indicates an expected call of ListProjectsByMember.
+func (mr *MockRepositoryMockRecorder) ListProjectsByMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByMember", reflect.TypeOf((*MockRepository)(nil).ListProjectsByMember), arg0, arg1)
+}
+
+// RemoveMember mocks base method.
+func (m *MockRepository) RemoveMember(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockRepositoryMockRecorder) RemoveMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockRepository)(nil).RemoveMember), arg0, arg1, arg2)
+}
+
 // UpdateProject mocks base method.
 func (m *MockRepository) UpdateProject(arg0 context.Context, arg1 *domain.Project) error {
 	m.ctrl.T.Helper()
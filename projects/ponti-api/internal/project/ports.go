@@ -13,6 +13,14 @@ type UseCases interface {
 	UpdateProject(context.Context, *domain.Project) error
 	DeleteProject(context.Context, int64) error
 	ListProjectsByCustomerID(context.Context, int64) ([]domain.Project, error)
+
+	ListMembers(context.Context, int64) ([]domain.Member, error)
+	AddMember(context.Context, *domain.Member) error
+	RemoveMember(context.Context, int64, string) error
+	// InviteMember emails an invitation and returns its ID.
+	InviteMember(context.Context, int64, string, domain.MemberRole) (int64, error)
+	// AcceptInvitation redeems the token, creating the user with the given password if needed.
+	AcceptInvitation(context.Context, string, string) error
 }
 
 type Repository interface {
@@ -22,4 +30,13 @@ type Repository interface {
 	UpdateProject(context.Context, *domain.Project) error
 	DeleteProject(context.Context, int64) error
 	ListProjectsByCustomerID(context.Context, int64) ([]domain.Project, error)
+	ListProjectsByMember(context.Context, string) ([]domain.Project, error)
+
+	AddMember(context.Context, *domain.Member) error
+	GetMember(context.Context, int64, string) (*domain.Member, error)
+	ListMembers(context.Context, int64) ([]domain.Member, error)
+	RemoveMember(context.Context, int64, string) error
+	CreateInvitation(context.Context, *domain.Invitation, string) (int64, error)
+	GetInvitationByToken(context.Context, string) (*domain.Invitation, error)
+	AcceptInvitation(context.Context, int64, *domain.Member) error
 }
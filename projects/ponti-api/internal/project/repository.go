@@ -4,21 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
+	outboxdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox/usecases/domain"
 	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/repository/models"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
 )
 
 type repository struct {
-	db gorm.Repository
+	db     gorm.Repository
+	outbox outbox.Repository
 }
 
-func NewRepository(db gorm.Repository) Repository {
-	return &repository{db: db}
+func NewRepository(db gorm.Repository, ob outbox.Repository) Repository {
+	return &repository{db: db, outbox: ob}
 }
 
 // CreateProject persists a project and all its associations in a single transaction.
@@ -28,6 +33,11 @@ func (r *repository) CreateProject(ctx context.Context, p *domain.Project) (int6
 		// Build GORM model from domain
 		m := models.FromDomain(p)
 
+		// 0. Every referenced entity must belong to the caller's organization
+		if err := ensureSameTenant(tx, m); err != nil {
+			return err
+		}
+
 		// 1. Insert project base
 		if err := tx.
 			Omit("Managers", "Investors", "Fields").
@@ -66,9 +76,50 @@ func (r *repository) CreateProject(ctx context.Context, p *domain.Project) (int6
 			}
 		}
 
+		// 5. The creator becomes the project owner
+		if userID, ok := pkgtypes.UserIDFromContext(ctx); ok {
+			if err := tx.Create(&models.ProjectMember{
+				ProjectID: m.ID,
+				UserID:    userID,
+				Role:      string(domain.RoleOwner),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to add project owner: %w", err)
+			}
+		}
+
+		// 6. Record the ProjectCreated event in the same transaction
+		payload := outboxdom.ProjectCreated{
+			ProjectID:   m.ID,
+			Name:        m.Name,
+			CustomerID:  m.CustomerID,
+			ManagerIDs:  make([]int64, 0, len(m.Managers)),
+			InvestorIDs: make([]int64, 0, len(m.Investors)),
+			FieldIDs:    make([]int64, 0, len(m.Fields)),
+		}
+		for _, mgr := range m.Managers {
+			payload.ManagerIDs = append(payload.ManagerIDs, mgr.ID)
+		}
+		for _, inv := range m.Investors {
+			payload.InvestorIDs = append(payload.InvestorIDs, inv.ID)
+		}
+		for _, fld := range m.Fields {
+			payload.FieldIDs = append(payload.FieldIDs, fld.ID)
+		}
+		event, err := outboxdom.NewEvent("project", m.ID, outboxdom.EventProjectCreated, payload)
+		if err != nil {
+			return err
+		}
+		if err := r.outbox.Add(tx, event); err != nil {
+			return fmt.Errorf("failed to record project event: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) {
+			return 0, appErr
+		}
 		// Wrap in application error
 		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("transaction failed for project creation: %v", err), err)
 	}
@@ -93,6 +144,27 @@ func (r *repository) ListProjects(ctx context.Context) ([]domain.Project, error)
 	return result, nil
 }
 
+// ListProjectsByMember retrieves the projects the user is a member of.
+func (r *repository) ListProjectsByMember(ctx context.Context, userID string) ([]domain.Project, error) {
+	var modelsList []models.Project
+	if err := r.db.Client().WithContext(ctx).
+		Preload("Managers").
+		Preload("Investors").
+		Preload("Fields").
+		Where("id IN (?)", r.db.Client().WithContext(ctx).
+			Model(&models.ProjectMember{}).
+			Select("project_id").
+			Where("user_id = ?", userID)).
+		Find(&modelsList).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list member projects", err)
+	}
+	var result []domain.Project
+	for _, m := range modelsList {
+		result = append(result, *m.ToDomain())
+	}
+	return result, nil
+}
+
 // ListProjectsByCustomerID retrieves projects filtered by customer.
 func (r *repository) ListProjectsByCustomerID(ctx context.Context, customerID int64) ([]domain.Project, error) {
 	var modelsList []models.Project
@@ -121,6 +193,9 @@ func (r *repository) GetProject(ctx context.Context, id int64) (*domain.Project,
 		First(&m, id).Error
 	if err != nil {
 		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.Project{}, id); err != nil {
+				return nil, err
+			}
 			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("project %d not found", id), err)
 		}
 		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to get project %d", id), err)
@@ -133,11 +208,21 @@ func (r *repository) GetProject(ctx context.Context, id int64) (*domain.Project,
 func (r *repository) UpdateProject(ctx context.Context, d *domain.Project) error {
 	m := models.FromDomain(d)
 	err := r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := ensureSameTenant(tx, m); err != nil {
+			return err
+		}
 		// update name and customer_id
-		if err := tx.Model(&models.Project{}).
+		result := tx.Model(&models.Project{}).
 			Where("id = ?", d.ID).
-			Updates(map[string]interface{}{"name": d.Name, "customer_id": d.Customer.ID}).Error; err != nil {
-			return err
+			Updates(map[string]interface{}{"name": d.Name, "customer_id": d.Customer.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			if err := gorm.CrossTenantError(tx, &models.Project{}, d.ID); err != nil {
+				return err
+			}
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("project %d not found", d.ID), nil)
 		}
 		// relink managers
 		if err := tx.Model(&models.Project{ID: d.ID}).Association("Managers").Replace(m.Managers); err != nil {
@@ -154,14 +239,30 @@ func (r *repository) UpdateProject(ctx context.Context, d *domain.Project) error
 		return nil
 	})
 	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) {
+			return appErr
+		}
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update project", err)
 	}
 	return nil
 }
 
-// DeleteProject removes a project and clears all its ID-based relations.
+// DeleteProject removes a project, clears all its ID-based relations and deletes
+// its members and invitations.
 func (r *repository) DeleteProject(ctx context.Context, id int64) error {
 	err := r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		// make sure the project is visible to the caller before touching its relations
+		var exists int64
+		if err := tx.Model(&models.Project{}).Where("id = ?", id).Count(&exists).Error; err != nil {
+			return err
+		}
+		if exists == 0 {
+			if err := gorm.CrossTenantError(tx, &models.Project{}, id); err != nil {
+				return err
+			}
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("project %d not found", id), nil)
+		}
 		// clear managers
 		if err := tx.Model(&models.Project{ID: id}).Association("Managers").Clear(); err != nil {
 			return err
@@ -174,6 +275,13 @@ func (r *repository) DeleteProject(ctx context.Context, id int64) error {
 		if err := tx.Model(&models.Project{ID: id}).Association("Fields").Clear(); err != nil {
 			return err
 		}
+		// drop memberships and pending invitations, so none can be redeemed later
+		if err := tx.Where("project_id = ?", id).Delete(&models.ProjectMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", id).Delete(&models.ProjectInvitation{}).Error; err != nil {
+			return err
+		}
 		// delete project
 		if err := tx.Delete(&models.Project{}, id).Error; err != nil {
 			return err
@@ -181,7 +289,179 @@ func (r *repository) DeleteProject(ctx context.Context, id int64) error {
 		return nil
 	})
 	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) {
+			return appErr
+		}
 		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete project", err)
 	}
 	return nil
 }
+
+// ensureSameTenant rejects projects that reference a customer, manager, investor
+// or field of another organization. The pivot tables are written with raw SQL and
+// are not covered by the tenant callbacks.
+func ensureSameTenant(tx *gorm0.DB, m *models.Project) error {
+	if err := gorm.EnsureSameTenant(tx, "customers", m.CustomerID); err != nil {
+		return err
+	}
+	managerIDs := make([]int64, 0, len(m.Managers))
+	for _, mgr := range m.Managers {
+		managerIDs = append(managerIDs, mgr.ID)
+	}
+	if err := gorm.EnsureSameTenant(tx, "managers", managerIDs...); err != nil {
+		return err
+	}
+	investorIDs := make([]int64, 0, len(m.Investors))
+	for _, inv := range m.Investors {
+		investorIDs = append(investorIDs, inv.ID)
+	}
+	if err := gorm.EnsureSameTenant(tx, "investors", investorIDs...); err != nil {
+		return err
+	}
+	fieldIDs := make([]int64, 0, len(m.Fields))
+	for _, fld := range m.Fields {
+		fieldIDs = append(fieldIDs, fld.ID)
+	}
+	return gorm.EnsureSameTenant(tx, "fields", fieldIDs...)
+}
+
+// AddMember creates the membership or updates its role if it already exists.
+func (r *repository) AddMember(ctx context.Context, member *domain.Member) error {
+	if member == nil {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "member is nil", nil)
+	}
+	db := r.db.Client().WithContext(ctx)
+	if err := gorm.EnsureSameTenant(db, "projects", member.ProjectID); err != nil {
+		return err
+	}
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(models.MemberFromDomain(member)).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to add project member", err)
+	}
+	return nil
+}
+
+// GetMember retrieves the membership of a user in a project.
+func (r *repository) GetMember(ctx context.Context, projectID int64, userID string) (*domain.Member, error) {
+	var m models.ProjectMember
+	err := r.db.Client().WithContext(ctx).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user %s is not a member of project %d", userID, projectID), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get project member", err)
+	}
+	return m.ToDomain(), nil
+}
+
+// ListMembers returns the members of a project.
+func (r *repository) ListMembers(ctx context.Context, projectID int64) ([]domain.Member, error) {
+	var list []models.ProjectMember
+	if err := r.db.Client().WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at").
+		Find(&list).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list project members", err)
+	}
+	result := make([]domain.Member, 0, len(list))
+	for _, m := range list {
+		result = append(result, *m.ToDomain())
+	}
+	return result, nil
+}
+
+// RemoveMember deletes the membership of a user in a project.
+func (r *repository) RemoveMember(ctx context.Context, projectID int64, userID string) error {
+	result := r.db.Client().WithContext(ctx).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Delete(&models.ProjectMember{})
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to remove project member", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user %s is not a member of project %d", userID, projectID), nil)
+	}
+	return nil
+}
+
+// CreateInvitation stores an invitation with the hash of its token.
+func (r *repository) CreateInvitation(ctx context.Context, inv *domain.Invitation, tokenHash string) (int64, error) {
+	if inv == nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "invitation is nil", nil)
+	}
+	db := r.db.Client().WithContext(ctx)
+	if err := gorm.EnsureSameTenant(db, "projects", inv.ProjectID); err != nil {
+		return 0, err
+	}
+	m := models.InvitationFromDomain(inv, tokenHash)
+	if err := db.Create(m).Error; err != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create invitation", err)
+	}
+	return m.ID, nil
+}
+
+// GetInvitationByToken looks an invitation up by token hash across organizations,
+// since the invitee is not authenticated yet.
+func (r *repository) GetInvitationByToken(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	var m models.ProjectInvitation
+	err := r.db.Client().WithContext(pkgtypes.WithoutTenantScope(ctx)).
+		Where("token_hash = ?", tokenHash).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "invitation not found", err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get invitation", err)
+	}
+	return m.ToDomain(), nil
+}
+
+// AcceptInvitation marks the invitation as accepted and adds the member in a
+// single transaction. The context must carry the invitation's organization.
+// Existing members keep their role if it is higher than the invited one.
+func (r *repository) AcceptInvitation(ctx context.Context, invitationID int64, member *domain.Member) error {
+	return r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		result := tx.Model(&models.ProjectInvitation{}).
+			Where("id = ? AND accepted_at IS NULL", invitationID).
+			Update("accepted_at", time.Now())
+		if result.Error != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to accept invitation", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return pkgtypes.NewError(pkgtypes.ErrConflict, "invitation has already been accepted", nil)
+		}
+		return joinProject(tx, member)
+	})
+}
+
+// joinProject adds the member, or raises the role of an existing one. An
+// invitation never lowers a role, so it cannot demote an owner.
+func joinProject(tx *gorm0.DB, member *domain.Member) error {
+	created := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(models.MemberFromDomain(member))
+	if created.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to add project member", created.Error)
+	}
+	if created.RowsAffected > 0 {
+		return nil
+	}
+	var current models.ProjectMember
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("project_id = ? AND user_id = ?", member.ProjectID, member.UserID).
+		First(&current).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get project member", err)
+	}
+	if !member.Role.Outranks(domain.MemberRole(current.Role)) {
+		return nil
+	}
+	if err := tx.Model(&models.ProjectMember{}).
+		Where("project_id = ? AND user_id = ?", member.ProjectID, member.UserID).
+		Update("role", string(member.Role)).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update project member", err)
+	}
+	return nil
+}
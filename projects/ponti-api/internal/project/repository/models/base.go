@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	customerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
 	fielddom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/usecases/domain"
 	investordom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor/usecases/domain"
@@ -12,6 +13,8 @@ import (
 
 // Project es el modelo GORM para proyectos.
 type Project struct {
+	pkggorm.TenantScoped
+
 	ID         int64     `gorm:"primaryKey;autoIncrement;column:id"`
 	Name       string    `gorm:"size:100;not null;column:name"`
 	CustomerID int64     `gorm:"not null;index;column:customer_id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
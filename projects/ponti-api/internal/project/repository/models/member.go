@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+)
+
+// ProjectMember es el modelo GORM de la membresía de un usuario en un proyecto.
+type ProjectMember struct {
+	pkggorm.TenantScoped
+
+	ProjectID int64     `gorm:"primaryKey;autoIncrement:false;column:project_id"`
+	UserID    string    `gorm:"primaryKey;index;column:user_id"`
+	Role      string    `gorm:"size:30;not null;column:role"`
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at"`
+}
+
+// ProjectInvitation es el modelo GORM de una invitación a un proyecto.
+// Del token sólo se guarda el hash.
+type ProjectInvitation struct {
+	pkggorm.TenantScoped
+
+	ID         int64      `gorm:"primaryKey;autoIncrement;column:id"`
+	ProjectID  int64      `gorm:"not null;index;column:project_id"`
+	Email      string     `gorm:"size:255;not null;column:email"`
+	Role       string     `gorm:"size:30;not null;column:role"`
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex;column:token_hash"`
+	InvitedBy  string     `gorm:"column:invited_by"`
+	ExpiresAt  time.Time  `gorm:"not null;column:expires_at"`
+	AcceptedAt *time.Time `gorm:"column:accepted_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;column:created_at"`
+}
+
+func MemberFromDomain(d *domain.Member) *ProjectMember {
+	return &ProjectMember{
+		ProjectID: d.ProjectID,
+		UserID:    d.UserID,
+		Role:      string(d.Role),
+	}
+}
+
+func (m *ProjectMember) ToDomain() *domain.Member {
+	return &domain.Member{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Role:      domain.MemberRole(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func InvitationFromDomain(d *domain.Invitation, tokenHash string) *ProjectInvitation {
+	return &ProjectInvitation{
+		ID:         d.ID,
+		ProjectID:  d.ProjectID,
+		Email:      d.Email,
+		Role:       string(d.Role),
+		TokenHash:  tokenHash,
+		InvitedBy:  d.InvitedBy,
+		ExpiresAt:  d.ExpiresAt,
+		AcceptedAt: d.AcceptedAt,
+	}
+}
+
+func (m *ProjectInvitation) ToDomain() *domain.Invitation {
+	return &domain.Invitation{
+		ID:         m.ID,
+		ProjectID:  m.ProjectID,
+		TenantID:   m.TenantID,
+		Email:      m.Email,
+		Role:       domain.MemberRole(m.Role),
+		InvitedBy:  m.InvitedBy,
+		ExpiresAt:  m.ExpiresAt,
+		AcceptedAt: m.AcceptedAt,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"time"
 
 	customer "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer"
 	customerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
@@ -14,7 +16,9 @@ import (
 	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
 	manager "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager"
 	managerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/usecases/domain"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
 type useCases struct {
@@ -24,6 +28,15 @@ type useCases struct {
 	investor investor.UseCases
 	field    field.UseCases
 	lot      lot.UseCases
+	users    user.UseCases
+	mailer   notification.UseCases
+	options  Options
+}
+
+// Options configures project invitations.
+type Options struct {
+	InvitationTTL time.Duration // How long an invitation can be accepted.
+	InvitationURL string        // Base of the accept link; the token is appended as ?token=.
 }
 
 func NewUseCases(
@@ -33,7 +46,13 @@ func NewUseCases(
 	in investor.UseCases,
 	fu field.UseCases,
 	lo lot.UseCases,
+	us user.UseCases,
+	mailer notification.UseCases,
+	opts Options,
 ) UseCases {
+	if opts.InvitationTTL <= 0 {
+		opts.InvitationTTL = 7 * 24 * time.Hour
+	}
 	return &useCases{
 		repo:     repo,
 		customer: cu,
@@ -41,6 +60,9 @@ func NewUseCases(
 		investor: in,
 		field:    fu,
 		lot:      lo,
+		users:    us,
+		mailer:   mailer,
+		options:  opts,
 	}
 }
 
@@ -148,6 +170,9 @@ func (u *useCases) CreateProject(ctx context.Context, p *domain.Project) (int64,
 }
 
 func (u *useCases) GetProject(ctx context.Context, id int64) (*domain.Project, error) {
+	if err := u.requireRole(ctx, id); err != nil {
+		return nil, err
+	}
 	proj, err := u.repo.GetProject(ctx, id)
 	if err != nil {
 		return nil, err
@@ -158,8 +183,18 @@ func (u *useCases) GetProject(ctx context.Context, id int64) (*domain.Project, e
 	return proj, nil
 }
 
+// ListProjects returns the caller's projects, or every project of the organization
+// when the call is not restricted by membership.
 func (u *useCases) ListProjects(ctx context.Context) ([]domain.Project, error) {
-	list, err := u.repo.ListProjects(ctx)
+	var (
+		list []domain.Project
+		err  error
+	)
+	if userID, scoped := membershipScope(ctx); scoped {
+		list, err = u.repo.ListProjectsByMember(ctx, userID)
+	} else {
+		list, err = u.repo.ListProjects(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +211,15 @@ func (u *useCases) ListProjectsByCustomerID(ctx context.Context, customerID int6
 	if err != nil {
 		return nil, err
 	}
+	if userID, scoped := membershipScope(ctx); scoped {
+		mine, err := u.repo.ListProjectsByMember(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		list = slices.DeleteFunc(list, func(p domain.Project) bool {
+			return !slices.ContainsFunc(mine, func(m domain.Project) bool { return m.ID == p.ID })
+		})
+	}
 	for i := range list {
 		if err := u.enrichProject(ctx, &list[i]); err != nil {
 			return nil, err
@@ -185,10 +229,16 @@ func (u *useCases) ListProjectsByCustomerID(ctx context.Context, customerID int6
 }
 
 func (u *useCases) UpdateProject(ctx context.Context, p *domain.Project) error {
+	if err := u.requireRole(ctx, p.ID, domain.RoleOwner, domain.RoleAgronomist); err != nil {
+		return err
+	}
 	return u.repo.UpdateProject(ctx, p)
 }
 
 func (u *useCases) DeleteProject(ctx context.Context, id int64) error {
+	if err := u.requireRole(ctx, id, domain.RoleOwner); err != nil {
+		return err
+	}
 	return u.repo.DeleteProject(ctx, id)
 }
 
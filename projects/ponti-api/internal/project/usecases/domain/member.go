@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// MemberRole is the role a user holds within a project.
+type MemberRole string
+
+const (
+	RoleOwner          MemberRole = "owner"           // Full control, including members.
+	RoleAgronomist     MemberRole = "agronomist"      // Can read and update the project.
+	RoleInvestorViewer MemberRole = "investor-viewer" // Read only.
+)
+
+// Valid reports whether the role is one of the known project roles.
+func (r MemberRole) Valid() bool {
+	switch r {
+	case RoleOwner, RoleAgronomist, RoleInvestorViewer:
+		return true
+	}
+	return false
+}
+
+// Outranks reports whether r grants more access than other.
+func (r MemberRole) Outranks(other MemberRole) bool {
+	return r.rank() > other.rank()
+}
+
+func (r MemberRole) rank() int {
+	switch r {
+	case RoleOwner:
+		return 3
+	case RoleAgronomist:
+		return 2
+	case RoleInvestorViewer:
+		return 1
+	}
+	return 0
+}
+
+// Member grants a user access to a project.
+type Member struct {
+	ProjectID int64
+	UserID    string
+	Role      MemberRole
+	CreatedAt time.Time
+}
+
+// Invitation invites an email address to join a project with a role.
+type Invitation struct {
+	ID         int64
+	ProjectID  int64
+	TenantID   int64
+	Email      string
+	Role       MemberRole
+	InvitedBy  string
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+}
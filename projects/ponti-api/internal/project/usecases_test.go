@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	cropdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop/usecases/domain"
 	customer "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/mocks"
 	customerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer/usecases/domain"
@@ -19,6 +21,8 @@ import (
 	managerdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager/usecases/domain"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/mocks"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project/usecases/domain"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+	userdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -182,7 +186,7 @@ func TestCreateProject(t *testing.T) {
 				in:   inMock,
 				fu:   fuMock,
 				lo:   loMock,
-				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock),
+				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock, nil, nil, Options{}),
 			}
 
 			tt.setup(&f)
@@ -284,7 +288,7 @@ func TestGetProject(t *testing.T) {
 				in:   inMock,
 				fu:   fuMock,
 				lo:   loMock,
-				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock),
+				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock, nil, nil, Options{}),
 			}
 
 			tt.setup(&f)
@@ -403,7 +407,7 @@ func TestListProject(t *testing.T) {
 				in:   inMock,
 				fu:   fuMock,
 				lo:   loMock,
-				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock),
+				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock, nil, nil, Options{}),
 			}
 			tt.setup(&f)
 			got, err := f.uc.ListProjects(tt.args.ctx)
@@ -499,7 +503,7 @@ func TestListProjectsByCustomerID(t *testing.T) {
 				in:   inMock,
 				fu:   fuMock,
 				lo:   loMock,
-				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock),
+				uc:   NewUseCases(repoMock, cuMock, maMock, inMock, fuMock, loMock, nil, nil, Options{}),
 			}
 
 			tt.setup(&f)
@@ -556,7 +560,7 @@ func TestUpdateProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := mocks.NewMockRepository(ctrl)
-			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil)
+			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil, nil, nil, Options{})
 			f := fields{repo: repoMock, uc: uc}
 
 			tt.setup(&f)
@@ -612,7 +616,7 @@ func TestDeleteProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := mocks.NewMockRepository(ctrl)
-			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil)
+			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil, nil, nil, Options{})
 			f := fields{repo: repoMock, uc: uc}
 
 			tt.setup(&f)
@@ -625,3 +629,270 @@ func TestDeleteProject(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	member := pkgtypes.WithUserID(context.TODO(), "u1")
+
+	tests := []struct {
+		name    string
+		setup   func(repo *mocks.MockRepository)
+		run     func(uc UseCases) error
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "list returns only the caller's projects",
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().ListProjectsByMember(gomock.Any(), "u1").Return(nil, nil)
+			},
+			run: func(uc UseCases) error {
+				_, err := uc.ListProjects(member)
+				return err
+			},
+		},
+		{
+			name: "list is unrestricted for managers",
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().ListProjects(gomock.Any()).Return(nil, nil)
+			},
+			run: func(uc UseCases) error {
+				_, err := uc.ListProjects(withAllProjects(member))
+				return err
+			},
+		},
+		{
+			name: "non members cannot update",
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetMember(gomock.Any(), int64(1), "u1").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "not a member", nil))
+			},
+			run: func(uc UseCases) error {
+				return uc.UpdateProject(member, &domain.Project{ID: 1})
+			},
+			wantErr: pkgtypes.ErrAuthorization,
+		},
+		{
+			name: "viewers cannot delete",
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetMember(gomock.Any(), int64(1), "u1").
+					Return(&domain.Member{ProjectID: 1, UserID: "u1", Role: domain.RoleInvestorViewer}, nil)
+			},
+			run: func(uc UseCases) error {
+				return uc.DeleteProject(member, 1)
+			},
+			wantErr: pkgtypes.ErrAuthorization,
+		},
+		{
+			name: "the last owner cannot be removed",
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetMember(gomock.Any(), int64(1), "u1").
+					Return(&domain.Member{ProjectID: 1, UserID: "u1", Role: domain.RoleOwner}, nil)
+				repo.EXPECT().ListMembers(gomock.Any(), int64(1)).Return([]domain.Member{
+					{ProjectID: 1, UserID: "u1", Role: domain.RoleOwner},
+					{ProjectID: 1, UserID: "u2", Role: domain.RoleAgronomist},
+				}, nil)
+			},
+			run: func(uc UseCases) error {
+				return uc.RemoveMember(member, 1, "u1")
+			},
+			wantErr: pkgtypes.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := mocks.NewMockRepository(ctrl)
+			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil, nil, nil, Options{})
+
+			tt.setup(repoMock)
+			err := tt.run(uc)
+			if tt.wantErr != "" {
+				errType, _ := pkgtypes.GetErrorType(err)
+				assert.Equal(t, tt.wantErr, errType)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := pkgtypes.WithTenantID(pkgtypes.WithUserID(context.TODO(), "u1"), 3)
+	asOwner := func(repo *mocks.MockRepository) {
+		repo.EXPECT().GetMember(gomock.Any(), int64(1), "u1").
+			Return(&domain.Member{ProjectID: 1, UserID: "u1", Role: domain.RoleOwner}, nil)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func(repo *mocks.MockRepository, users *user.MockUseCases)
+		wantErr pkgtypes.ErrorType
+	}{
+		{
+			name: "adds a user of the organization",
+			ctx:  owner,
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				asOwner(repo)
+				users.EXPECT().GetUser(gomock.Any(), "u2").Return(&userdom.User{ID: "u2", OrganizationID: 3}, nil)
+				repo.EXPECT().ListMembers(gomock.Any(), int64(1)).Return(nil, nil)
+				repo.EXPECT().AddMember(gomock.Any(), &domain.Member{ProjectID: 1, UserID: "u2", Role: domain.RoleAgronomist}).Return(nil)
+			},
+		},
+		{
+			name: "a user of another organization is not found",
+			ctx:  owner,
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				asOwner(repo)
+				users.EXPECT().GetUser(gomock.Any(), "u2").Return(&userdom.User{ID: "u2", OrganizationID: 4}, nil)
+			},
+			wantErr: pkgtypes.ErrNotFound,
+		},
+		{
+			name: "an unknown user is not found",
+			ctx:  owner,
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				asOwner(repo)
+				users.EXPECT().GetUser(gomock.Any(), "u2").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "not found", nil))
+			},
+			wantErr: pkgtypes.ErrNotFound,
+		},
+		{
+			name: "the organization is required",
+			ctx:  pkgtypes.WithUserID(context.TODO(), "u1"),
+			setup: func(repo *mocks.MockRepository, _ *user.MockUseCases) {
+				asOwner(repo)
+			},
+			wantErr: pkgtypes.ErrAuthorization,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := mocks.NewMockRepository(ctrl)
+			usersMock := user.NewMockUseCases(ctrl)
+			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil, usersMock, nil, Options{})
+
+			tt.setup(repoMock, usersMock)
+			err := uc.AddMember(tt.ctx, &domain.Member{ProjectID: 1, UserID: "u2", Role: domain.RoleAgronomist})
+			if tt.wantErr != "" {
+				errType, _ := pkgtypes.GetErrorType(err)
+				assert.Equal(t, tt.wantErr, errType)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pending := &domain.Invitation{
+		ID:        7,
+		ProjectID: 1,
+		TenantID:  3,
+		Email:     "new@b.com",
+		Role:      domain.RoleAgronomist,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		setup    func(repo *mocks.MockRepository, users *user.MockUseCases)
+		wantErr  pkgtypes.ErrorType
+	}{
+		{
+			name:     "creates the user in the project's organization",
+			password: "S3cret!pass",
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				repo.EXPECT().GetInvitationByToken(gomock.Any(), hashInvitationToken("tok")).Return(pending, nil)
+				users.EXPECT().GetUserByEmail(gomock.Any(), "new@b.com").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "not found", nil))
+				users.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *userdom.User) (string, error) {
+						assert.Equal(t, int64(3), u.OrganizationID)
+						return "u9", nil
+					})
+				repo.EXPECT().AcceptInvitation(gomock.Any(), int64(7), &domain.Member{
+					ProjectID: 1, UserID: "u9", Role: domain.RoleAgronomist,
+				}).DoAndReturn(func(ctx context.Context, _ int64, _ *domain.Member) error {
+					tenantID, _ := pkgtypes.TenantIDFromContext(ctx)
+					assert.Equal(t, int64(3), tenantID)
+					return nil
+				})
+			},
+		},
+		{
+			name: "new users must choose a password",
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				repo.EXPECT().GetInvitationByToken(gomock.Any(), gomock.Any()).Return(pending, nil)
+				users.EXPECT().GetUserByEmail(gomock.Any(), "new@b.com").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "not found", nil))
+			},
+			wantErr: pkgtypes.ErrValidation,
+		},
+		{
+			name: "users of another organization are rejected",
+			setup: func(repo *mocks.MockRepository, users *user.MockUseCases) {
+				repo.EXPECT().GetInvitationByToken(gomock.Any(), gomock.Any()).Return(pending, nil)
+				users.EXPECT().GetUserByEmail(gomock.Any(), "new@b.com").
+					Return(&userdom.User{ID: "u2", OrganizationID: 4}, nil)
+			},
+			wantErr: pkgtypes.ErrConflict,
+		},
+		{
+			name: "expired invitation",
+			setup: func(repo *mocks.MockRepository, _ *user.MockUseCases) {
+				expired := *pending
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+				repo.EXPECT().GetInvitationByToken(gomock.Any(), gomock.Any()).Return(&expired, nil)
+			},
+			wantErr: pkgtypes.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := mocks.NewMockRepository(ctrl)
+			usersMock := user.NewMockUseCases(ctrl)
+			uc := NewUseCases(repoMock, nil, nil, nil, nil, nil, usersMock, nil, Options{})
+
+			tt.setup(repoMock, usersMock)
+			err := uc.AcceptInvitation(context.TODO(), "tok", tt.password)
+			if tt.wantErr != "" {
+				errType, _ := pkgtypes.GetErrorType(err)
+				assert.Equal(t, tt.wantErr, errType)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemberRoleOutranks(t *testing.T) {
+	tests := []struct {
+		role, other domain.MemberRole
+		want        bool
+	}{
+		{role: domain.RoleOwner, other: domain.RoleAgronomist, want: true},
+		{role: domain.RoleAgronomist, other: domain.RoleInvestorViewer, want: true},
+		{role: domain.RoleAgronomist, other: domain.RoleOwner},
+		{role: domain.RoleInvestorViewer, other: domain.RoleOwner},
+		{role: domain.RoleOwner, other: domain.RoleOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" over "+string(tt.other), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.Outranks(tt.other))
+		})
+	}
+}
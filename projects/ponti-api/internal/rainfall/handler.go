@@ -0,0 +1,310 @@
+package rainfall
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// Handler encapsulates dependencies for the Rainfall HTTP handler.
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+// NewHandler creates a new Rainfall handler.
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{ucs: u, gsv: s, mws: m}
+}
+
+// Routes registers HTTP routes for rainfall readings.
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/rainfall"
+	protectedPrefix := apiBase + "/protected"
+
+	protected := router.Group(protectedPrefix)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.GET("/ping", h.ProtectedPing)
+
+		scoped := protected.Group("", h.mws.Tenant...)
+		read := scoped.Group("", h.mws.RequirePermission("rainfall:read"), h.mws.Cache("private"))
+		write := scoped.Group("", h.mws.RequirePermission("rainfall:write"))
+		idempotent := write.Group("", h.mws.Idempotent...)
+		idempotent.POST("", h.CreateReading)
+		idempotent.POST("/bulk", h.CreateMonthReadings)
+		read.GET("", h.ListReadings)
+		read.GET("/:id", h.GetReading)
+		write.PUT("/:id", h.UpdateReading)
+		write.DELETE("/:id", h.DeleteReading)
+
+		read.GET("/fields/:field_id/monthly", h.GetMonthlyAccumulation)
+		read.GET("/fields/:field_id/seasonal", h.GetSeasonAccumulation)
+		read.GET("/fields/:field_id/comparison", h.GetComparison)
+		write.POST("/fields/:field_id/sync", h.SyncFromProvider)
+	}
+
+	h.describeRoutes()
+}
+
+// ProtectedPing is a test endpoint for protected routes.
+func (h *Handler) ProtectedPing(c *gin.Context) {
+	c.JSON(http.StatusCreated, types.MessageResponse{Message: "Protected Pong!"})
+}
+
+// CreateReading handles POST /rainfall
+func (h *Handler) CreateReading(c *gin.Context) {
+	var req dto.CreateReading
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	dom, err := req.Reading.ToDomain()
+	if err != nil {
+		c.Error(types.NewError(types.ErrInvalidInput, "invalid date, expected YYYY-MM-DD", err))
+		return
+	}
+
+	newID, err := h.ucs.CreateReading(c.Request.Context(), dom)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateReadingResponse{Message: "Reading created successfully", ID: newID})
+}
+
+// CreateMonthReadings handles POST /rainfall/bulk
+func (h *Handler) CreateMonthReadings(c *gin.Context) {
+	var req dto.MonthReadings
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	stored, err := h.ucs.CreateMonthReadings(c.Request.Context(), req.FieldID, req.Year, time.Month(req.Month), req.ToDomain())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.StoredReadingsResponse{Message: "Readings stored successfully", Stored: stored})
+}
+
+// ListReadings handles GET /rainfall?field_id=&from=&to=
+func (h *Handler) ListReadings(c *gin.Context) {
+	fieldID, err := strconv.ParseInt(c.Query("field_id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return
+	}
+	from, to, ok := parseRange(c)
+	if !ok {
+		return
+	}
+
+	readings, err := h.ucs.ListReadings(c.Request.Context(), fieldID, from, to)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainList(readings))
+}
+
+// GetReading handles GET /rainfall/:id
+func (h *Handler) GetReading(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid reading id", err))
+		return
+	}
+
+	reading, err := h.ucs.GetReading(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomain(*reading))
+}
+
+// UpdateReading handles PUT /rainfall/:id
+func (h *Handler) UpdateReading(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid reading id", err))
+		return
+	}
+	var req dto.UpdateReading
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	dom, err := req.Reading.ToDomain()
+	if err != nil {
+		c.Error(types.NewError(types.ErrInvalidInput, "invalid date, expected YYYY-MM-DD", err))
+		return
+	}
+	dom.ID = id
+	if err := h.ucs.UpdateReading(c.Request.Context(), dom); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "Reading updated successfully"})
+}
+
+// DeleteReading handles DELETE /rainfall/:id
+func (h *Handler) DeleteReading(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid reading id", err))
+		return
+	}
+	if err := h.ucs.DeleteReading(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{Message: "Reading deleted successfully"})
+}
+
+// GetMonthlyAccumulation handles GET /rainfall/fields/:field_id/monthly?year=
+func (h *Handler) GetMonthlyAccumulation(c *gin.Context) {
+	fieldID, err := strconv.ParseInt(c.Param("field_id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return
+	}
+	year := time.Now().Year()
+	if raw := c.Query("year"); raw != "" {
+		if year, err = strconv.Atoi(raw); err != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, "invalid year", err))
+			return
+		}
+	}
+
+	months, err := h.ucs.MonthlyAccumulation(c.Request.Context(), fieldID, year)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewMonthlyAccumulationResponse(fieldID, year, months))
+}
+
+// GetSeasonAccumulation handles GET /rainfall/fields/:field_id/seasonal?season=2024/2025
+func (h *Handler) GetSeasonAccumulation(c *gin.Context) {
+	fieldID, err := strconv.ParseInt(c.Param("field_id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return
+	}
+	season := domain.SeasonOf(time.Now())
+	if raw := c.Query("season"); raw != "" {
+		if season, err = domain.ParseSeason(raw); err != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, err.Error(), err))
+			return
+		}
+	}
+
+	acc, err := h.ucs.SeasonAccumulation(c.Request.Context(), fieldID, season)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSeasonAccumulationResponse(acc))
+}
+
+// GetComparison handles GET /rainfall/fields/:field_id/comparison
+// with either ?year=&month= or ?season=.
+func (h *Handler) GetComparison(c *gin.Context) {
+	fieldID, err := strconv.ParseInt(c.Param("field_id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return
+	}
+
+	var cmp *domain.Comparison
+	if raw := c.Query("season"); raw != "" {
+		season, err := domain.ParseSeason(raw)
+		if err != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, err.Error(), err))
+			return
+		}
+		cmp, err = h.ucs.CompareSeason(c.Request.Context(), fieldID, season)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+	} else {
+		year, errYear := strconv.Atoi(c.Query("year"))
+		month, errMonth := strconv.Atoi(c.Query("month"))
+		if errYear != nil || errMonth != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, "either season or year and month are required", nil))
+			return
+		}
+		cmp, err = h.ucs.CompareMonth(c.Request.Context(), fieldID, year, time.Month(month))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, dto.NewComparisonResponse(cmp))
+}
+
+// SyncFromProvider handles POST /rainfall/fields/:field_id/sync?from=&to=
+func (h *Handler) SyncFromProvider(c *gin.Context) {
+	fieldID, err := strconv.ParseInt(c.Param("field_id"), 10, 64)
+	if err != nil {
+		c.Error(types.NewInvalidIDError("invalid field id", err))
+		return
+	}
+	from, to, ok := parseRange(c)
+	if !ok {
+		return
+	}
+	if from.IsZero() || to.IsZero() {
+		c.Error(types.NewError(types.ErrInvalidInput, "from and to are required", nil))
+		return
+	}
+
+	stored, err := h.ucs.SyncFromProvider(c.Request.Context(), fieldID, from, to)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.StoredReadingsResponse{Message: "Readings synchronized successfully", Stored: stored})
+}
+
+// parseRange reads the optional from/to query params (YYYY-MM-DD). The range is
+// [from, to]; to is returned as the day after so it can be used as an open bound.
+func parseRange(c *gin.Context) (time.Time, time.Time, bool) {
+	var from, to time.Time
+	var err error
+	if raw := c.Query("from"); raw != "" {
+		if from, err = time.Parse(time.DateOnly, raw); err != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, "invalid from date, expected YYYY-MM-DD", err))
+			return from, to, false
+		}
+	}
+	if raw := c.Query("to"); raw != "" {
+		if to, err = time.Parse(time.DateOnly, raw); err != nil {
+			c.Error(types.NewError(types.ErrInvalidInput, "invalid to date, expected YYYY-MM-DD", err))
+			return from, to, false
+		}
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to, true
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// MonthlyAccumulation is the rain accumulated in a month.
+type MonthlyAccumulation struct {
+	Year        int     `json:"year"`
+	Month       int     `json:"month"`
+	Millimeters float64 `json:"millimeters"`
+	Readings    int     `json:"readings"`
+}
+
+// MonthlyAccumulationResponse lists the months of a year for a field.
+type MonthlyAccumulationResponse struct {
+	FieldID     int64                 `json:"field_id"`
+	Year        int                   `json:"year"`
+	Millimeters float64               `json:"millimeters"`
+	Months      []MonthlyAccumulation `json:"months"`
+}
+
+// SeasonAccumulationResponse is the rain accumulated in an agricultural season.
+type SeasonAccumulationResponse struct {
+	FieldID     int64                 `json:"field_id"`
+	Season      string                `json:"season"`
+	Millimeters float64               `json:"millimeters"`
+	Readings    int                   `json:"readings"`
+	Months      []MonthlyAccumulation `json:"months"`
+}
+
+// ComparisonResponse compares a period against the field's historical average.
+type ComparisonResponse struct {
+	FieldID           int64   `json:"field_id"`
+	Period            string  `json:"period"`
+	Millimeters       float64 `json:"millimeters"`
+	HistoricalAverage float64 `json:"historical_average"`
+	SamplePeriods     int     `json:"sample_periods"`
+	Difference        float64 `json:"difference"`
+	DifferencePercent float64 `json:"difference_percent"`
+}
+
+func fromMonths(list []domain.MonthlyAccumulation) []MonthlyAccumulation {
+	result := make([]MonthlyAccumulation, 0, len(list))
+	for _, m := range list {
+		result = append(result, MonthlyAccumulation{
+			Year:        m.Year,
+			Month:       int(m.Month),
+			Millimeters: m.Millimeters,
+			Readings:    m.Readings,
+		})
+	}
+	return result
+}
+
+// NewMonthlyAccumulationResponse builds the response for a calendar year.
+func NewMonthlyAccumulationResponse(fieldID int64, year int, list []domain.MonthlyAccumulation) *MonthlyAccumulationResponse {
+	resp := &MonthlyAccumulationResponse{
+		FieldID: fieldID,
+		Year:    year,
+		Months:  fromMonths(list),
+	}
+	for _, m := range list {
+		resp.Millimeters += m.Millimeters
+	}
+	return resp
+}
+
+// NewSeasonAccumulationResponse builds the response for a season.
+func NewSeasonAccumulationResponse(d *domain.SeasonAccumulation) *SeasonAccumulationResponse {
+	return &SeasonAccumulationResponse{
+		FieldID:     d.FieldID,
+		Season:      d.Season.String(),
+		Millimeters: d.Millimeters,
+		Readings:    d.Readings,
+		Months:      fromMonths(d.Months),
+	}
+}
+
+// NewComparisonResponse builds the comparison response.
+func NewComparisonResponse(d *domain.Comparison) *ComparisonResponse {
+	return &ComparisonResponse{
+		FieldID:           d.FieldID,
+		Period:            d.Period,
+		Millimeters:       d.Millimeters,
+		HistoricalAverage: d.HistoricalAverage,
+		SamplePeriods:     d.SamplePeriods,
+		Difference:        d.Difference,
+		DifferencePercent: d.DifferencePercent,
+	}
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// Reading is the payload of a single rain gauge reading.
+type Reading struct {
+	ID          int64   `json:"id,omitempty"`
+	FieldID     int64   `json:"field_id" binding:"required"`
+	Date        string  `json:"date" binding:"required"` // YYYY-MM-DD
+	Millimeters float64 `json:"millimeters" binding:"gte=0"`
+	Observer    string  `json:"observer"`
+}
+
+// ToDomain converts the DTO into a domain.Reading.
+func (r Reading) ToDomain() (*domain.Reading, error) {
+	date, err := time.Parse(time.DateOnly, r.Date)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Reading{
+		ID:          r.ID,
+		FieldID:     r.FieldID,
+		Date:        date,
+		Millimeters: r.Millimeters,
+		Observer:    r.Observer,
+	}, nil
+}
+
+// FromDomain converts a domain.Reading into a DTO.
+func FromDomain(d domain.Reading) *Reading {
+	return &Reading{
+		ID:          d.ID,
+		FieldID:     d.FieldID,
+		Date:        d.Date.Format(time.DateOnly),
+		Millimeters: d.Millimeters,
+		Observer:    d.Observer,
+	}
+}
+
+// FromDomainList converts a list of readings into DTOs.
+func FromDomainList(list []domain.Reading) []Reading {
+	result := make([]Reading, 0, len(list))
+	for _, d := range list {
+		result = append(result, *FromDomain(d))
+	}
+	return result
+}
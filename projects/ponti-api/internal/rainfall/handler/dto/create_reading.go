@@ -0,0 +1,12 @@
+package dto
+
+// CreateReading is the DTO for creating a reading.
+type CreateReading struct {
+	Reading
+}
+
+// CreateReadingResponse is the response DTO for CreateReading.
+type CreateReadingResponse struct {
+	Message string `json:"message"`
+	ID      int64  `json:"id"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// DayReading is a reading inside a monthly bulk entry.
+type DayReading struct {
+	Day         int     `json:"day" binding:"required,min=1,max=31"`
+	Millimeters float64 `json:"millimeters" binding:"gte=0"`
+	Observer    string  `json:"observer"`
+}
+
+// MonthReadings is the DTO for loading every reading of a month at once.
+type MonthReadings struct {
+	FieldID  int64        `json:"field_id" binding:"required"`
+	Year     int          `json:"year" binding:"required"`
+	Month    int          `json:"month" binding:"required,min=1,max=12"`
+	Readings []DayReading `json:"readings" binding:"required,min=1,dive"`
+}
+
+// ToDomain converts the bulk entry into domain readings.
+func (m MonthReadings) ToDomain() []domain.Reading {
+	result := make([]domain.Reading, 0, len(m.Readings))
+	for _, r := range m.Readings {
+		result = append(result, domain.Reading{
+			FieldID:     m.FieldID,
+			Date:        time.Date(m.Year, time.Month(m.Month), r.Day, 0, 0, 0, 0, time.UTC),
+			Millimeters: r.Millimeters,
+			Observer:    r.Observer,
+		})
+	}
+	return result
+}
+
+// StoredReadingsResponse is returned by the bulk and sync endpoints.
+type StoredReadingsResponse struct {
+	Message string `json:"message"`
+	Stored  int    `json:"stored"`
+}
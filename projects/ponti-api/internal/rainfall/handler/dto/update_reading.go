@@ -0,0 +1,6 @@
+package dto
+
+// UpdateReading is the DTO for updating a reading.
+type UpdateReading struct {
+	Reading
+}
@@ -0,0 +1,89 @@
+package rainfall
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "integer", "Reading ID")
+	fieldID := pkgswagger.PathParam("field_id", "integer", "Field ID")
+	from := pkgswagger.QueryParam("from", "string", "First day, YYYY-MM-DD", false)
+	to := pkgswagger.QueryParam("to", "string", "Last day (inclusive), YYYY-MM-DD", false)
+	season := pkgswagger.QueryParam("season", "string", "Season, e.g. 2024/2025; the current one by default", false)
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateReading, pkgswagger.Operation{
+		Summary:   "Record a rainfall reading",
+		Request:   dto.CreateReading{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateReadingResponse{}},
+	})
+	pkgswagger.Describe(h.CreateMonthReadings, pkgswagger.Operation{
+		Summary:   "Record the readings of a month",
+		Request:   dto.MonthReadings{},
+		Responses: map[int]any{http.StatusCreated: dto.StoredReadingsResponse{}},
+	})
+	pkgswagger.Describe(h.ListReadings, pkgswagger.Operation{
+		Summary: "List the readings of a field",
+		Parameters: []pkgswagger.Parameter{
+			pkgswagger.QueryParam("field_id", "integer", "Field ID", true),
+			from,
+			to,
+		},
+		Responses: map[int]any{http.StatusOK: []dto.Reading{}},
+	})
+	pkgswagger.Describe(h.GetReading, pkgswagger.Operation{
+		Summary:    "Get a reading",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: dto.Reading{}},
+	})
+	pkgswagger.Describe(h.UpdateReading, pkgswagger.Operation{
+		Summary:    "Update a reading",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdateReading{},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteReading, pkgswagger.Operation{
+		Summary:    "Delete a reading",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.GetMonthlyAccumulation, pkgswagger.Operation{
+		Summary: "Rainfall accumulated per month",
+		Parameters: []pkgswagger.Parameter{
+			fieldID,
+			pkgswagger.QueryParam("year", "integer", "Year; the current one by default", false),
+		},
+		Responses: map[int]any{http.StatusOK: dto.MonthlyAccumulationResponse{}},
+	})
+	pkgswagger.Describe(h.GetSeasonAccumulation, pkgswagger.Operation{
+		Summary:    "Rainfall accumulated in a season",
+		Parameters: []pkgswagger.Parameter{fieldID, season},
+		Responses:  map[int]any{http.StatusOK: dto.SeasonAccumulationResponse{}},
+	})
+	pkgswagger.Describe(h.GetComparison, pkgswagger.Operation{
+		Summary:     "Compare rainfall with the historical average",
+		Description: "Compares either a season or a month (year and month).",
+		Parameters: []pkgswagger.Parameter{
+			fieldID,
+			season,
+			pkgswagger.QueryParam("year", "integer", "Year of the month to compare", false),
+			pkgswagger.QueryParam("month", "integer", "Month to compare, 1-12", false),
+		},
+		Responses: map[int]any{http.StatusOK: dto.ComparisonResponse{}},
+	})
+	pkgswagger.Describe(h.SyncFromProvider, pkgswagger.Operation{
+		Summary:    "Import readings from the weather provider",
+		Parameters: []pkgswagger.Parameter{fieldID, from, to},
+		NoBody:     true,
+		Responses:  map[int]any{http.StatusOK: dto.StoredReadingsResponse{}},
+	})
+}
@@ -0,0 +1,378 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/rainfall/ports.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUseCases is a mock of UseCases interface.
+type MockUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasesMockRecorder
+}
+
+// MockUseCasesMockRecorder is the mock recorder for MockUseCases.
+type MockUseCasesMockRecorder struct {
+	mock *MockUseCases
+}
+
+// NewMockUseCases creates a new mock instance.
+func NewMockUseCases(ctrl *gomock.Controller) *MockUseCases {
+	mock := &MockUseCases{ctrl: ctrl}
+	mock.recorder = &MockUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CompareMonth mocks base method.
+func (m *MockUseCases) CompareMonth(ctx context.Context, fieldID int64, year int, month time.Month) (*domain.Comparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareMonth", ctx, fieldID, year, month)
+	ret0, _ := ret[0].(*domain.Comparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareMonth indicates an expected call of CompareMonth.
+func (mr *MockUseCasesMockRecorder) CompareMonth(ctx, fieldID, year, month interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareMonth", reflect.TypeOf((*MockUseCases)(nil).CompareMonth), ctx, fieldID, year, month)
+}
+
+// CompareSeason mocks base method.
+func (m *MockUseCases) CompareSeason(ctx context.Context, fieldID int64, season domain.Season) (*domain.Comparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareSeason", ctx, fieldID, season)
+	ret0, _ := ret[0].(*domain.Comparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareSeason indicates an expected call of CompareSeason.
+func (mr *MockUseCasesMockRecorder) CompareSeason(ctx, fieldID, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareSeason", reflect.TypeOf((*MockUseCases)(nil).CompareSeason), ctx, fieldID, season)
+}
+
+// CreateMonthReadings mocks base method.
+func (m *MockUseCases) CreateMonthReadings(ctx context.Context, fieldID int64, year int, month time.Month, readings []domain.Reading) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMonthReadings", ctx, fieldID, year, month, readings)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMonthReadings indicates an expected call of CreateMonthReadings.
+func (mr *MockUseCasesMockRecorder) CreateMonthReadings(ctx, fieldID, year, month, readings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMonthReadings", reflect.TypeOf((*MockUseCases)(nil).CreateMonthReadings), ctx, fieldID, year, month, readings)
+}
+
+// CreateReading mocks base method.
+func (m *MockUseCases) CreateReading(arg0 context.Context, arg1 *domain.Reading) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReading", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReading indicates an expected call of CreateReading.
+func (mr *MockUseCasesMockRecorder) CreateReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReading", reflect.TypeOf((*MockUseCases)(nil).CreateReading), arg0, arg1)
+}
+
+// DeleteReading mocks base method.
+func (m *MockUseCases) DeleteReading(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReading", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReading indicates an expected call of DeleteReading.
+func (mr *MockUseCasesMockRecorder) DeleteReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReading", reflect.TypeOf((*MockUseCases)(nil).DeleteReading), arg0, arg1)
+}
+
+// GetReading mocks base method.
+func (m *MockUseCases) GetReading(arg0 context.Context, arg1 int64) (*domain.Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReading", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReading indicates an expected call of GetReading.
+func (mr *MockUseCasesMockRecorder) GetReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReading", reflect.TypeOf((*MockUseCases)(nil).GetReading), arg0, arg1)
+}
+
+// ListReadings mocks base method.
+func (m *MockUseCases) ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadings", ctx, fieldID, from, to)
+	ret0, _ := ret[0].([]domain.Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReadings indicates an expected call of ListReadings.
+func (mr *MockUseCasesMockRecorder) ListReadings(ctx, fieldID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadings", reflect.TypeOf((*MockUseCases)(nil).ListReadings), ctx, fieldID, from, to)
+}
+
+// MonthlyAccumulation mocks base method.
+func (m *MockUseCases) MonthlyAccumulation(ctx context.Context, fieldID int64, year int) ([]domain.MonthlyAccumulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MonthlyAccumulation", ctx, fieldID, year)
+	ret0, _ := ret[0].([]domain.MonthlyAccumulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MonthlyAccumulation indicates an expected call of MonthlyAccumulation.
+func (mr *MockUseCasesMockRecorder) MonthlyAccumulation(ctx, fieldID, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonthlyAccumulation", reflect.TypeOf((*MockUseCases)(nil).MonthlyAccumulation), ctx, fieldID, year)
+}
+
+// SeasonAccumulation mocks base method.
+func (m *MockUseCases) SeasonAccumulation(ctx context.Context, fieldID int64, season domain.Season) (*domain.SeasonAccumulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAccumulation", ctx, fieldID, season)
+	ret0, _ := ret[0].(*domain.SeasonAccumulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAccumulation indicates an expected call of SeasonAccumulation.
+func (mr *MockUseCasesMockRecorder) SeasonAccumulation(ctx, fieldID, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAccumulation", reflect.TypeOf((*MockUseCases)(nil).SeasonAccumulation), ctx, fieldID, season)
+}
+
+// SyncFromProvider mocks base method.
+func (m *MockUseCases) SyncFromProvider(ctx context.Context, fieldID int64, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncFromProvider", ctx, fieldID, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncFromProvider indicates an expected call of SyncFromProvider.
+func (mr *MockUseCasesMockRecorder) SyncFromProvider(ctx, fieldID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncFromProvider", reflect.TypeOf((*MockUseCases)(nil).SyncFromProvider), ctx, fieldID, from, to)
+}
+
+// UpdateReading mocks base method.
+func (m *MockUseCases) UpdateReading(arg0 context.Context, arg1 *domain.Reading) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReading", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReading indicates an expected call of UpdateReading.
+func (mr *MockUseCasesMockRecorder) UpdateReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReading", reflect.TypeOf((*MockUseCases)(nil).UpdateReading), arg0, arg1)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReading mocks base method.
+func (m *MockRepository) CreateReading(arg0 context.Context, arg1 *domain.Reading) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReading", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReading indicates an expected call of CreateReading.
+func (mr *MockRepositoryMockRecorder) CreateReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReading", reflect.TypeOf((*MockRepository)(nil).CreateReading), arg0, arg1)
+}
+
+// DeleteReading mocks base method.
+func (m *MockRepository) DeleteReading(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReading", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReading indicates an expected call of DeleteReading.
+func (mr *MockRepositoryMockRecorder) DeleteReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReading", reflect.TypeOf((*MockRepository)(nil).DeleteReading), arg0, arg1)
+}
+
+// GetReading mocks base method.
+func (m *MockRepository) GetReading(arg0 context.Context, arg1 int64) (*domain.Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReading", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReading indicates an expected call of GetReading.
+func (mr *MockRepositoryMockRecorder) GetReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReading", reflect.TypeOf((*MockRepository)(nil).GetReading), arg0, arg1)
+}
+
+// ListReadings mocks base method.
+func (m *MockRepository) ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadings", ctx, fieldID, from, to)
+	ret0, _ := ret[0].([]domain.Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReadings indicates an expected call of ListReadings.
+func (mr *MockRepositoryMockRecorder) ListReadings(ctx, fieldID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadings", reflect.TypeOf((*MockRepository)(nil).ListReadings), ctx, fieldID, from, to)
+}
+
+// MonthlyTotals mocks base method.
+func (m *MockRepository) MonthlyTotals(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.MonthlyAccumulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MonthlyTotals", ctx, fieldID, from, to)
+	ret0, _ := ret[0].([]domain.MonthlyAccumulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MonthlyTotals indicates an expected call of MonthlyTotals.
+func (mr *MockRepositoryMockRecorder) MonthlyTotals(ctx, fieldID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonthlyTotals", reflect.TypeOf((*MockRepository)(nil).MonthlyTotals), ctx, fieldID, from, to)
+}
+
+// UpdateReading mocks base method.
+func (m *MockRepository) UpdateReading(arg0 context.Context, arg1 *domain.Reading) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReading", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReading indicates an expected call of UpdateReading.
+func (mr *MockRepositoryMockRecorder) UpdateReading(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReading", reflect.TypeOf((*MockRepository)(nil).UpdateReading), arg0, arg1)
+}
+
+// UpsertReadings mocks base method.
+func (m *MockRepository) UpsertReadings(arg0 context.Context, arg1 []domain.Reading) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReadings", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReadings indicates an expected call of UpsertReadings.
+func (mr *MockRepositoryMockRecorder) UpsertReadings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReadings", reflect.TypeOf((*MockRepository)(nil).UpsertReadings), arg0, arg1)
+}
+
+// MockProvider is a mock of Provider interface.
+type MockProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderMockRecorder
+}
+
+// MockProviderMockRecorder is the mock recorder for MockProvider.
+type MockProviderMockRecorder struct {
+	mock *MockProvider
+}
+
+// NewMockProvider creates a new mock instance.
+func NewMockProvider(ctrl *gomock.Controller) *MockProvider {
+	mock := &MockProvider{ctrl: ctrl}
+	mock.recorder = &MockProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method.
+func (m *MockProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockProvider)(nil).Name))
+}
+
+// Readings mocks base method.
+func (m *MockProvider) Readings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Readings", ctx, fieldID, from, to)
+	ret0, _ := ret[0].([]domain.Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Readings indicates an expected call of Readings.
+func (mr *MockProviderMockRecorder) Readings(ctx, fieldID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readings", reflect.TypeOf((*MockProvider)(nil).Readings), ctx, fieldID, from, to)
+}
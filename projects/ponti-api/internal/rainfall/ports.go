@@ -0,0 +1,39 @@
+package rainfall
+
+import (
+	"context"
+	"time"
+
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+type UseCases interface {
+	CreateReading(context.Context, *domain.Reading) (int64, error)
+	CreateMonthReadings(ctx context.Context, fieldID int64, year int, month time.Month, readings []domain.Reading) (int, error)
+	ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error)
+	GetReading(context.Context, int64) (*domain.Reading, error)
+	UpdateReading(context.Context, *domain.Reading) error
+	DeleteReading(context.Context, int64) error
+	MonthlyAccumulation(ctx context.Context, fieldID int64, year int) ([]domain.MonthlyAccumulation, error)
+	SeasonAccumulation(ctx context.Context, fieldID int64, season domain.Season) (*domain.SeasonAccumulation, error)
+	CompareMonth(ctx context.Context, fieldID int64, year int, month time.Month) (*domain.Comparison, error)
+	CompareSeason(ctx context.Context, fieldID int64, season domain.Season) (*domain.Comparison, error)
+	SyncFromProvider(ctx context.Context, fieldID int64, from, to time.Time) (int, error)
+}
+
+type Repository interface {
+	CreateReading(context.Context, *domain.Reading) (int64, error)
+	UpsertReadings(context.Context, []domain.Reading) (int, error)
+	ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error)
+	GetReading(context.Context, int64) (*domain.Reading, error)
+	UpdateReading(context.Context, *domain.Reading) error
+	DeleteReading(context.Context, int64) error
+	MonthlyTotals(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.MonthlyAccumulation, error)
+}
+
+// Provider is a source of readings outside the API, e.g. a weather service.
+// Readings returned for days that already exist overwrite the stored ones.
+type Provider interface {
+	Name() string
+	Readings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error)
+}
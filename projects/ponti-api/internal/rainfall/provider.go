@@ -0,0 +1,72 @@
+package rainfall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// fileReading is one entry of the JSON file read by the file provider.
+type fileReading struct {
+	FieldID     int64   `json:"field_id"`
+	Date        string  `json:"date"` // YYYY-MM-DD
+	Millimeters float64 `json:"millimeters"`
+	Observer    string  `json:"observer"`
+}
+
+// fileProvider serves readings from a JSON file. It stands in for a weather
+// service until a real adapter is written.
+type fileProvider struct {
+	path string
+}
+
+// NewFileProvider creates a Provider backed by a JSON array of readings stored at path.
+func NewFileProvider(path string) Provider {
+	return &fileProvider{path: path}
+}
+
+func (p *fileProvider) Name() string {
+	return "file"
+}
+
+// Readings returns the readings of fieldID in [from, to). The file is read on each
+// call so it can be replaced without restarting the API.
+func (p *fileProvider) Readings(_ context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	if p.path == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrOperationFailed, "rainfall provider file is not configured", nil)
+	}
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrOperationFailed, "failed to read rainfall provider file", err)
+	}
+	var entries []fileReading
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrOperationFailed, "invalid rainfall provider file", err)
+	}
+
+	var result []domain.Reading
+	for _, e := range entries {
+		if e.FieldID != fieldID {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, e.Date)
+		if err != nil {
+			return nil, pkgtypes.NewError(pkgtypes.ErrOperationFailed, fmt.Sprintf("invalid date %q in rainfall provider file", e.Date), err)
+		}
+		if date.Before(from) || !date.Before(to) {
+			continue
+		}
+		result = append(result, domain.Reading{
+			FieldID:     e.FieldID,
+			Date:        date,
+			Millimeters: e.Millimeters,
+			Observer:    e.Observer,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,174 @@
+package rainfall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/repository/models"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+type repository struct {
+	db gorm.Repository
+}
+
+// NewRepository creates a new GORM repository for rainfall readings.
+func NewRepository(db gorm.Repository) Repository {
+	return &repository{db: db}
+}
+
+// CreateReading persists a single reading and returns its autogenerated ID.
+func (r *repository) CreateReading(ctx context.Context, d *domain.Reading) (int64, error) {
+	if d == nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "reading is nil", nil)
+	}
+	model := models.FromDomain(d)
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return 0, pkgtypes.NewError(pkgtypes.ErrConflict, fmt.Sprintf("field %d already has a reading for %s", d.FieldID, d.Date.Format(time.DateOnly)), err)
+		}
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create reading", err)
+	}
+	return model.ID, nil
+}
+
+// UpsertReadings stores several readings at once; a reading for a day that already
+// exists replaces the stored one.
+func (r *repository) UpsertReadings(ctx context.Context, readings []domain.Reading) (int, error) {
+	if len(readings) == 0 {
+		return 0, nil
+	}
+	list := make([]models.RainfallReading, 0, len(readings))
+	for i := range readings {
+		list = append(list, *models.FromDomain(&readings[i]))
+	}
+	result := r.db.Client().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "field_id"}, {Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"millimeters", "observer", "updated_at"}),
+		}).
+		Create(&list)
+	if result.Error != nil {
+		return 0, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to store readings", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// ListReadings returns the readings of a field in [from, to), ordered by date.
+// A zero from or to leaves that end open.
+func (r *repository) ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	var list []models.RainfallReading
+	q := r.db.Client().WithContext(ctx).Where("field_id = ?", fieldID)
+	if !from.IsZero() {
+		q = q.Where("date >= ?", from)
+	}
+	if !to.IsZero() {
+		q = q.Where("date < ?", to)
+	}
+	if err := q.Order("date").Find(&list).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list readings", err)
+	}
+	result := make([]domain.Reading, 0, len(list))
+	for _, m := range list {
+		result = append(result, *m.ToDomain())
+	}
+	return result, nil
+}
+
+// GetReading retrieves a reading by its ID.
+func (r *repository) GetReading(ctx context.Context, id int64) (*domain.Reading, error) {
+	var model models.RainfallReading
+	err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.RainfallReading{}, id); err != nil {
+				return nil, err
+			}
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("reading with id %d not found", id), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get reading", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// UpdateReading updates an existing reading.
+func (r *repository) UpdateReading(ctx context.Context, d *domain.Reading) error {
+	if d == nil {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "reading is nil", nil)
+	}
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.RainfallReading{}).
+		Where("id = ?", d.ID).
+		Select("field_id", "date", "millimeters", "observer").
+		Updates(models.FromDomain(d))
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return pkgtypes.NewError(pkgtypes.ErrConflict, fmt.Sprintf("field %d already has a reading for %s", d.FieldID, d.Date.Format(time.DateOnly)), result.Error)
+		}
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update reading", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.RainfallReading{}, d.ID); err != nil {
+			return err
+		}
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("reading with id %d does not exist", d.ID), nil)
+	}
+	return nil
+}
+
+// DeleteReading deletes a reading by its ID.
+func (r *repository) DeleteReading(ctx context.Context, id int64) error {
+	result := r.db.Client().WithContext(ctx).
+		Delete(&models.RainfallReading{}, "id = ?", id)
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete reading", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if err := gorm.CrossTenantError(r.db.Client().WithContext(ctx), &models.RainfallReading{}, id); err != nil {
+			return err
+		}
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("reading with id %d does not exist", id), nil)
+	}
+	return nil
+}
+
+// MonthlyTotals sums the readings of a field per calendar month in [from, to).
+// Only months with at least one reading are returned. A zero from or to leaves
+// that end open.
+func (r *repository) MonthlyTotals(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.MonthlyAccumulation, error) {
+	var rows []models.MonthlyTotal
+	q := r.db.Client().WithContext(ctx).
+		Model(&models.RainfallReading{}).
+		Select("CAST(EXTRACT(YEAR FROM date) AS INTEGER) AS year, "+
+			"CAST(EXTRACT(MONTH FROM date) AS INTEGER) AS month, "+
+			"SUM(millimeters) AS millimeters, COUNT(*) AS readings").
+		Where("field_id = ?", fieldID)
+	if !from.IsZero() {
+		q = q.Where("date >= ?", from)
+	}
+	if !to.IsZero() {
+		q = q.Where("date < ?", to)
+	}
+	if err := q.Group("year, month").Order("year, month").Scan(&rows).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to aggregate readings", err)
+	}
+	result := make([]domain.MonthlyAccumulation, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row.ToDomain(fieldID))
+	}
+	return result, nil
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	pkggorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// RainfallReading is the GORM model for a daily rain gauge reading. A field has
+// at most one reading per day.
+type RainfallReading struct {
+	pkggorm.TenantScoped
+
+	ID          int64     `gorm:"primaryKey"`
+	FieldID     int64     `gorm:"not null;uniqueIndex:idx_rainfall_field_date;column:field_id"`
+	Date        time.Time `gorm:"type:date;not null;uniqueIndex:idx_rainfall_field_date"`
+	Millimeters float64   `gorm:"type:decimal(7,2);not null"`
+	Observer    string    `gorm:"size:100"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (m *RainfallReading) ToDomain() *domain.Reading {
+	return &domain.Reading{
+		ID:          m.ID,
+		FieldID:     m.FieldID,
+		Date:        m.Date,
+		Millimeters: m.Millimeters,
+		Observer:    m.Observer,
+	}
+}
+
+func FromDomain(d *domain.Reading) *RainfallReading {
+	return &RainfallReading{
+		ID:          d.ID,
+		FieldID:     d.FieldID,
+		Date:        d.Date,
+		Millimeters: d.Millimeters,
+		Observer:    d.Observer,
+	}
+}
+
+// MonthlyTotal is the row returned by the monthly aggregation query.
+type MonthlyTotal struct {
+	Year        int
+	Month       int
+	Millimeters float64
+	Readings    int
+}
+
+func (m MonthlyTotal) ToDomain(fieldID int64) domain.MonthlyAccumulation {
+	return domain.MonthlyAccumulation{
+		FieldID:     fieldID,
+		Year:        m.Year,
+		Month:       time.Month(m.Month),
+		Millimeters: m.Millimeters,
+		Readings:    m.Readings,
+	}
+}
@@ -0,0 +1,250 @@
+package rainfall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+)
+
+// maxDailyMillimeters rejects readings that are certainly typing mistakes.
+const maxDailyMillimeters = 500
+
+type useCases struct {
+	repo     Repository
+	field    field.UseCases
+	provider Provider
+}
+
+func NewUseCases(repo Repository, field field.UseCases, provider Provider) UseCases {
+	return &useCases{
+		repo:     repo,
+		field:    field,
+		provider: provider,
+	}
+}
+
+func (u *useCases) CreateReading(ctx context.Context, r *domain.Reading) (int64, error) {
+	if err := validateReading(r); err != nil {
+		return 0, err
+	}
+	if _, err := u.field.GetField(ctx, r.FieldID); err != nil {
+		return 0, err
+	}
+	r.Date = truncateDay(r.Date)
+	return u.repo.CreateReading(ctx, r)
+}
+
+// CreateMonthReadings stores the readings of a whole month for a field. Days that
+// already have a reading are overwritten.
+func (u *useCases) CreateMonthReadings(ctx context.Context, fieldID int64, year int, month time.Month, readings []domain.Reading) (int, error) {
+	if month < time.January || month > time.December {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("invalid month %d", month), nil)
+	}
+	if len(readings) == 0 {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "no readings to store", nil)
+	}
+	if _, err := u.field.GetField(ctx, fieldID); err != nil {
+		return 0, err
+	}
+
+	seen := make(map[int]bool, len(readings))
+	for i := range readings {
+		r := &readings[i]
+		r.FieldID = fieldID
+		r.Date = truncateDay(r.Date)
+		if err := validateReading(r); err != nil {
+			return 0, err
+		}
+		if r.Date.Year() != year || r.Date.Month() != month {
+			return 0, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("reading of %s is outside %d-%02d", r.Date.Format(time.DateOnly), year, month), nil)
+		}
+		if seen[r.Date.Day()] {
+			return 0, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("duplicated reading for %s", r.Date.Format(time.DateOnly)), nil)
+		}
+		seen[r.Date.Day()] = true
+	}
+	return u.repo.UpsertReadings(ctx, readings)
+}
+
+func (u *useCases) ListReadings(ctx context.Context, fieldID int64, from, to time.Time) ([]domain.Reading, error) {
+	return u.repo.ListReadings(ctx, fieldID, from, to)
+}
+
+func (u *useCases) GetReading(ctx context.Context, id int64) (*domain.Reading, error) {
+	return u.repo.GetReading(ctx, id)
+}
+
+func (u *useCases) UpdateReading(ctx context.Context, r *domain.Reading) error {
+	if err := validateReading(r); err != nil {
+		return err
+	}
+	r.Date = truncateDay(r.Date)
+	return u.repo.UpdateReading(ctx, r)
+}
+
+func (u *useCases) DeleteReading(ctx context.Context, id int64) error {
+	return u.repo.DeleteReading(ctx, id)
+}
+
+// MonthlyAccumulation returns the twelve months of the year, with zero for months without readings.
+func (u *useCases) MonthlyAccumulation(ctx context.Context, fieldID int64, year int) ([]domain.MonthlyAccumulation, error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	totals, err := u.repo.MonthlyTotals(ctx, fieldID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+	return fillMonths(fieldID, from, 12, totals), nil
+}
+
+// SeasonAccumulation returns the total of the season and its months from July to June.
+func (u *useCases) SeasonAccumulation(ctx context.Context, fieldID int64, season domain.Season) (*domain.SeasonAccumulation, error) {
+	totals, err := u.repo.MonthlyTotals(ctx, fieldID, season.From(), season.To())
+	if err != nil {
+		return nil, err
+	}
+	acc := &domain.SeasonAccumulation{
+		FieldID: fieldID,
+		Season:  season,
+		Months:  fillMonths(fieldID, season.From(), 12, totals),
+	}
+	for _, m := range totals {
+		acc.Millimeters += m.Millimeters
+		acc.Readings += m.Readings
+	}
+	return acc, nil
+}
+
+// CompareMonth compares a month against the same month of every previous year with readings.
+func (u *useCases) CompareMonth(ctx context.Context, fieldID int64, year int, month time.Month) (*domain.Comparison, error) {
+	if month < time.January || month > time.December {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("invalid month %d", month), nil)
+	}
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	totals, err := u.repo.MonthlyTotals(ctx, fieldID, time.Time{}, start.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	var current float64
+	var history []float64
+	for _, m := range totals {
+		if m.Month != month {
+			continue
+		}
+		if m.Year == year {
+			current = m.Millimeters
+		} else {
+			history = append(history, m.Millimeters)
+		}
+	}
+	return domain.NewComparison(fieldID, start.Format("2006-01"), current, history), nil
+}
+
+// CompareSeason compares a season against every previous season with readings.
+func (u *useCases) CompareSeason(ctx context.Context, fieldID int64, season domain.Season) (*domain.Comparison, error) {
+	totals, err := u.repo.MonthlyTotals(ctx, fieldID, time.Time{}, season.To())
+	if err != nil {
+		return nil, err
+	}
+
+	bySeason := make(map[int]float64)
+	for _, m := range totals {
+		s := domain.SeasonOf(time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.UTC))
+		bySeason[s.StartYear] += m.Millimeters
+	}
+	current := bySeason[season.StartYear]
+	delete(bySeason, season.StartYear)
+
+	history := make([]float64, 0, len(bySeason))
+	for _, total := range bySeason {
+		history = append(history, total)
+	}
+	return domain.NewComparison(fieldID, season.String(), current, history), nil
+}
+
+// SyncFromProvider imports the provider readings of [from, to) for the field.
+// When the provider reports a day more than once its last reading wins, since
+// a batch upsert cannot touch the same row twice.
+func (u *useCases) SyncFromProvider(ctx context.Context, fieldID int64, from, to time.Time) (int, error) {
+	if !from.Before(to) {
+		return 0, pkgtypes.NewError(pkgtypes.ErrValidation, "from must be before to", nil)
+	}
+	if _, err := u.field.GetField(ctx, fieldID); err != nil {
+		return 0, err
+	}
+	readings, err := u.provider.Readings(ctx, fieldID, from, to)
+	if err != nil {
+		return 0, err
+	}
+	for i := range readings {
+		r := &readings[i]
+		r.FieldID = fieldID
+		r.Date = truncateDay(r.Date)
+		if r.Observer == "" {
+			r.Observer = u.provider.Name()
+		}
+		if err := validateReading(r); err != nil {
+			return 0, err
+		}
+	}
+	return u.repo.UpsertReadings(ctx, lastReadingPerDay(readings))
+}
+
+// helpers
+func validateReading(r *domain.Reading) error {
+	if r == nil {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "reading is nil", nil)
+	}
+	if r.FieldID <= 0 {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "field_id is required", nil)
+	}
+	if r.Date.IsZero() {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "date is required", nil)
+	}
+	if r.Millimeters < 0 || r.Millimeters > maxDailyMillimeters {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("millimeters must be between 0 and %d", maxDailyMillimeters), nil)
+	}
+	return nil
+}
+
+// lastReadingPerDay keeps one reading per day, the last one, in the order the days
+// first appear. Dates must already be truncated.
+func lastReadingPerDay(readings []domain.Reading) []domain.Reading {
+	index := make(map[time.Time]int, len(readings))
+	unique := make([]domain.Reading, 0, len(readings))
+	for _, r := range readings {
+		if i, ok := index[r.Date]; ok {
+			unique[i] = r
+			continue
+		}
+		index[r.Date] = len(unique)
+		unique = append(unique, r)
+	}
+	return unique
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// fillMonths returns n consecutive months starting at from, taking the totals that exist.
+func fillMonths(fieldID int64, from time.Time, n int, totals []domain.MonthlyAccumulation) []domain.MonthlyAccumulation {
+	byMonth := make(map[string]domain.MonthlyAccumulation, len(totals))
+	for _, m := range totals {
+		byMonth[fmt.Sprintf("%d-%02d", m.Year, m.Month)] = m
+	}
+	months := make([]domain.MonthlyAccumulation, 0, n)
+	for i := 0; i < n; i++ {
+		t := from.AddDate(0, i, 0)
+		m, ok := byMonth[fmt.Sprintf("%d-%02d", t.Year(), t.Month())]
+		if !ok {
+			m = domain.MonthlyAccumulation{FieldID: fieldID, Year: t.Year(), Month: t.Month()}
+		}
+		months = append(months, m)
+	}
+	return months
+}
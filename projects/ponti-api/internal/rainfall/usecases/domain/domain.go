@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reading is a rain gauge reading taken on a field for a given day.
+type Reading struct {
+	ID          int64
+	FieldID     int64
+	Date        time.Time // day of the reading (time part ignored)
+	Millimeters float64
+	Observer    string // who read the gauge, or the provider name
+}
+
+// MonthlyAccumulation is the rain accumulated on a field during a calendar month.
+type MonthlyAccumulation struct {
+	FieldID     int64
+	Year        int
+	Month       time.Month
+	Millimeters float64
+	Readings    int
+}
+
+// SeasonAccumulation is the rain accumulated during an agricultural season.
+type SeasonAccumulation struct {
+	FieldID     int64
+	Season      Season
+	Millimeters float64
+	Readings    int
+	Months      []MonthlyAccumulation
+}
+
+// Comparison contrasts a period against the historical average of the same field.
+type Comparison struct {
+	FieldID           int64
+	Period            string
+	Millimeters       float64
+	HistoricalAverage float64
+	SamplePeriods     int     // previous periods used for the average
+	Difference        float64 // Millimeters - HistoricalAverage
+	DifferencePercent float64 // relative to the historical average, 0 when there is no history
+}
+
+// Season is an agricultural season running from July 1st to June 30th, e.g. "2024/2025".
+type Season struct {
+	StartYear int
+}
+
+// ParseSeason accepts "2024/2025", "2024-2025" or just the starting year.
+func ParseSeason(s string) (Season, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '/' || r == '-' })
+	if len(parts) == 0 || len(parts) > 2 {
+		return Season{}, fmt.Errorf("invalid season %q", s)
+	}
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Season{}, fmt.Errorf("invalid season %q", s)
+	}
+	if len(parts) == 2 {
+		end, err := strconv.Atoi(parts[1])
+		if err != nil || end != start+1 {
+			return Season{}, fmt.Errorf("invalid season %q", s)
+		}
+	}
+	return Season{StartYear: start}, nil
+}
+
+func (s Season) String() string {
+	return fmt.Sprintf("%d/%d", s.StartYear, s.StartYear+1)
+}
+
+// From returns the first day of the season.
+func (s Season) From() time.Time {
+	return time.Date(s.StartYear, time.July, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// To returns the first day after the season.
+func (s Season) To() time.Time {
+	return time.Date(s.StartYear+1, time.July, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SeasonOf returns the season a date belongs to.
+func SeasonOf(t time.Time) Season {
+	if t.Month() >= time.July {
+		return Season{StartYear: t.Year()}
+	}
+	return Season{StartYear: t.Year() - 1}
+}
+
+// NewComparison fills the difference fields from the total and the history.
+func NewComparison(fieldID int64, period string, total float64, history []float64) *Comparison {
+	c := &Comparison{
+		FieldID:       fieldID,
+		Period:        period,
+		Millimeters:   total,
+		SamplePeriods: len(history),
+	}
+	if len(history) == 0 {
+		return c
+	}
+	var sum float64
+	for _, h := range history {
+		sum += h
+	}
+	c.HistoricalAverage = sum / float64(len(history))
+	c.Difference = c.Millimeters - c.HistoricalAverage
+	if c.HistoricalAverage != 0 {
+		c.DifferencePercent = c.Difference / c.HistoricalAverage * 100
+	}
+	return c
+}
@@ -0,0 +1,219 @@
+package rainfall
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	fieldmocks "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/mocks"
+	fielddom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field/usecases/domain"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/mocks"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall/usecases/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func month(year int, m time.Month, mm float64) domain.MonthlyAccumulation {
+	return domain.MonthlyAccumulation{FieldID: 1, Year: year, Month: m, Millimeters: mm, Readings: 1}
+}
+
+func TestCompareMonth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		totals      []domain.MonthlyAccumulation
+		wantTotal   float64
+		wantAverage float64
+		wantSamples int
+		wantPercent float64
+	}{
+		{
+			name: "averages the same month of previous years",
+			totals: []domain.MonthlyAccumulation{
+				month(2023, time.March, 100),
+				month(2023, time.April, 40),
+				month(2024, time.March, 140),
+				month(2025, time.March, 90),
+			},
+			wantTotal:   90,
+			wantAverage: 120,
+			wantSamples: 2,
+			wantPercent: -25,
+		},
+		{
+			name:        "no history",
+			totals:      []domain.MonthlyAccumulation{month(2025, time.March, 30)},
+			wantTotal:   30,
+			wantSamples: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(ctrl)
+			uc := NewUseCases(repo, fieldmocks.NewMockUseCases(ctrl), mocks.NewMockProvider(ctrl))
+
+			repo.EXPECT().
+				MonthlyTotals(gomock.Any(), int64(1), time.Time{}, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)).
+				Return(tt.totals, nil)
+
+			got, err := uc.CompareMonth(context.Background(), 1, 2025, time.March)
+			assert.NoError(t, err)
+			assert.Equal(t, "2025-03", got.Period)
+			assert.Equal(t, tt.wantTotal, got.Millimeters)
+			assert.Equal(t, tt.wantAverage, got.HistoricalAverage)
+			assert.Equal(t, tt.wantSamples, got.SamplePeriods)
+			assert.InDelta(t, tt.wantPercent, got.DifferencePercent, 0.001)
+		})
+	}
+}
+
+func TestCompareSeason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	uc := NewUseCases(repo, fieldmocks.NewMockUseCases(ctrl), mocks.NewMockProvider(ctrl))
+	season := domain.Season{StartYear: 2024}
+
+	repo.EXPECT().
+		MonthlyTotals(gomock.Any(), int64(1), time.Time{}, season.To()).
+		Return([]domain.MonthlyAccumulation{
+			month(2022, time.December, 300), // 2022/2023
+			month(2023, time.May, 100),      // 2022/2023
+			month(2023, time.October, 500),  // 2023/2024
+			month(2024, time.July, 200),     // 2024/2025
+			month(2025, time.January, 250),  // 2024/2025
+		}, nil)
+
+	got, err := uc.CompareSeason(context.Background(), 1, season)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024/2025", got.Period)
+	assert.Equal(t, 450.0, got.Millimeters)
+	assert.Equal(t, 450.0, got.HistoricalAverage)
+	assert.Equal(t, 2, got.SamplePeriods)
+	assert.Equal(t, 0.0, got.Difference)
+}
+
+func TestCreateMonthReadings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	day := func(d int, mm float64) domain.Reading {
+		return domain.Reading{Date: time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC), Millimeters: mm}
+	}
+
+	tests := []struct {
+		name     string
+		readings []domain.Reading
+		setup    func(repo *mocks.MockRepository)
+		wantErr  bool
+	}{
+		{
+			name:     "stores the month",
+			readings: []domain.Reading{day(1, 12), day(2, 0), day(15, 33.5)},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().UpsertReadings(gomock.Any(), gomock.Len(3)).Return(3, nil)
+			},
+		},
+		{
+			name:     "rejects duplicated days",
+			readings: []domain.Reading{day(1, 12), day(1, 4)},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects two readings of the same day at different hours",
+			readings: []domain.Reading{day(1, 12), {Date: time.Date(2025, time.March, 1, 18, 30, 0, 0, time.UTC), Millimeters: 4}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects readings of another month",
+			readings: []domain.Reading{day(1, 12), {Date: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects negative millimeters",
+			readings: []domain.Reading{day(3, -1)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(ctrl)
+			fieldUC := fieldmocks.NewMockUseCases(ctrl)
+			uc := NewUseCases(repo, fieldUC, mocks.NewMockProvider(ctrl))
+
+			fieldUC.EXPECT().GetField(gomock.Any(), int64(1)).Return(&fielddom.Field{ID: 1}, nil)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			_, err := uc.CreateMonthReadings(context.Background(), 1, 2025, time.March, tt.readings)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSyncFromProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d, hour int, mm float64) domain.Reading {
+		return domain.Reading{Date: time.Date(2025, time.March, d, hour, 0, 0, 0, time.UTC), Millimeters: mm}
+	}
+
+	tests := []struct {
+		name     string
+		readings []domain.Reading
+		want     []domain.Reading
+	}{
+		{
+			name:     "stores one reading per day",
+			readings: []domain.Reading{at(1, 0, 12), at(2, 0, 3)},
+			want:     []domain.Reading{at(1, 0, 12), at(2, 0, 3)},
+		},
+		{
+			name:     "the last reading of a repeated day wins",
+			readings: []domain.Reading{at(1, 6, 12), at(2, 0, 3), at(1, 18, 20)},
+			want:     []domain.Reading{at(1, 0, 20), at(2, 0, 3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(ctrl)
+			fieldUC := fieldmocks.NewMockUseCases(ctrl)
+			provider := mocks.NewMockProvider(ctrl)
+			uc := NewUseCases(repo, fieldUC, provider)
+
+			fieldUC.EXPECT().GetField(gomock.Any(), int64(1)).Return(&fielddom.Field{ID: 1}, nil)
+			provider.EXPECT().Readings(gomock.Any(), int64(1), from, to).Return(tt.readings, nil)
+			provider.EXPECT().Name().Return("file").AnyTimes()
+
+			var stored []domain.Reading
+			repo.EXPECT().UpsertReadings(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, readings []domain.Reading) (int, error) {
+					stored = readings
+					return len(readings), nil
+				})
+
+			n, err := uc.SyncFromProvider(context.Background(), 1, from, to)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.want), n)
+			for i := range tt.want {
+				tt.want[i].FieldID = 1
+				tt.want[i].Observer = "file"
+			}
+			assert.Equal(t, tt.want, stored)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	gsv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	project "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project"
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/handler/dto"
+)
+
+// Handler encapsulates dependencies for the global search HTTP handler.
+type Handler struct {
+	ucs UseCases
+	gsv gsv.Server
+	mws *mdw.Middlewares
+}
+
+// NewHandler creates a new Search handler.
+func NewHandler(s gsv.Server, u UseCases, m *mdw.Middlewares) *Handler {
+	return &Handler{ucs: u, gsv: s, mws: m}
+}
+
+// Routes registers HTTP routes for the global search.
+func (h *Handler) Routes() {
+	router := h.gsv.GetRouter()
+
+	apiVersion := h.gsv.GetApiVersion()
+	apiBase := "/api/" + apiVersion + "/search"
+
+	protected := router.Group(apiBase)
+	{
+		protected.Use(h.mws.Protected...)
+		protected.Use(h.mws.Tenant...)
+		protected.GET("", h.mws.RequirePermission("search:read"), h.Search)
+	}
+
+	h.describeRoutes()
+}
+
+// Search handles GET /search?q=
+func (h *Handler) Search(c *gin.Context) {
+	ctx := c.Request.Context()
+	if mdw.HasPermission(c.GetStringSlice(mdw.PermissionsContextKey), project.ManagePermission) {
+		ctx = withAllProjects(ctx)
+	}
+	result, err := h.ucs.Search(ctx, c.Query("q"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomain(result))
+}
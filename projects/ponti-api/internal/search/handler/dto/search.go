@@ -0,0 +1,41 @@
+package dto
+
+import (
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/usecases/domain"
+)
+
+type Hit struct {
+	ID    string  `json:"id"`
+	Text  string  `json:"text"`
+	Score float64 `json:"score"`
+}
+
+type Group struct {
+	Type    string `json:"type"`
+	Count   int    `json:"count"`
+	Results []Hit  `json:"results"`
+}
+
+// SearchResponse is the response of GET /search.
+type SearchResponse struct {
+	Query  string  `json:"query"`
+	Total  int     `json:"total"`
+	Groups []Group `json:"groups"`
+}
+
+// FromDomain converts a domain.Result into the response DTO.
+func FromDomain(d *domain.Result) *SearchResponse {
+	resp := &SearchResponse{
+		Query:  d.Query,
+		Total:  d.Total,
+		Groups: make([]Group, 0, len(d.Groups)),
+	}
+	for _, g := range d.Groups {
+		group := Group{Type: g.Type, Count: len(g.Hits), Results: make([]Hit, 0, len(g.Hits))}
+		for _, h := range g.Hits {
+			group.Results = append(group.Results, Hit{ID: h.ID, Text: h.Text, Score: h.Score})
+		}
+		resp.Groups = append(resp.Groups, group)
+	}
+	return resp
+}
@@ -0,0 +1,18 @@
+package search
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/handler/dto"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	pkgswagger.Describe(h.Search, pkgswagger.Operation{
+		Summary:    "Search across the tenant's entities",
+		Parameters: []pkgswagger.Parameter{pkgswagger.QueryParam("q", "string", "Search text", true)},
+		Responses:  map[int]any{http.StatusOK: dto.SearchResponse{}},
+	})
+}
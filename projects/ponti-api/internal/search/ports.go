@@ -0,0 +1,18 @@
+package search
+
+import (
+	"context"
+
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/usecases/domain"
+)
+
+type UseCases interface {
+	Search(ctx context.Context, query string) (*domain.Result, error)
+}
+
+type Repository interface {
+	// Search returns the hits of every entity ordered by similarity.
+	Search(ctx context.Context, query string) ([]domain.Hit, error)
+	// EnsureIndexes creates the pg_trgm extension and the GIN trigram indexes.
+	EnsureIndexes(ctx context.Context) error
+}
@@ -0,0 +1,87 @@
+package search
+
+import (
+	"context"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgsuggester "github.com/alphacodinggroup/ponti-backend/pkg/words-suggestors/pg_trgm-gin"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/usecases/domain"
+)
+
+// sources lists the tables and columns covered by the global search. Every source
+// is restricted to the organization of the request, and projects to the ones the
+// caller is a member of.
+var sources = []pkgsuggester.Source{
+	{Name: domain.TypeCustomer, Table: "customers", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn},
+	{Name: domain.TypeProject, Table: "projects", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn, Restrict: projectMembership},
+	{Name: domain.TypeField, Table: "fields", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn},
+	{Name: domain.TypeLot, Table: "lots", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn},
+	{Name: domain.TypeManager, Table: "managers", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn},
+	{Name: domain.TypeInvestor, Table: "investors", Columns: []string{"name"}, ScopeColumn: gorm.TenantColumn},
+	{Name: domain.TypePerson, Table: "people", Columns: []string{"first_name", "last_name"}, ScopeColumn: gorm.TenantColumn, Filter: "deleted_at IS NULL"},
+}
+
+type repository struct {
+	searcher pkgsuggester.Searcher
+}
+
+// NewRepository creates the search repository on top of the GORM connection.
+// limit is the maximum number of hits per entity type.
+func NewRepository(db gorm.Repository, limit int) (Repository, error) {
+	searcher, err := pkgsuggester.NewSearcher(db.Client(), sources,
+		pkgsuggester.WithLimit(limit),
+		pkgsuggester.WithScope(tenantScope),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &repository{searcher: searcher}, nil
+}
+
+func tenantScope(ctx context.Context) (any, bool) {
+	return pkgtypes.TenantIDFromContext(ctx)
+}
+
+type allProjectsKey struct{}
+
+// withAllProjects marks the context so every project of the organization is
+// searched. The handler uses it for callers holding project.ManagePermission.
+func withAllProjects(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allProjectsKey{}, true)
+}
+
+// projectMembership limits projects to the memberships of the caller, as the
+// project module does.
+func projectMembership(ctx context.Context) (string, []any) {
+	if all, _ := ctx.Value(allProjectsKey{}).(bool); all {
+		return "", nil
+	}
+	userID, ok := pkgtypes.UserIDFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	return "id IN (SELECT project_id FROM project_members WHERE user_id = ?)", []any{userID}
+}
+
+func (r *repository) Search(ctx context.Context, query string) ([]domain.Hit, error) {
+	if _, ok := pkgtypes.TenantIDFromContext(ctx); !ok {
+		return nil, pkgtypes.NewError(pkgtypes.ErrAuthorization, "organization is required to search", nil)
+	}
+	matches, err := r.searcher.Search(ctx, query)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to search", err)
+	}
+	hits := make([]domain.Hit, 0, len(matches))
+	for _, m := range matches {
+		hits = append(hits, domain.Hit{Type: m.Source, ID: m.ID, Text: m.Text, Score: m.Score})
+	}
+	return hits, nil
+}
+
+func (r *repository) EnsureIndexes(ctx context.Context) error {
+	if err := r.searcher.EnsureIndexes(ctx); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create search indexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pkgsuggester "github.com/alphacodinggroup/ponti-backend/pkg/words-suggestors/pg_trgm-gin"
+)
+
+type fakeSearcher struct {
+	calls int
+}
+
+func (f *fakeSearcher) Search(context.Context, string) ([]pkgsuggester.Match, error) {
+	f.calls++
+	return []pkgsuggester.Match{{Source: "projects", ID: "1", Text: "Norte", Score: 0.8}}, nil
+}
+
+func (f *fakeSearcher) EnsureIndexes(context.Context) error { return nil }
+
+func TestRepositorySearchRequiresTenant(t *testing.T) {
+	searcher := &fakeSearcher{}
+	repo := &repository{searcher: searcher}
+
+	_, err := repo.Search(context.Background(), "norte")
+	errType, _ := pkgtypes.GetErrorType(err)
+	assert.Equal(t, pkgtypes.ErrAuthorization, errType)
+	assert.Zero(t, searcher.calls)
+
+	hits, err := repo.Search(pkgtypes.WithTenantID(context.Background(), 3), "norte")
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+}
+
+func TestEverySourceIsTenantScoped(t *testing.T) {
+	for _, source := range sources {
+		assert.Equal(t, gorm.TenantColumn, source.ScopeColumn, "%s is searched across organizations", source.Name)
+	}
+}
+
+func TestProjectMembership(t *testing.T) {
+	member := pkgtypes.WithUserID(context.Background(), "u1")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCond bool
+	}{
+		{name: "members only see their projects", ctx: member, wantCond: true},
+		{name: "managers see every project", ctx: withAllProjects(member)},
+		{name: "internal calls are not restricted", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, args := projectMembership(tt.ctx)
+			if !tt.wantCond {
+				assert.Empty(t, condition)
+				assert.Empty(t, args)
+				return
+			}
+			assert.Equal(t, "id IN (SELECT project_id FROM project_members WHERE user_id = ?)", condition)
+			assert.Equal(t, []any{"u1"}, args)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/usecases/domain"
+)
+
+// minQueryLength avoids scanning every row for one-letter queries, which
+// trigrams cannot narrow down.
+const minQueryLength = 2
+
+type useCases struct {
+	repo Repository
+}
+
+func NewUseCases(repo Repository) UseCases {
+	return &useCases{repo: repo}
+}
+
+func (u *useCases) Search(ctx context.Context, query string) (*domain.Result, error) {
+	q := strings.TrimSpace(query)
+	if utf8.RuneCountInString(q) < minQueryLength {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, fmt.Sprintf("query must have at least %d characters", minQueryLength), nil)
+	}
+	hits, err := u.repo.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return domain.GroupHits(q, hits), nil
+}
@@ -0,0 +1,48 @@
+package domain
+
+// Entity types returned by the global search.
+const (
+	TypeCustomer = "customers"
+	TypeProject  = "projects"
+	TypeField    = "fields"
+	TypeLot      = "lots"
+	TypeManager  = "managers"
+	TypeInvestor = "investors"
+	TypePerson   = "people"
+)
+
+// Hit is a single entity matching the query.
+type Hit struct {
+	Type  string
+	ID    string // people use UUIDs, the rest numeric IDs
+	Text  string
+	Score float64 // pg_trgm similarity, 0..1
+}
+
+// Group gathers the hits of one entity type, best first.
+type Group struct {
+	Type string
+	Hits []Hit
+}
+
+type Result struct {
+	Query  string
+	Total  int
+	Groups []Group
+}
+
+// GroupHits groups hits by type keeping their order. Groups are ordered by their best hit.
+func GroupHits(query string, hits []Hit) *Result {
+	res := &Result{Query: query, Total: len(hits)}
+	index := make(map[string]int)
+	for _, h := range hits {
+		i, ok := index[h.Type]
+		if !ok {
+			i = len(res.Groups)
+			index[h.Type] = i
+			res.Groups = append(res.Groups, Group{Type: h.Type})
+		}
+		res.Groups[i].Hits = append(res.Groups[i].Hits, h)
+	}
+	return res
+}
@@ -0,0 +1,73 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search/usecases/domain"
+)
+
+// fakeRepository returns fixed hits and records the query it received.
+type fakeRepository struct {
+	hits  []domain.Hit
+	query string
+	calls int
+}
+
+func (f *fakeRepository) Search(_ context.Context, query string) ([]domain.Hit, error) {
+	f.calls++
+	f.query = query
+	return f.hits, nil
+}
+
+func (f *fakeRepository) EnsureIndexes(context.Context) error { return nil }
+
+func TestSearch(t *testing.T) {
+	hits := []domain.Hit{
+		{Type: domain.TypeLot, ID: "4", Text: "Norte 1", Score: 0.9},
+		{Type: domain.TypeField, ID: "2", Text: "La Norteña", Score: 0.7},
+		{Type: domain.TypeLot, ID: "5", Text: "Norte 2", Score: 0.6},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		wantErr    pkgtypes.ErrorType
+		wantQuery  string
+		wantGroups []domain.Group
+	}{
+		{name: "empty query", query: "", wantErr: pkgtypes.ErrValidation},
+		{name: "one character", query: " n ", wantErr: pkgtypes.ErrValidation},
+		{name: "one multibyte character", query: "ñ", wantErr: pkgtypes.ErrValidation},
+		{
+			name:      "groups the hits by type ordered by their best hit",
+			query:     "  norte ",
+			wantQuery: "norte",
+			wantGroups: []domain.Group{
+				{Type: domain.TypeLot, Hits: []domain.Hit{hits[0], hits[2]}},
+				{Type: domain.TypeField, Hits: []domain.Hit{hits[1]}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{hits: hits}
+			got, err := NewUseCases(repo).Search(context.Background(), tt.query)
+			if tt.wantErr != "" {
+				errType, _ := pkgtypes.GetErrorType(err)
+				assert.Equal(t, tt.wantErr, errType)
+				assert.Zero(t, repo.calls)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, repo.query)
+			assert.Equal(t, tt.wantQuery, got.Query)
+			assert.Equal(t, len(hits), got.Total)
+			assert.Equal(t, tt.wantGroups, got.Groups)
+		})
+	}
+}
@@ -1,35 +1,97 @@
 package user
 
-// import (
-// 	"context"
-
-// 	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/grpc/pb"
-// )
-
-// type Server interface {
-// 	GetUserUUID(context.Context, *pb.GetUserRequest) (*pb.GetUserResponse, error)
-// }
-
-// type Grpc struct {
-// 	pb.UnimplementedUserServiceServer
-// 	ucs UseCases
-// }
-
-// func NewGrpc(ucs UseCases) Server {
-// 	return &Grpc{
-// 		ucs: ucs,
-// 	}
-// }
-
-// func (s *Grpc) GetUserByCrentials(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-// 	userID, err := s.ucs.GetUserByCredentials(ctx, req.Username, req.PasswordHash)
-// 	if err != nil {
-// 		return &pb.GetUserResponse{}, err
-// 	}
-
-// 	_ = userID
-// 	UUID := "userID"
-// 	return &pb.GetUserResponse{
-// 		UUID: UUID,
-// 	}, nil
-// }
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/grpc/pb"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// GrpcServer expone los casos de uso de usuarios como UserService. Los errores
+// del dominio los traduce a status el interceptor del servidor.
+type GrpcServer struct {
+	pb.UnimplementedUserServiceServer
+	ucs UseCases
+}
+
+// NewGrpcServer crea el servidor gRPC de usuarios.
+func NewGrpcServer(ucs UseCases) *GrpcServer {
+	return &GrpcServer{ucs: ucs}
+}
+
+// Register registra UserService en el servidor gRPC.
+func (s *GrpcServer) Register(ctx context.Context, server pkggrpcserver.Server) {
+	server.RegisterService(ctx, &pb.UserService_ServiceDesc, s)
+}
+
+// GetUserUUID se mantiene por compatibilidad: password_hash lleva la contraseña en texto plano.
+func (s *GrpcServer) GetUserUUID(ctx context.Context, req *pb.GetUserUUIDRequest) (*pb.GetUserUUIDResponse, error) {
+	user, err := s.ucs.VerifyCredentials(ctx, req.GetUsername(), req.GetPasswordHash())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserUUIDResponse{UUID: user.ID}, nil
+}
+
+func (s *GrpcServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if req.GetId() == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "id is required", nil)
+	}
+	user, err := s.ucs.GetUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *GrpcServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	users, err := s.ucs.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListUsersResponse{Users: make([]*pb.User, 0, len(users))}
+	for i := range users {
+		if req.GetOrganizationId() != 0 && users[i].OrganizationID != req.GetOrganizationId() {
+			continue
+		}
+		res.Users = append(res.Users, toProtoUser(&users[i]))
+	}
+	return res, nil
+}
+
+// VerifyCredentials responde valid=false ante credenciales incorrectas; sólo los
+// errores de validación o de infraestructura se devuelven como error.
+func (s *GrpcServer) VerifyCredentials(ctx context.Context, req *pb.VerifyCredentialsRequest) (*pb.VerifyCredentialsResponse, error) {
+	user, err := s.ucs.VerifyCredentials(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		if pkgtypes.IsAuthenticationError(err) {
+			return &pb.VerifyCredentialsResponse{Valid: false}, nil
+		}
+		return nil, err
+	}
+	return &pb.VerifyCredentialsResponse{Valid: true, User: toProtoUser(user)}, nil
+}
+
+// toProtoUser mapea el usuario del dominio al mensaje gRPC; la contraseña nunca se expone.
+func toProtoUser(u *domain.User) *pb.User {
+	user := &pb.User{
+		Id:             u.ID,
+		Email:          u.Credentials.Email,
+		PersonId:       u.PersonID,
+		OrganizationId: u.OrganizationID,
+		UserType:       string(u.UserType),
+		EmailValidated: u.EmailValidated,
+		Roles:          make([]string, 0, len(u.Roles)),
+	}
+	for _, r := range u.Roles {
+		user.Roles = append(user.Roles, r.Name)
+	}
+	if !u.LoggedAt.IsZero() {
+		user.LoggedAt = timestamppb.New(u.LoggedAt)
+	}
+	return user
+}
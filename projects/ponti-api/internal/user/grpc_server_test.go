@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkggrpcserver "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	pb "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/grpc/pb"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+func TestGrpcServer(t *testing.T) {
+	ctx := context.Background()
+	alice := domain.User{
+		ID:             "u-1",
+		Credentials:    domain.Credentials{Email: "alice@example.com", Password: "hash"},
+		OrganizationID: 1,
+		Roles:          []domain.Role{{Name: "admin"}},
+	}
+	bob := domain.User{ID: "u-2", Credentials: domain.Credentials{Email: "bob@example.com"}, OrganizationID: 2}
+
+	t.Run("GetUser maps the user and hides the password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+		ucs.EXPECT().GetUser(ctx, "u-1").Return(&alice, nil)
+
+		res, err := NewGrpcServer(ucs).GetUser(ctx, &pb.GetUserRequest{Id: "u-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "alice@example.com", res.GetUser().GetEmail())
+		assert.Equal(t, []string{"admin"}, res.GetUser().GetRoles())
+		assert.Nil(t, res.GetUser().GetLoggedAt())
+	})
+
+	t.Run("GetUser returns domain errors for the interceptor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+		ucs.EXPECT().GetUser(ctx, "missing").Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "user not found", nil))
+		srv := NewGrpcServer(ucs)
+
+		_, err := srv.GetUser(ctx, &pb.GetUserRequest{Id: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(pkggrpcserver.ToStatus(err)))
+
+		_, err = srv.GetUser(ctx, &pb.GetUserRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(pkggrpcserver.ToStatus(err)))
+	})
+
+	t.Run("ListUsers filters by organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ucs := mocks.NewMockUseCases(ctrl)
+		ucs.EXPECT().ListUsers(ctx).Return([]domain.User{alice, bob}, nil).Times(2)
+		srv := NewGrpcServer(ucs)
+
+		res, err := srv.ListUsers(ctx, &pb.ListUsersRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, res.GetUsers(), 2)
+
+		res, err = srv.ListUsers(ctx, &pb.ListUsersRequest{OrganizationId: 2})
+		assert.NoError(t, err)
+		if assert.Len(t, res.GetUsers(), 1) {
+			assert.Equal(t, "u-2", res.GetUsers()[0].GetId())
+		}
+	})
+
+	tests := []struct {
+		name      string
+		err       error
+		wantValid bool
+		wantCode  codes.Code
+	}{
+		{name: "valid credentials", wantValid: true, wantCode: codes.OK},
+		{name: "invalid credentials", err: errInvalidCredentials, wantCode: codes.OK},
+		{name: "missing fields", err: pkgtypes.NewError(pkgtypes.ErrValidation, "email and password are required", nil), wantCode: codes.InvalidArgument},
+		{name: "repository failure", err: pkgtypes.NewError(pkgtypes.ErrInternal, "db down", nil), wantCode: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run("VerifyCredentials "+tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ucs := mocks.NewMockUseCases(ctrl)
+			if tt.err != nil {
+				ucs.EXPECT().VerifyCredentials(ctx, "alice@example.com", "secret").Return(nil, tt.err)
+			} else {
+				ucs.EXPECT().VerifyCredentials(ctx, "alice@example.com", "secret").Return(&alice, nil)
+			}
+
+			res, err := NewGrpcServer(ucs).VerifyCredentials(ctx, &pb.VerifyCredentialsRequest{Email: "alice@example.com", Password: "secret"})
+			assert.Equal(t, tt.wantCode, status.Code(pkggrpcserver.ToStatus(err)))
+			if err == nil {
+				assert.Equal(t, tt.wantValid, res.GetValid())
+				assert.Equal(t, tt.wantValid, res.GetUser() != nil)
+			}
+		})
+	}
+}
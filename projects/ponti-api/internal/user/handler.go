@@ -11,6 +11,7 @@ import (
 	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
 
 	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 )
 
 type Handler struct {
@@ -39,11 +40,9 @@ func (h *Handler) Routes() {
 	// Rutas públicas
 	public := router.Group(publicPrefix)
 	{
-		public.POST("", h.CreateUser)
-		public.GET("", h.ListUsers)
-		public.GET("/:id", h.GetUser)
-		public.PUT("/:id", h.UpdateUser)
-		public.DELETE("/:id", h.DeleteUser)
+		public.Group("", h.mws.Idempotent...).POST("", h.mws.RateLimit("public"), h.CreateUser)
+		public.GET("/verify", h.VerifyEmail)
+		public.POST("/verify/resend", h.mws.RateLimit("public"), h.ResendVerification)
 		public.POST("/follow", h.FollowUser)
 		//public.DELETE("/unfollow", h.UnfollowUser)
 		public.GET("/:id/followees", h.GetFolloweeUsers)
@@ -63,9 +62,40 @@ func (h *Handler) Routes() {
 		protected.Use(h.mws.Protected...)
 
 		protected.GET("/ping", h.ProtectedPing)
+
+		// Cada usuario gestiona su propia cuenta; user:manage permite gestionar las
+		// del resto de la organización.
+		scoped := protected.Group("", h.mws.Tenant...)
+		scoped.GET("", h.mws.RequirePermission(ManagePermission), h.ListUsers)
+		scoped.GET("/:id", h.mws.RequireSelfOrPermission("id", ManagePermission), h.GetUser)
+		scoped.PUT("/:id", h.mws.RequireSelfOrPermission("id", ManagePermission), h.UpdateUser)
+		scoped.DELETE("/:id", h.mws.RequireSelfOrPermission("id", ManagePermission), h.DeleteUser)
+
+		// Asignación de roles a usuarios de la organización
+		rbac := scoped.Group("", h.mws.RequirePermission("rbac:manage"))
+		rbac.GET("/roles", h.ListRoles)
+		rbac.GET("/roles/:role_id", h.GetRole)
+		rbac.GET("/permissions", h.ListPermissions)
+		rbac.PUT("/:id/roles/:role_id", h.AssignRole)
+		rbac.DELETE("/:id/roles/:role_id", h.UnassignRole)
+
+		// Los roles y permisos son compartidos por todas las organizaciones: sólo
+		// los modifica un administrador de la plataforma.
+		platform := protected.Group("", h.mws.RequirePermission(domain.PlatformAdminPermission))
+		platform.Group("", h.mws.Idempotent...).POST("/roles", h.CreateRole)
+		platform.DELETE("/roles/:role_id", h.DeleteRole)
+		platform.PUT("/roles/:role_id/permissions/:permission_id", h.GrantPermission)
+		platform.DELETE("/roles/:role_id/permissions/:permission_id", h.RevokePermission)
+		platform.Group("", h.mws.Idempotent...).POST("/permissions", h.CreatePermission)
 	}
+
+	h.describeRoutes()
 }
 
+// ManagePermission lets the holder view, update and delete any user of the
+// organization, not only its own account.
+const ManagePermission = "user:manage"
+
 func (h *Handler) ProtectedPing(c *gin.Context) {
 	c.JSON(http.StatusCreated, types.MessageResponse{
 		Message: "Protected Pong!",
@@ -75,16 +105,14 @@ func (h *Handler) ProtectedPing(c *gin.Context) {
 func (h *Handler) CreateUser(c *gin.Context) {
 	var req dto.CreateUser
 	if err := utils.ValidateRequest(c, &req); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	newUserID, err := h.ucs.CreateUser(ctx, req.ToDomain())
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -94,11 +122,36 @@ func (h *Handler) CreateUser(c *gin.Context) {
 	})
 }
 
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	if err := h.ucs.VerifyEmail(c.Request.Context(), c.Query("token")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Email verified successfully",
+	})
+}
+
+func (h *Handler) ResendVerification(c *gin.Context) {
+	var req dto.ResendVerification
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := h.ucs.ResendVerification(c.Request.Context(), req.Email); err != nil {
+		c.Error(err)
+		return
+	}
+	// Misma respuesta exista o no el email.
+	c.JSON(http.StatusAccepted, types.MessageResponse{
+		Message: "If the email is registered and pending verification, a new link was sent",
+	})
+}
+
 func (h *Handler) ListUsers(c *gin.Context) {
 	users, err := h.ucs.ListUsers(c.Request.Context())
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, users)
@@ -109,8 +162,7 @@ func (h *Handler) GetUser(c *gin.Context) {
 
 	person, err := h.ucs.GetUser(c.Request.Context(), id)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -118,16 +170,16 @@ func (h *Handler) GetUser(c *gin.Context) {
 }
 
 func (h *Handler) UpdateUser(c *gin.Context) {
-	var updatedUser dto.User
-	if err := c.ShouldBindJSON(&updatedUser); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+	var updatedUser dto.UpdateUser
+	if err := utils.ValidateRequest(c, &updatedUser); err != nil {
+		c.Error(err)
 		return
 	}
 
-	if err := h.ucs.UpdateUser(c.Request.Context(), updatedUser.ToDomain()); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+	user := updatedUser.ToDomain()
+	user.ID = c.Param("id")
+	if err := h.ucs.UpdateUser(c.Request.Context(), user); err != nil {
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusCreated, types.MessageResponse{
@@ -139,8 +191,7 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 	id := c.Param("id")
 	hardDelete := c.Query("hardDelete") == "true"
 	if err := h.ucs.DeleteUser(c.Request.Context(), id, hardDelete); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusCreated, types.MessageResponse{
@@ -151,16 +202,14 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 func (h *Handler) FollowUser(c *gin.Context) {
 	var req dto.Follow
 	if err := c.ShouldBindJSON(&req); err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
 	ctx := c.Request.Context()
 	relationID, err := h.ucs.FollowUser(ctx, req.FollowerID, req.FolloweeID)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -176,8 +225,7 @@ func (h *Handler) GetFolloweeUsers(c *gin.Context) {
 
 	followees, err := h.ucs.GetFolloweeUsers(ctx, followerID)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -196,8 +244,7 @@ func (h *Handler) GetFollowerUsers(c *gin.Context) {
 	// Se llama al caso de uso que devuelve la lista de followers.
 	followers, err := h.ucs.GetFollowerUsers(ctx, followeeID)
 	if err != nil {
-		apiErr, errCode := types.NewAPIError(err)
-		c.Error(apiErr).SetMeta(errCode)
+		c.Error(err)
 		return
 	}
 
@@ -207,3 +254,126 @@ func (h *Handler) GetFollowerUsers(c *gin.Context) {
 		Followers: followers,
 	})
 }
+
+func (h *Handler) CreateRole(c *gin.Context) {
+	var req dto.CreateRole
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	roleID, err := h.ucs.CreateRole(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateRoleResponse{
+		Message: "Role created successfully",
+		RoleID:  roleID,
+	})
+}
+
+func (h *Handler) ListRoles(c *gin.Context) {
+	roles, err := h.ucs.ListRoles(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	resp := make([]dto.RoleResponse, 0, len(roles))
+	for _, r := range roles {
+		resp = append(resp, dto.FromDomainRole(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetRole(c *gin.Context) {
+	role, err := h.ucs.GetRole(c.Request.Context(), c.Param("role_id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.FromDomainRole(*role))
+}
+
+func (h *Handler) DeleteRole(c *gin.Context) {
+	if err := h.ucs.DeleteRole(c.Request.Context(), c.Param("role_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Role deleted successfully",
+	})
+}
+
+func (h *Handler) CreatePermission(c *gin.Context) {
+	var req dto.CreatePermission
+	if err := utils.ValidateRequest(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	permissionID, err := h.ucs.CreatePermission(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatePermissionResponse{
+		Message:      "Permission created successfully",
+		PermissionID: permissionID,
+	})
+}
+
+func (h *Handler) ListPermissions(c *gin.Context) {
+	permissions, err := h.ucs.ListPermissions(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	resp := make([]dto.PermissionResponse, 0, len(permissions))
+	for _, p := range permissions {
+		resp = append(resp, dto.FromDomainPermission(p))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GrantPermission(c *gin.Context) {
+	if err := h.ucs.GrantPermission(c.Request.Context(), c.Param("role_id"), c.Param("permission_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Permission granted successfully",
+	})
+}
+
+func (h *Handler) RevokePermission(c *gin.Context) {
+	if err := h.ucs.RevokePermission(c.Request.Context(), c.Param("role_id"), c.Param("permission_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Permission revoked successfully",
+	})
+}
+
+func (h *Handler) AssignRole(c *gin.Context) {
+	if err := h.ucs.AssignRole(c.Request.Context(), c.Param("id"), c.Param("role_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Role assigned successfully",
+	})
+}
+
+func (h *Handler) UnassignRole(c *gin.Context) {
+	if err := h.ucs.UnassignRole(c.Request.Context(), c.Param("id"), c.Param("role_id")); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, types.MessageResponse{
+		Message: "Role unassigned successfully",
+	})
+}
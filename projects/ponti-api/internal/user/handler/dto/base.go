@@ -5,6 +5,8 @@ import (
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 )
 
+// User no lleva la organización: la asigna el servidor (la organización por
+// defecto en el alta, la de la invitación al aceptarla) y nunca el cliente.
 type User struct {
 	UserType       string                 `json:"user_type"`
 	EmailValidated bool                   `json:"email_validated"`
@@ -13,6 +15,13 @@ type User struct {
 	Roles          []Role                 `json:"roles"`
 }
 
+// UpdateUser son los datos de perfil que el usuario puede cambiar. La contraseña,
+// la verificación del email y la organización tienen sus propios flujos.
+type UpdateUser struct {
+	UserType string `json:"user_type" binding:"omitempty,oneof=person"`
+	PersonID string `json:"person_id"`
+}
+
 type Role struct {
 	Name        string       `json:"name"`
 	Permissions []Permission `json:"permissions"`
@@ -44,6 +53,13 @@ func (dto *User) ToDomain() *domain.User {
 	return user
 }
 
+func (dto *UpdateUser) ToDomain() *domain.User {
+	return &domain.User{
+		UserType: domain.UserType(dto.UserType),
+		PersonID: dto.PersonID,
+	}
+}
+
 // Función auxiliar para convertir los roles del DTO al dominio
 func convertRoles(dtoRoles []Role) []domain.Role {
 	roles := make([]domain.Role, len(dtoRoles))
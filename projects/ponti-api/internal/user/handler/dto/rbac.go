@@ -0,0 +1,75 @@
+package dto
+
+import (
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+type CreateRole struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type CreatePermission struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type RoleResponse struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Permissions []PermissionResponse `json:"permissions"`
+}
+
+type PermissionResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Response
+type CreateRoleResponse struct {
+	Message string `json:"message"`
+	RoleID  string `json:"role_id"`
+}
+
+type CreatePermissionResponse struct {
+	Message      string `json:"message"`
+	PermissionID string `json:"permission_id"`
+}
+
+// Mappers
+func (dto *CreateRole) ToDomain() *domain.Role {
+	return &domain.Role{
+		Name:        dto.Name,
+		Description: dto.Description,
+	}
+}
+
+func (dto *CreatePermission) ToDomain() *domain.Permission {
+	return &domain.Permission{
+		Name:        dto.Name,
+		Description: dto.Description,
+	}
+}
+
+func FromDomainRole(r domain.Role) RoleResponse {
+	resp := RoleResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: make([]PermissionResponse, 0, len(r.Permissions)),
+	}
+	for _, p := range r.Permissions {
+		resp.Permissions = append(resp.Permissions, FromDomainPermission(p))
+	}
+	return resp
+}
+
+func FromDomainPermission(p domain.Permission) PermissionResponse {
+	return PermissionResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+}
@@ -0,0 +1,5 @@
+package dto
+
+type ResendVerification struct {
+	Email string `json:"email" binding:"required,email"`
+}
@@ -0,0 +1,125 @@
+package user
+
+import (
+	"net/http"
+
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	types "github.com/alphacodinggroup/ponti-backend/pkg/types"
+
+	dto "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/handler/dto"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// describeRoutes documents the routes registered in Routes for the OpenAPI spec.
+func (h *Handler) describeRoutes() {
+	id := pkgswagger.PathParam("id", "string", "User ID")
+	roleID := pkgswagger.PathParam("role_id", "string", "Role ID")
+	permissionID := pkgswagger.PathParam("permission_id", "string", "Permission ID")
+	hardDelete := pkgswagger.QueryParam("hardDelete", "boolean", "Remove the record instead of soft-deleting it", false)
+
+	pkgswagger.Describe(h.ProtectedPing, pkgswagger.Operation{
+		Summary:   "Check authentication",
+		Responses: map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreateUser, pkgswagger.Operation{
+		Summary:     "Sign up",
+		Description: "Creates the user and emails a verification link.",
+		Request:     dto.CreateUser{},
+		Responses:   map[int]any{http.StatusCreated: dto.CreateUserResponse{}},
+	})
+	pkgswagger.Describe(h.VerifyEmail, pkgswagger.Operation{
+		Summary:    "Verify an email address",
+		Parameters: []pkgswagger.Parameter{pkgswagger.QueryParam("token", "string", "Verification token", true)},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ResendVerification, pkgswagger.Operation{
+		Summary:   "Resend the verification email",
+		Request:   dto.ResendVerification{},
+		Responses: map[int]any{http.StatusAccepted: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.ListUsers, pkgswagger.Operation{
+		Summary:   "List users",
+		Responses: map[int]any{http.StatusOK: []domain.User{}},
+	})
+	pkgswagger.Describe(h.GetUser, pkgswagger.Operation{
+		Summary:    "Get a user",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: domain.User{}},
+	})
+	pkgswagger.Describe(h.UpdateUser, pkgswagger.Operation{
+		Summary:    "Update a user's profile",
+		Parameters: []pkgswagger.Parameter{id},
+		Request:    dto.UpdateUser{},
+		Responses:  map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteUser, pkgswagger.Operation{
+		Summary:    "Delete a user",
+		Parameters: []pkgswagger.Parameter{id, hardDelete},
+		Responses:  map[int]any{http.StatusCreated: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.FollowUser, pkgswagger.Operation{
+		Summary:   "Follow a user",
+		Request:   dto.Follow{},
+		Responses: map[int]any{http.StatusCreated: dto.FollowUserResponse{}},
+	})
+	pkgswagger.Describe(h.GetFolloweeUsers, pkgswagger.Operation{
+		Summary:    "List the users a user follows",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: dto.GetFolloweesResponse{}},
+	})
+	pkgswagger.Describe(h.GetFollowerUsers, pkgswagger.Operation{
+		Summary:    "List the followers of a user",
+		Parameters: []pkgswagger.Parameter{id},
+		Responses:  map[int]any{http.StatusOK: dto.GetFollowersResponse{}},
+	})
+	pkgswagger.Describe(h.CreateRole, pkgswagger.Operation{
+		Summary:   "Create a role",
+		Request:   dto.CreateRole{},
+		Responses: map[int]any{http.StatusCreated: dto.CreateRoleResponse{}},
+	})
+	pkgswagger.Describe(h.ListRoles, pkgswagger.Operation{
+		Summary:   "List roles",
+		Responses: map[int]any{http.StatusOK: []dto.RoleResponse{}},
+	})
+	pkgswagger.Describe(h.GetRole, pkgswagger.Operation{
+		Summary:    "Get a role",
+		Parameters: []pkgswagger.Parameter{roleID},
+		Responses:  map[int]any{http.StatusOK: dto.RoleResponse{}},
+	})
+	pkgswagger.Describe(h.DeleteRole, pkgswagger.Operation{
+		Summary:    "Delete a role",
+		Parameters: []pkgswagger.Parameter{roleID},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.CreatePermission, pkgswagger.Operation{
+		Summary:   "Create a permission",
+		Request:   dto.CreatePermission{},
+		Responses: map[int]any{http.StatusCreated: dto.CreatePermissionResponse{}},
+	})
+	pkgswagger.Describe(h.ListPermissions, pkgswagger.Operation{
+		Summary:   "List permissions",
+		Responses: map[int]any{http.StatusOK: []dto.PermissionResponse{}},
+	})
+	pkgswagger.Describe(h.GrantPermission, pkgswagger.Operation{
+		Summary:    "Grant a permission to a role",
+		Parameters: []pkgswagger.Parameter{roleID, permissionID},
+		NoBody:     true,
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.RevokePermission, pkgswagger.Operation{
+		Summary:    "Revoke a permission from a role",
+		Parameters: []pkgswagger.Parameter{roleID, permissionID},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.AssignRole, pkgswagger.Operation{
+		Summary:    "Assign a role to a user",
+		Parameters: []pkgswagger.Parameter{id, roleID},
+		NoBody:     true,
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+	pkgswagger.Describe(h.UnassignRole, pkgswagger.Operation{
+		Summary:    "Remove a role from a user",
+		Parameters: []pkgswagger.Parameter{id, roleID},
+		Responses:  map[int]any{http.StatusOK: types.MessageResponse{}},
+	})
+}
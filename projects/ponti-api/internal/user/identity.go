@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"strings"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// ResolveExternalAccount devuelve el usuario de una cuenta externa, vinculándola
+// en el primer login. Sólo se vincula a un usuario existente si el proveedor
+// verificó el email; si no, cualquiera con una cuenta en el proveedor podría
+// tomar el usuario registrado con esa dirección.
+func (u *useCases) ResolveExternalAccount(ctx context.Context, account *domain.ExternalAccount) (*domain.User, error) {
+	if account == nil || account.Provider == "" || account.Subject == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "external account is incomplete", nil)
+	}
+
+	identity, err := u.repository.GetIdentity(ctx, account.Provider, account.Subject)
+	if err == nil {
+		return u.repository.GetUser(ctx, identity.UserID)
+	}
+	if !pkgtypes.IsNotFound(err) {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(account.Email)
+	if email == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "provider did not return an email", nil)
+	}
+	link := &domain.Identity{Provider: account.Provider, Subject: account.Subject}
+
+	existing, err := u.repository.GetUserByEmail(ctx, email)
+	switch {
+	case err == nil:
+		if !account.EmailVerified {
+			return nil, pkgtypes.NewError(pkgtypes.ErrConflict, "an account with this email already exists", nil)
+		}
+		link.UserID = existing.ID
+		if err := u.repository.LinkIdentity(ctx, link); err != nil {
+			return nil, err
+		}
+		if !existing.EmailValidated {
+			if err := u.repository.MarkEmailVerified(ctx, existing.ID); err != nil {
+				return nil, err
+			}
+		}
+		return u.repository.GetUser(ctx, existing.ID)
+	case !pkgtypes.IsNotFound(err):
+		return nil, err
+	}
+
+	return u.createExternalUser(ctx, email, account.EmailVerified, link)
+}
+
+// createExternalUser da de alta un usuario sin contraseña utilizable: sólo puede
+// entrar con el proveedor hasta que pida un reset de contraseña.
+func (u *useCases) createExternalUser(ctx context.Context, email string, verified bool, link *domain.Identity) (*domain.User, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to generate password", err)
+	}
+	hashed, err := utils.HashPassword(hex.EncodeToString(raw), 12)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to hash password", err)
+	}
+
+	usr := &domain.User{
+		Credentials:    domain.Credentials{Email: email, Password: hashed},
+		UserType:       domain.UserTypePerson,
+		EmailValidated: verified,
+	}
+	id, err := u.repository.CreateUserWithIdentity(ctx, usr, link)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		if err := u.sendVerification(ctx, id, email); err != nil {
+			log.Printf("[User] failed to send verification email to user %s: %v", id, err)
+		}
+	}
+	return u.repository.GetUser(ctx, id)
+}
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	gorm0 "gorm.io/gorm"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/repository/models"
+	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// GetIdentity busca el vínculo de una cuenta externa.
+func (r *repository) GetIdentity(ctx context.Context, provider, subject string) (*domain.Identity, error) {
+	var model models.UserIdentity
+	err := r.db.Client().WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("%s identity not linked", provider), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get identity", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// LinkIdentity vincula una cuenta externa con un usuario existente.
+func (r *repository) LinkIdentity(ctx context.Context, identity *domain.Identity) error {
+	if err := r.db.Client().WithContext(ctx).Create(models.IdentityFromDomain(identity)).Error; err != nil {
+		if isUniqueViolation(err) {
+			return pkgtypes.NewError(pkgtypes.ErrConflict, "identity is already linked", err)
+		}
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to link identity", err)
+	}
+	return nil
+}
+
+// CreateUserWithIdentity crea el usuario y el vínculo juntos, para que un alta a
+// medias no deje un usuario sin forma de iniciar sesión.
+func (r *repository) CreateUserWithIdentity(ctx context.Context, user *domain.User, identity *domain.Identity) (string, error) {
+	model, err := models.FromDomain(user)
+	if err != nil {
+		return "", fmt.Errorf("error converting domain user to model: %w", err)
+	}
+	model.ID = uuid.New().String()
+
+	err = r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		link := models.IdentityFromDomain(identity)
+		link.UserID = model.ID
+		return tx.Create(link).Error
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return "", pkgtypes.NewError(pkgtypes.ErrConflict, "user or identity already exists", err)
+		}
+		return "", pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create user", err)
+	}
+	return model.ID, nil
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
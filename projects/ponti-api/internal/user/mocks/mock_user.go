@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ports.go
+// Source: internal/user/ports.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,50 @@ func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
 	return m.recorder
 }
 
+// AssignRole mocks base method.
+func (m *MockUseCases) AssignRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockUseCasesMockRecorder) AssignRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockUseCases)(nil).AssignRole), arg0, arg1, arg2)
+}
+
+// CreatePermission mocks base method.
+func (m *MockUseCases) CreatePermission(arg0 context.Context, arg1 *domain.Permission) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermission", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePermission indicates an expected call of CreatePermission.
+func (mr *MockUseCasesMockRecorder) CreatePermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermission", reflect.TypeOf((*MockUseCases)(nil).CreatePermission), arg0, arg1)
+}
+
+// CreateRole mocks base method.
+func (m *MockUseCases) CreateRole(arg0 context.Context, arg1 *domain.Role) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockUseCasesMockRecorder) CreateRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockUseCases)(nil).CreateRole), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockUseCases) CreateUser(arg0 context.Context, arg1 *domain.User) (string, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +95,20 @@ func (mr *MockUseCasesMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUseCases)(nil).CreateUser), arg0, arg1)
 }
 
+// DeleteRole mocks base method.
+func (m *MockUseCases) DeleteRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockUseCasesMockRecorder) DeleteRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockUseCases)(nil).DeleteRole), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUseCases) DeleteUser(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -109,6 +168,21 @@ func (mr *MockUseCasesMockRecorder) GetFollowerUsers(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerUsers", reflect.TypeOf((*MockUseCases)(nil).GetFollowerUsers), arg0, arg1)
 }
 
+// GetRole mocks base method.
+func (m *MockUseCases) GetRole(arg0 context.Context, arg1 string) (*domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockUseCasesMockRecorder) GetRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockUseCases)(nil).GetRole), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockUseCases) GetUser(arg0 context.Context, arg1 string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +198,65 @@ func (mr *MockUseCasesMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUseCases)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserByEmail mocks base method.
+func (m *MockUseCases) GetUserByEmail(arg0 context.Context, arg1 string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUseCasesMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUseCases)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// GrantPermission mocks base method.
+func (m *MockUseCases) GrantPermission(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantPermission indicates an expected call of GrantPermission.
+func (mr *MockUseCasesMockRecorder) GrantPermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPermission", reflect.TypeOf((*MockUseCases)(nil).GrantPermission), arg0, arg1, arg2)
+}
+
+// ListPermissions mocks base method.
+func (m *MockUseCases) ListPermissions(arg0 context.Context) ([]domain.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", arg0)
+	ret0, _ := ret[0].([]domain.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockUseCasesMockRecorder) ListPermissions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockUseCases)(nil).ListPermissions), arg0)
+}
+
+// ListRoles mocks base method.
+func (m *MockUseCases) ListRoles(arg0 context.Context) ([]domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockUseCasesMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockUseCases)(nil).ListRoles), arg0)
+}
+
 // ListUsers mocks base method.
 func (m *MockUseCases) ListUsers(arg0 context.Context) ([]domain.User, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +272,120 @@ func (mr *MockUseCasesMockRecorder) ListUsers(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUseCases)(nil).ListUsers), arg0)
 }
 
+// RecordLogin mocks base method.
+func (m *MockUseCases) RecordLogin(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLogin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLogin indicates an expected call of RecordLogin.
+func (mr *MockUseCasesMockRecorder) RecordLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLogin", reflect.TypeOf((*MockUseCases)(nil).RecordLogin), arg0, arg1)
+}
+
+// ResendVerification mocks base method.
+func (m *MockUseCases) ResendVerification(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification.
+func (mr *MockUseCasesMockRecorder) ResendVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockUseCases)(nil).ResendVerification), arg0, arg1)
+}
+
+// ResolveExternalAccount mocks base method.
+func (m *MockUseCases) ResolveExternalAccount(arg0 context.Context, arg1 *domain.ExternalAccount) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveExternalAccount", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveExternalAccount indicates an expected call of ResolveExternalAccount.
+func (mr *MockUseCasesMockRecorder) ResolveExternalAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveExternalAccount", reflect.TypeOf((*MockUseCases)(nil).ResolveExternalAccount), arg0, arg1)
+}
+
+// ResolvePermissions mocks base method.
+func (m *MockUseCases) ResolvePermissions(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePermissions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePermissions indicates an expected call of ResolvePermissions.
+func (mr *MockUseCasesMockRecorder) ResolvePermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePermissions", reflect.TypeOf((*MockUseCases)(nil).ResolvePermissions), arg0, arg1)
+}
+
+// RevokePermission mocks base method.
+func (m *MockUseCases) RevokePermission(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePermission indicates an expected call of RevokePermission.
+func (mr *MockUseCasesMockRecorder) RevokePermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePermission", reflect.TypeOf((*MockUseCases)(nil).RevokePermission), arg0, arg1, arg2)
+}
+
+// SeedRBAC mocks base method.
+func (m *MockUseCases) SeedRBAC(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedRBAC", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeedRBAC indicates an expected call of SeedRBAC.
+func (mr *MockUseCasesMockRecorder) SeedRBAC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedRBAC", reflect.TypeOf((*MockUseCases)(nil).SeedRBAC), arg0, arg1)
+}
+
+// UnassignRole mocks base method.
+func (m *MockUseCases) UnassignRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignRole indicates an expected call of UnassignRole.
+func (mr *MockUseCasesMockRecorder) UnassignRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignRole", reflect.TypeOf((*MockUseCases)(nil).UnassignRole), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUseCases) UpdatePassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUseCasesMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUseCases)(nil).UpdatePassword), arg0, arg1, arg2)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUseCases) UpdateUser(arg0 context.Context, arg1 *domain.User) error {
 	m.ctrl.T.Helper()
@@ -153,6 +400,35 @@ func (mr *MockUseCasesMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUseCases)(nil).UpdateUser), arg0, arg1)
 }
 
+// VerifyCredentials mocks base method.
+func (m *MockUseCases) VerifyCredentials(arg0 context.Context, arg1, arg2 string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCredentials", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyCredentials indicates an expected call of VerifyCredentials.
+func (mr *MockUseCasesMockRecorder) VerifyCredentials(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCredentials", reflect.TypeOf((*MockUseCases)(nil).VerifyCredentials), arg0, arg1, arg2)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUseCases) VerifyEmail(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUseCasesMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUseCases)(nil).VerifyEmail), arg0, arg1)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -176,6 +452,50 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignRole mocks base method.
+func (m *MockRepository) AssignRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockRepositoryMockRecorder) AssignRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockRepository)(nil).AssignRole), arg0, arg1, arg2)
+}
+
+// CreatePermission mocks base method.
+func (m *MockRepository) CreatePermission(arg0 context.Context, arg1 *domain.Permission) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermission", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePermission indicates an expected call of CreatePermission.
+func (mr *MockRepositoryMockRecorder) CreatePermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermission", reflect.TypeOf((*MockRepository)(nil).CreatePermission), arg0, arg1)
+}
+
+// CreateRole mocks base method.
+func (m *MockRepository) CreateRole(arg0 context.Context, arg1 *domain.Role) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockRepositoryMockRecorder) CreateRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRepository)(nil).CreateRole), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockRepository) CreateUser(arg0 context.Context, arg1 *domain.User) (string, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +511,35 @@ func (mr *MockRepositoryMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), arg0, arg1)
 }
 
+// CreateUserWithIdentity mocks base method.
+func (m *MockRepository) CreateUserWithIdentity(arg0 context.Context, arg1 *domain.User, arg2 *domain.Identity) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWithIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithIdentity indicates an expected call of CreateUserWithIdentity.
+func (mr *MockRepositoryMockRecorder) CreateUserWithIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithIdentity", reflect.TypeOf((*MockRepository)(nil).CreateUserWithIdentity), arg0, arg1, arg2)
+}
+
+// DeleteRole mocks base method.
+func (m *MockRepository) DeleteRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockRepositoryMockRecorder) DeleteRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRepository)(nil).DeleteRole), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockRepository) DeleteUser(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -205,6 +554,35 @@ func (mr *MockRepositoryMockRecorder) DeleteUser(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockRepository)(nil).DeleteUser), arg0, arg1, arg2)
 }
 
+// EnsurePermissions mocks base method.
+func (m *MockRepository) EnsurePermissions(arg0 context.Context, arg1 []domain.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsurePermissions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsurePermissions indicates an expected call of EnsurePermissions.
+func (mr *MockRepositoryMockRecorder) EnsurePermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsurePermissions", reflect.TypeOf((*MockRepository)(nil).EnsurePermissions), arg0, arg1)
+}
+
+// EnsureRole mocks base method.
+func (m *MockRepository) EnsureRole(arg0 context.Context, arg1 *domain.Role) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureRole indicates an expected call of EnsureRole.
+func (mr *MockRepositoryMockRecorder) EnsureRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRole", reflect.TypeOf((*MockRepository)(nil).EnsureRole), arg0, arg1)
+}
+
 // FollowExists mocks base method.
 func (m *MockRepository) FollowExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +643,36 @@ func (mr *MockRepositoryMockRecorder) GetFollowerUsers(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerUsers", reflect.TypeOf((*MockRepository)(nil).GetFollowerUsers), arg0, arg1)
 }
 
+// GetIdentity mocks base method.
+func (m *MockRepository) GetIdentity(arg0 context.Context, arg1, arg2 string) (*domain.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentity indicates an expected call of GetIdentity.
+func (mr *MockRepositoryMockRecorder) GetIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentity", reflect.TypeOf((*MockRepository)(nil).GetIdentity), arg0, arg1, arg2)
+}
+
+// GetRole mocks base method.
+func (m *MockRepository) GetRole(arg0 context.Context, arg1 string) (*domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRepositoryMockRecorder) GetRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRepository)(nil).GetRole), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockRepository) GetUser(arg0 context.Context, arg1 string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +688,109 @@ func (mr *MockRepositoryMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserByEmail mocks base method.
+func (m *MockRepository) GetUserByEmail(arg0 context.Context, arg1 string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockRepositoryMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockRepository)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// GetUserPermissions mocks base method.
+func (m *MockRepository) GetUserPermissions(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPermissions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPermissions indicates an expected call of GetUserPermissions.
+func (mr *MockRepositoryMockRecorder) GetUserPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPermissions", reflect.TypeOf((*MockRepository)(nil).GetUserPermissions), arg0, arg1)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockRepository) GetUserRoles(arg0 context.Context, arg1 string) ([]domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockRepositoryMockRecorder) GetUserRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockRepository)(nil).GetUserRoles), arg0, arg1)
+}
+
+// GrantPermission mocks base method.
+func (m *MockRepository) GrantPermission(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantPermission indicates an expected call of GrantPermission.
+func (mr *MockRepositoryMockRecorder) GrantPermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPermission", reflect.TypeOf((*MockRepository)(nil).GrantPermission), arg0, arg1, arg2)
+}
+
+// LinkIdentity mocks base method.
+func (m *MockRepository) LinkIdentity(arg0 context.Context, arg1 *domain.Identity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkIdentity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkIdentity indicates an expected call of LinkIdentity.
+func (mr *MockRepositoryMockRecorder) LinkIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkIdentity", reflect.TypeOf((*MockRepository)(nil).LinkIdentity), arg0, arg1)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRepository) ListPermissions(arg0 context.Context) ([]domain.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", arg0)
+	ret0, _ := ret[0].([]domain.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRepositoryMockRecorder) ListPermissions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRepository)(nil).ListPermissions), arg0)
+}
+
+// ListRoles mocks base method.
+func (m *MockRepository) ListRoles(arg0 context.Context) ([]domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRepositoryMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRepository)(nil).ListRoles), arg0)
+}
+
 // ListUsers mocks base method.
 func (m *MockRepository) ListUsers(arg0 context.Context) ([]domain.User, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +806,76 @@ func (mr *MockRepositoryMockRecorder) ListUsers(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepository)(nil).ListUsers), arg0)
 }
 
+// MarkEmailVerified mocks base method.
+func (m *MockRepository) MarkEmailVerified(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockRepositoryMockRecorder) MarkEmailVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockRepository)(nil).MarkEmailVerified), arg0, arg1)
+}
+
+// RevokePermission mocks base method.
+func (m *MockRepository) RevokePermission(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePermission indicates an expected call of RevokePermission.
+func (mr *MockRepositoryMockRecorder) RevokePermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePermission", reflect.TypeOf((*MockRepository)(nil).RevokePermission), arg0, arg1, arg2)
+}
+
+// UnassignRole mocks base method.
+func (m *MockRepository) UnassignRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignRole indicates an expected call of UnassignRole.
+func (mr *MockRepositoryMockRecorder) UnassignRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignRole", reflect.TypeOf((*MockRepository)(nil).UnassignRole), arg0, arg1, arg2)
+}
+
+// UpdateLoggedAt mocks base method.
+func (m *MockRepository) UpdateLoggedAt(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoggedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLoggedAt indicates an expected call of UpdateLoggedAt.
+func (mr *MockRepositoryMockRecorder) UpdateLoggedAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoggedAt", reflect.TypeOf((*MockRepository)(nil).UpdateLoggedAt), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockRepository) UpdatePassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockRepository)(nil).UpdatePassword), arg0, arg1, arg2)
+}
+
 // UpdateUser mocks base method.
 func (m *MockRepository) UpdateUser(arg0 context.Context, arg1 *domain.User) error {
 	m.ctrl.T.Helper()
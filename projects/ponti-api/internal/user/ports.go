@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 )
@@ -9,12 +10,41 @@ import (
 type UseCases interface {
 	CreateUser(context.Context, *domain.User) (string, error)
 	GetUser(context.Context, string) (*domain.User, error)
+	GetUserByEmail(context.Context, string) (*domain.User, error)
+	// VerifyCredentials devuelve el usuario si el email y la contraseña coinciden.
+	VerifyCredentials(context.Context, string, string) (*domain.User, error)
 	DeleteUser(context.Context, string, bool) error
 	ListUsers(context.Context) ([]domain.User, error)
 	UpdateUser(context.Context, *domain.User) error
 	FollowUser(context.Context, string, string) (string, error)
 	GetFolloweeUsers(context.Context, string) ([]string, error)
 	GetFollowerUsers(context.Context, string) ([]string, error)
+	// VerifyEmail consume el token de verificación y marca el email como validado.
+	VerifyEmail(context.Context, string) error
+	// ResendVerification reenvía el email de verificación, con rate limiting por dirección.
+	ResendVerification(context.Context, string) error
+	// UpdatePassword hashea y guarda la nueva contraseña del usuario.
+	UpdatePassword(context.Context, string, string) error
+	// RecordLogin registra la fecha del último login.
+	RecordLogin(context.Context, string) error
+	// ResolveExternalAccount devuelve el usuario vinculado a la cuenta externa. Si no
+	// hay vínculo lo crea, con el usuario del mismo email o con uno nuevo.
+	ResolveExternalAccount(context.Context, *domain.ExternalAccount) (*domain.User, error)
+
+	CreateRole(context.Context, *domain.Role) (string, error)
+	ListRoles(context.Context) ([]domain.Role, error)
+	GetRole(context.Context, string) (*domain.Role, error)
+	DeleteRole(context.Context, string) error
+	CreatePermission(context.Context, *domain.Permission) (string, error)
+	ListPermissions(context.Context) ([]domain.Permission, error)
+	GrantPermission(context.Context, string, string) error
+	RevokePermission(context.Context, string, string) error
+	AssignRole(context.Context, string, string) error
+	UnassignRole(context.Context, string, string) error
+	// ResolvePermissions devuelve los permisos del usuario, cacheados en Redis.
+	ResolvePermissions(context.Context, string) ([]string, error)
+	// SeedRBAC crea el catálogo de permisos y el rol admin, y se lo asigna al email indicado (si existe).
+	SeedRBAC(context.Context, string) error
 }
 
 type Repository interface {
@@ -27,6 +57,30 @@ type Repository interface {
 	GetFolloweeUsers(context.Context, string) ([]string, error)
 	GetFollowerUsers(context.Context, string) ([]string, error)
 	FollowExists(context.Context, string, string) (bool, error)
+	GetUserByEmail(context.Context, string) (*domain.User, error)
+	MarkEmailVerified(context.Context, string) error
+	UpdatePassword(context.Context, string, string) error
+	UpdateLoggedAt(context.Context, string, time.Time) error
+	// GetIdentity devuelve ErrNotFound si la cuenta externa no está vinculada.
+	GetIdentity(context.Context, string, string) (*domain.Identity, error)
+	LinkIdentity(context.Context, *domain.Identity) error
+	// CreateUserWithIdentity crea el usuario y su vínculo en una transacción.
+	CreateUserWithIdentity(context.Context, *domain.User, *domain.Identity) (string, error)
+
+	CreateRole(context.Context, *domain.Role) (string, error)
+	ListRoles(context.Context) ([]domain.Role, error)
+	GetRole(context.Context, string) (*domain.Role, error)
+	DeleteRole(context.Context, string) error
+	CreatePermission(context.Context, *domain.Permission) (string, error)
+	ListPermissions(context.Context) ([]domain.Permission, error)
+	GrantPermission(context.Context, string, string) error
+	RevokePermission(context.Context, string, string) error
+	AssignRole(context.Context, string, string) error
+	UnassignRole(context.Context, string, string) error
+	GetUserRoles(context.Context, string) ([]domain.Role, error)
+	GetUserPermissions(context.Context, string) ([]string, error)
+	EnsurePermissions(context.Context, []domain.Permission) error
+	EnsureRole(context.Context, *domain.Role) (string, error)
 }
 
 // Gomock
@@ -2,11 +2,18 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	gorm0 "gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	"github.com/google/uuid"
 
+	orgdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
 	models "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/repository/models"
 	domain "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 )
@@ -42,10 +49,16 @@ func (r *repository) CreateUser(ctx context.Context, user *domain.User) (string,
 	return model.ID, nil
 }
 
-// ListUsers retrieves all users from the database.
+// ListUsers retrieves the users of the organization in ctx, or all of them when
+// ctx carries none. Users without organization belong to the default one.
 func (r *repository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	db := r.db.Client().WithContext(ctx)
+	if tenantID, ok := pkgtypes.TenantIDFromContext(ctx); ok {
+		db = db.Where("COALESCE(organization_id, ?) = ?", orgdom.DefaultOrganizationID, tenantID)
+	}
+
 	var modelsList []models.User
-	if err := r.db.Client().WithContext(ctx).Find(&modelsList).Error; err != nil {
+	if err := db.Find(&modelsList).Error; err != nil {
 		return nil, fmt.Errorf("error listing users: %w", err)
 	}
 
@@ -63,22 +76,41 @@ func (r *repository) ListUsers(ctx context.Context) ([]domain.User, error) {
 // GetUser retrieves a user by its ID.
 func (r *repository) GetUser(ctx context.Context, id string) (*domain.User, error) {
 	if id == "" {
-		return nil, fmt.Errorf("id is empty")
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "user id is required", nil)
 	}
 
 	var model models.User
 	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
-		return nil, fmt.Errorf("error retrieving user with id %s: %w", id, err)
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", id), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get user", err)
 	}
 
 	user, err := model.ToDomain()
 	if err != nil {
 		return nil, fmt.Errorf("error converting model to domain: %w", err)
 	}
+	if user.Roles, err = r.GetUserRoles(ctx, id); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
-// UpdateUser updates an existing user in the database.
+// GetUserByEmail retrieves a user by its email.
+func (r *repository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var model models.User
+	if err := r.db.Client().WithContext(ctx).Where("email = ?", email).First(&model).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with email %s not found", email), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get user by email", err)
+	}
+	return model.ToDomain()
+}
+
+// UpdateUser stores the profile columns of an existing user. Credentials, email
+// verification and organization are updated by their own methods.
 func (r *repository) UpdateUser(ctx context.Context, user *domain.User) error {
 	if user == nil {
 		return fmt.Errorf("user is nil")
@@ -89,8 +121,56 @@ func (r *repository) UpdateUser(ctx context.Context, user *domain.User) error {
 		return fmt.Errorf("error converting domain user to model: %w", err)
 	}
 
-	if err := r.db.Client().WithContext(ctx).Save(model).Error; err != nil {
-		return fmt.Errorf("error updating user with id %s: %w", user.ID, err)
+	result := r.db.Client().WithContext(ctx).
+		Model(model).
+		Select("person_id", "user_type").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("error updating user with id %s: %w", user.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", user.ID), nil)
+	}
+	return nil
+}
+
+// MarkEmailVerified flags the user's email as validated.
+func (r *repository) MarkEmailVerified(ctx context.Context, id string) error {
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Update("email_validated", true)
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to mark email as verified", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// UpdatePassword replaces the stored password hash.
+func (r *repository) UpdatePassword(ctx context.Context, id, hashedPassword string) error {
+	result := r.db.Client().WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Update("password", hashedPassword)
+	if result.Error != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to update password", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", id), nil)
+	}
+	return nil
+}
+
+// UpdateLoggedAt records the time of the user's last login.
+func (r *repository) UpdateLoggedAt(ctx context.Context, id string, at time.Time) error {
+	if err := r.db.Client().WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Update("logged_at", at).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to record login", err)
 	}
 	return nil
 }
@@ -185,3 +265,266 @@ func (r *repository) GetFollowerUsers(ctx context.Context, followeeID string) ([
 
 	return followerIDs, nil
 }
+
+// CreateRole persists a new role without permissions.
+func (r *repository) CreateRole(ctx context.Context, role *domain.Role) (string, error) {
+	model := models.RoleFromDomain(role)
+	model.ID = uuid.New().String()
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create role", err)
+	}
+	return model.ID, nil
+}
+
+// ListRoles returns every role with its permissions.
+func (r *repository) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	var roles []models.Role
+	if err := r.db.Client().WithContext(ctx).Order("name").Find(&roles).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list roles", err)
+	}
+	return r.withPermissions(ctx, roles)
+}
+
+// GetRole returns a role with its permissions.
+func (r *repository) GetRole(ctx context.Context, id string) (*domain.Role, error) {
+	var role models.Role
+	if err := r.db.Client().WithContext(ctx).Where("id = ?", id).First(&role).Error; err != nil {
+		if errors.Is(err, gorm0.ErrRecordNotFound) {
+			return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("role %s not found", id), err)
+		}
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get role", err)
+	}
+	roles, err := r.withPermissions(ctx, []models.Role{role})
+	if err != nil {
+		return nil, err
+	}
+	return &roles[0], nil
+}
+
+// DeleteRole removes a role together with its grants and assignments.
+func (r *repository) DeleteRole(ctx context.Context, id string) error {
+	err := r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		if err := tx.Where("role_id = ?", id).Delete(&models.RolePermission{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id = ?", id).Delete(&models.UserRole{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id = ?", id).Delete(&models.Role{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("role %s not found", id), nil)
+		}
+		return nil
+	})
+	var appErr *pkgtypes.Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to delete role", err)
+	}
+	return nil
+}
+
+// CreatePermission persists a new permission.
+func (r *repository) CreatePermission(ctx context.Context, permission *domain.Permission) (string, error) {
+	model := models.PermissionFromDomain(permission)
+	model.ID = uuid.New().String()
+	if err := r.db.Client().WithContext(ctx).Create(model).Error; err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrInternal, "failed to create permission", err)
+	}
+	return model.ID, nil
+}
+
+// ListPermissions returns every permission.
+func (r *repository) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	var list []models.Permission
+	if err := r.db.Client().WithContext(ctx).Order("name").Find(&list).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to list permissions", err)
+	}
+	permissions := make([]domain.Permission, 0, len(list))
+	for _, p := range list {
+		permissions = append(permissions, p.ToDomain())
+	}
+	return permissions, nil
+}
+
+// GrantPermission adds a permission to a role. Granting twice is a no-op.
+func (r *repository) GrantPermission(ctx context.Context, roleID, permissionID string) error {
+	db := r.db.Client().WithContext(ctx)
+	if err := exists(db, &models.Role{}, roleID, "role"); err != nil {
+		return err
+	}
+	if err := exists(db, &models.Permission{}, permissionID, "permission"); err != nil {
+		return err
+	}
+	grant := models.RolePermission{RoleID: roleID, PermissionID: permissionID}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&grant).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to grant permission", err)
+	}
+	return nil
+}
+
+// RevokePermission removes a permission from a role.
+func (r *repository) RevokePermission(ctx context.Context, roleID, permissionID string) error {
+	if err := r.db.Client().WithContext(ctx).
+		Where("role_id = ? AND permission_id = ?", roleID, permissionID).
+		Delete(&models.RolePermission{}).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to revoke permission", err)
+	}
+	return nil
+}
+
+// AssignRole gives a role to a user. Assigning twice is a no-op.
+func (r *repository) AssignRole(ctx context.Context, userID, roleID string) error {
+	db := r.db.Client().WithContext(ctx)
+	if err := exists(db, &models.User{}, userID, "user"); err != nil {
+		return err
+	}
+	if err := exists(db, &models.Role{}, roleID, "role"); err != nil {
+		return err
+	}
+	assignment := models.UserRole{UserID: userID, RoleID: roleID}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&assignment).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to assign role", err)
+	}
+	return nil
+}
+
+// UnassignRole takes a role away from a user.
+func (r *repository) UnassignRole(ctx context.Context, userID, roleID string) error {
+	if err := r.db.Client().WithContext(ctx).
+		Where("user_id = ? AND role_id = ?", userID, roleID).
+		Delete(&models.UserRole{}).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to unassign role", err)
+	}
+	return nil
+}
+
+// GetUserRoles returns the roles of a user with their permissions.
+func (r *repository) GetUserRoles(ctx context.Context, userID string) ([]domain.Role, error) {
+	var roles []models.Role
+	if err := r.db.Client().WithContext(ctx).
+		Joins("JOIN user_roles ON user_roles.role_id = roles.id").
+		Where("user_roles.user_id = ?", userID).
+		Order("roles.name").
+		Find(&roles).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get user roles", err)
+	}
+	return r.withPermissions(ctx, roles)
+}
+
+// GetUserPermissions returns the distinct permission names granted to a user
+// through its roles.
+func (r *repository) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
+	var names []string
+	if err := r.db.Client().WithContext(ctx).
+		Model(&models.Permission{}).
+		Distinct("permissions.name").
+		Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
+		Joins("JOIN user_roles ON user_roles.role_id = role_permissions.role_id").
+		Joins("JOIN roles ON roles.id = user_roles.role_id AND roles.deleted_at IS NULL").
+		Where("user_roles.user_id = ?", userID).
+		Pluck("permissions.name", &names).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to get user permissions", err)
+	}
+	return names, nil
+}
+
+// EnsurePermissions creates the permissions that do not exist yet, matching by name.
+func (r *repository) EnsurePermissions(ctx context.Context, permissions []domain.Permission) error {
+	db := r.db.Client().WithContext(ctx)
+	for i := range permissions {
+		model := models.PermissionFromDomain(&permissions[i])
+		model.ID = uuid.New().String()
+		if err := db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).
+			Create(model).Error; err != nil {
+			return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to ensure permission %s", model.Name), err)
+		}
+	}
+	return nil
+}
+
+// EnsureRole creates the role if needed, matching by name, and grants it the
+// permissions of role.Permissions (also matched by name). It returns the role ID.
+func (r *repository) EnsureRole(ctx context.Context, role *domain.Role) (string, error) {
+	var roleID string
+	err := r.db.Client().WithContext(ctx).Transaction(func(tx *gorm0.DB) error {
+		model := models.RoleFromDomain(role)
+		model.ID = uuid.New().String()
+		if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).
+			Create(model).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Role{}).Where("name = ?", role.Name).Pluck("id", &roleID).Error; err != nil {
+			return err
+		}
+		names := make([]string, 0, len(role.Permissions))
+		for _, p := range role.Permissions {
+			names = append(names, p.Name)
+		}
+		if len(names) == 0 {
+			return nil
+		}
+		return tx.Exec(
+			"INSERT INTO role_permissions (role_id, permission_id) SELECT ?, id FROM permissions WHERE name IN ? ON CONFLICT DO NOTHING",
+			roleID, names,
+		).Error
+	})
+	if err != nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to ensure role %s", role.Name), err)
+	}
+	return roleID, nil
+}
+
+// withPermissions loads the permissions of the given roles in a single query.
+func (r *repository) withPermissions(ctx context.Context, roles []models.Role) ([]domain.Role, error) {
+	result := make([]domain.Role, 0, len(roles))
+	if len(roles) == 0 {
+		return result, nil
+	}
+	ids := make([]string, 0, len(roles))
+	for _, role := range roles {
+		ids = append(ids, role.ID)
+	}
+
+	var rows []struct {
+		RoleID      string
+		ID          string
+		Name        string
+		Description string
+	}
+	if err := r.db.Client().WithContext(ctx).
+		Table("role_permissions").
+		Select("role_permissions.role_id, permissions.id, permissions.name, permissions.description").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id AND permissions.deleted_at IS NULL").
+		Where("role_permissions.role_id IN ?", ids).
+		Order("permissions.name").
+		Scan(&rows).Error; err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to load role permissions", err)
+	}
+
+	byRole := make(map[string][]models.Permission, len(roles))
+	for _, row := range rows {
+		byRole[row.RoleID] = append(byRole[row.RoleID], models.Permission{ID: row.ID, Name: row.Name, Description: row.Description})
+	}
+	for _, role := range roles {
+		result = append(result, role.ToDomain(byRole[role.ID]))
+	}
+	return result, nil
+}
+
+// exists returns ErrNotFound when no row of model has the given id.
+func exists(db *gorm0.DB, model any, id, name string) error {
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, fmt.Sprintf("failed to check %s", name), err)
+	}
+	if count == 0 {
+		return pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("%s %s not found", name, id), nil)
+	}
+	return nil
+}
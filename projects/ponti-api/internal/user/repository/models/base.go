@@ -12,6 +12,7 @@ import (
 type User struct {
 	ID             string         `gorm:"primaryKey;column:id"`
 	PersonID       *string        `gorm:"column:person_id"`
+	OrganizationID *int64         `gorm:"column:organization_id;index"`
 	Email          string         `gorm:"column:email;unique;not null"`
 	Password       string         `gorm:"column:password;not null"`
 	EmailValidated bool           `gorm:"column:email_validated;default:false"`
@@ -69,6 +70,11 @@ func FromDomain(u *domain.User) (*User, error) {
 		personID = &u.PersonID
 	}
 
+	var organizationID *int64
+	if u.OrganizationID != 0 {
+		organizationID = &u.OrganizationID
+	}
+
 	var loggedAt *time.Time
 	if !u.LoggedAt.IsZero() {
 		loggedAt = &u.LoggedAt
@@ -77,6 +83,7 @@ func FromDomain(u *domain.User) (*User, error) {
 	return &User{
 		ID:             u.ID,
 		PersonID:       personID,
+		OrganizationID: organizationID,
 		Email:          u.Credentials.Email,
 		Password:       u.Credentials.Password,
 		EmailValidated: u.EmailValidated,
@@ -95,6 +102,11 @@ func (um *User) ToDomain() (*domain.User, error) {
 		personID = *um.PersonID
 	}
 
+	var organizationID int64
+	if um.OrganizationID != nil {
+		organizationID = *um.OrganizationID
+	}
+
 	var loggedAt time.Time
 	if um.LoggedAt != nil {
 		loggedAt = *um.LoggedAt
@@ -103,6 +115,7 @@ func (um *User) ToDomain() (*domain.User, error) {
 	return &domain.User{
 		ID:             um.ID,
 		PersonID:       personID,
+		OrganizationID: organizationID,
 		EmailValidated: um.EmailValidated,
 		Credentials: domain.Credentials{
 			Email:    um.Email,
@@ -113,3 +126,40 @@ func (um *User) ToDomain() (*domain.User, error) {
 		Roles:    []domain.Role{},
 	}, nil
 }
+
+func PermissionFromDomain(p *domain.Permission) *Permission {
+	return &Permission{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+}
+
+func (pm Permission) ToDomain() domain.Permission {
+	return domain.Permission{
+		ID:          pm.ID,
+		Name:        pm.Name,
+		Description: pm.Description,
+	}
+}
+
+func RoleFromDomain(r *domain.Role) *Role {
+	return &Role{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+func (rm Role) ToDomain(permissions []Permission) domain.Role {
+	role := domain.Role{
+		ID:          rm.ID,
+		Name:        rm.Name,
+		Description: rm.Description,
+		Permissions: make([]domain.Permission, 0, len(permissions)),
+	}
+	for _, p := range permissions {
+		role.Permissions = append(role.Permissions, p.ToDomain())
+	}
+	return role
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// UserIdentity vincula el subject de un proveedor OAuth2 con un usuario.
+type UserIdentity struct {
+	Provider  string    `gorm:"primaryKey;size:50;column:provider"`
+	Subject   string    `gorm:"primaryKey;size:255;column:subject"`
+	UserID    string    `gorm:"not null;index;column:user_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (m *UserIdentity) ToDomain() *domain.Identity {
+	return &domain.Identity{
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		UserID:    m.UserID,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func IdentityFromDomain(d *domain.Identity) *UserIdentity {
+	return &UserIdentity{
+		Provider: d.Provider,
+		Subject:  d.Subject,
+		UserID:   d.UserID,
+	}
+}
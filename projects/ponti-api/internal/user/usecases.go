@@ -2,27 +2,69 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-redis/redis/v8"
+
+	pkgredis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
 	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	orgdom "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization/usecases/domain"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
 )
 
+const permissionsKeyPrefix = "ponti-api:rbac"
+
+var errInvalidCredentials = pkgtypes.NewError(pkgtypes.ErrAuthentication, "invalid email or password", nil)
+
 type useCases struct {
 	repository Repository
+	cache      pkgredis.Cache
+	mailer     notification.UseCases
+	options    Options
+}
+
+// Options configura el cacheo de permisos y la verificación de email.
+type Options struct {
+	PermissionsTTL  time.Duration // Cuánto se cachean los permisos resueltos (5 minutos por defecto).
+	VerificationTTL time.Duration // Validez del token de verificación de email.
+	VerificationURL string        // URL base del link; el token se agrega como ?token=.
+	ResendCooldown  time.Duration // Espera mínima entre reenvíos al mismo email.
 }
 
-// NewUseCases crea una nueva instancia de useCases.
-func NewUseCases(rp Repository) UseCases {
+// NewUseCases crea una nueva instancia de useCases. Los permisos resueltos y los
+// tokens de verificación se guardan en Redis; con cache nil ninguno de los dos está disponible.
+func NewUseCases(rp Repository, cache pkgredis.Cache, mailer notification.UseCases, opts Options) UseCases {
+	// Sin vencimiento, un permiso revocado seguiría cacheado si falla la invalidación.
+	if opts.PermissionsTTL <= 0 {
+		opts.PermissionsTTL = 5 * time.Minute
+	}
+	if opts.VerificationTTL <= 0 {
+		opts.VerificationTTL = 24 * time.Hour
+	}
+	if opts.ResendCooldown <= 0 {
+		opts.ResendCooldown = time.Minute
+	}
 	return &useCases{
 		repository: rp,
+		cache:      cache,
+		mailer:     mailer,
+		options:    opts,
 	}
 }
 
 // CreateUser creates a new user by hashing the password (business logic) and storing the user using the repository.
 func (u *useCases) CreateUser(ctx context.Context, user *domain.User) (string, error) {
 	if user == nil {
-		return "", fmt.Errorf("user is nil")
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "user is required", nil)
 	}
 
 	// La transformación de la contraseña se hace en el use case (regla de negocio)
@@ -31,16 +73,27 @@ func (u *useCases) CreateUser(ctx context.Context, user *domain.User) (string, e
 		return "", fmt.Errorf("error hashing password: %w", err)
 	}
 	user.Credentials.Password = hashedPassword
+	user.EmailValidated = false
+	// El alta pública entra en la organización por defecto; otras organizaciones
+	// sólo se asignan desde el servidor (p. ej. al aceptar una invitación).
+	if user.OrganizationID == 0 {
+		user.OrganizationID = orgdom.DefaultOrganizationID
+	}
 
 	newUserID, err := u.repository.CreateUser(ctx, user)
 	if err != nil {
 		return "", fmt.Errorf("error creating user: %w", err)
 	}
 
+	// El alta no falla si el email no sale: el usuario puede pedir el reenvío.
+	if err := u.sendVerification(ctx, newUserID, user.Credentials.Email); err != nil {
+		log.Printf("[User] failed to send verification email to user %s: %v", newUserID, err)
+	}
+
 	return newUserID, nil
 }
 
-// ListUsers retrieves a list of all users.
+// ListUsers retrieves the users of the request's organization.
 func (u *useCases) ListUsers(ctx context.Context) ([]domain.User, error) {
 	users, err := u.repository.ListUsers(ctx)
 	if err != nil {
@@ -52,20 +105,94 @@ func (u *useCases) ListUsers(ctx context.Context) ([]domain.User, error) {
 // GetUser retrieves a user by its ID.
 func (u *useCases) GetUser(ctx context.Context, userID string) (*domain.User, error) {
 	if userID == "" {
-		return nil, fmt.Errorf("userID is empty")
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "user id is required", nil)
 	}
 
 	user, err := u.repository.GetUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving user with ID %s: %w", userID, err)
 	}
+	if !sameTenant(ctx, user) {
+		return nil, pkgtypes.NewError(pkgtypes.ErrNotFound, fmt.Sprintf("user with id %s not found", userID), nil)
+	}
+	return user, nil
+}
+
+// sameTenant indica si el usuario pertenece a la organización de la request.
+// Sin organización en el contexto (procesos internos, login) no se restringe.
+// Los usuarios de otra organización se reportan como inexistentes.
+func sameTenant(ctx context.Context, user *domain.User) bool {
+	tenantID, ok := pkgtypes.TenantIDFromContext(ctx)
+	if !ok {
+		return true
+	}
+	organizationID := user.OrganizationID
+	if organizationID == 0 {
+		organizationID = orgdom.DefaultOrganizationID
+	}
+	return organizationID == tenantID
+}
+
+// GetUserByEmail retrieves a user by its email.
+func (u *useCases) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if email == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "email is required", nil)
+	}
+	return u.repository.GetUserByEmail(ctx, email)
+}
+
+// VerifyCredentials returns the user owning the email if the password matches.
+// Unknown emails and wrong passwords fail with the same authentication error.
+func (u *useCases) VerifyCredentials(ctx context.Context, email, password string) (*domain.User, error) {
+	if email == "" || password == "" {
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "email and password are required", nil)
+	}
+	user, err := u.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return nil, errInvalidCredentials
+		}
+		return nil, err
+	}
+	// Callers bound to an organization (gRPC clients) only verify their own users.
+	if !sameTenant(ctx, user) {
+		return nil, errInvalidCredentials
+	}
+	ok, err := utils.VerifyPassword(password, user.Credentials.Password)
+	if err != nil {
+		return nil, pkgtypes.NewError(pkgtypes.ErrInternal, "failed to verify password", err)
+	}
+	if !ok {
+		return nil, errInvalidCredentials
+	}
 	return user, nil
 }
 
+// UpdatePassword hashes the new password and stores it. Complexity rules are
+// enforced by the caller.
+func (u *useCases) UpdatePassword(ctx context.Context, userID, password string) error {
+	if userID == "" || password == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "user id and password are required", nil)
+	}
+	hashedPassword, err := utils.HashPassword(password, 12)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to hash password", err)
+	}
+	return u.repository.UpdatePassword(ctx, userID, hashedPassword)
+}
+
+// RecordLogin stores the current time as the user's last login.
+func (u *useCases) RecordLogin(ctx context.Context, userID string) error {
+	if userID == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "user id is required", nil)
+	}
+	return u.repository.UpdateLoggedAt(ctx, userID, time.Now())
+}
+
 // DeleteUser deletes a user by its ID.
 func (u *useCases) DeleteUser(ctx context.Context, id string, hardDelete bool) error {
-	if id == "" {
-		return fmt.Errorf("id is empty")
+	if _, err := u.GetUser(ctx, id); err != nil {
+		return err
 	}
 
 	if err := u.repository.DeleteUser(ctx, id, hardDelete); err != nil {
@@ -74,13 +201,23 @@ func (u *useCases) DeleteUser(ctx context.Context, id string, hardDelete bool) e
 	return nil
 }
 
-// UpdateUser updates the user's information.
+// UpdateUser updates the user's profile. Only the profile fields are taken from
+// updatedUser: the password, the email verification and the organization have
+// their own flows and are never written from here.
 func (u *useCases) UpdateUser(ctx context.Context, updatedUser *domain.User) error {
 	if updatedUser == nil {
-		return fmt.Errorf("updatedUser is nil")
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "user is required", nil)
+	}
+	current, err := u.GetUser(ctx, updatedUser.ID)
+	if err != nil {
+		return err
 	}
 
-	if err := u.repository.UpdateUser(ctx, updatedUser); err != nil {
+	current.PersonID = updatedUser.PersonID
+	if updatedUser.UserType != "" {
+		current.UserType = updatedUser.UserType
+	}
+	if err := u.repository.UpdateUser(ctx, current); err != nil {
 		return fmt.Errorf("error updating user with ID %s: %w", updatedUser.ID, err)
 	}
 	return nil
@@ -89,7 +226,7 @@ func (u *useCases) UpdateUser(ctx context.Context, updatedUser *domain.User) err
 // FollowUser creates a follow relationship between two users.
 func (u *useCases) FollowUser(ctx context.Context, followerID, followeeID string) (string, error) {
 	if followerID == "" || followeeID == "" {
-		return "", fmt.Errorf("followerID or followeeID is empty")
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "follower_id and followee_id are required", nil)
 	}
 
 	// Verifica que el seguidor exista.
@@ -107,7 +244,7 @@ func (u *useCases) FollowUser(ctx context.Context, followerID, followeeID string
 		return "", fmt.Errorf("error checking follow relationship: %w", err)
 	}
 	if exists {
-		return "", fmt.Errorf("follower %s already follows user %s", followerID, followeeID)
+		return "", pkgtypes.NewError(pkgtypes.ErrConflict, fmt.Sprintf("user %s already follows user %s", followerID, followeeID), nil)
 	}
 
 	relationID, err := u.repository.FollowUser(ctx, followerID, followeeID)
@@ -120,7 +257,7 @@ func (u *useCases) FollowUser(ctx context.Context, followerID, followeeID string
 // GetFolloweeUsers retrieves the list of user IDs that the given follower is following.
 func (u *useCases) GetFolloweeUsers(ctx context.Context, followerID string) ([]string, error) {
 	if followerID == "" {
-		return nil, fmt.Errorf("followerID is empty")
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "user id is required", nil)
 	}
 
 	followees, err := u.repository.GetFolloweeUsers(ctx, followerID)
@@ -133,7 +270,7 @@ func (u *useCases) GetFolloweeUsers(ctx context.Context, followerID string) ([]s
 // GetFollowerUsers retrieves the list of user IDs that are following the given user (followee).
 func (u *useCases) GetFollowerUsers(ctx context.Context, followeeID string) ([]string, error) {
 	if followeeID == "" {
-		return nil, fmt.Errorf("followeeID is empty")
+		return nil, pkgtypes.NewError(pkgtypes.ErrValidation, "user id is required", nil)
 	}
 
 	followers, err := u.repository.GetFollowerUsers(ctx, followeeID)
@@ -142,3 +279,209 @@ func (u *useCases) GetFollowerUsers(ctx context.Context, followeeID string) ([]s
 	}
 	return followers, nil
 }
+
+// CreateRole crea un rol vacío; los permisos se otorgan con GrantPermission.
+func (u *useCases) CreateRole(ctx context.Context, role *domain.Role) (string, error) {
+	if role == nil || strings.TrimSpace(role.Name) == "" {
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "role name is required", nil)
+	}
+	role.Name = strings.TrimSpace(role.Name)
+	return u.repository.CreateRole(ctx, role)
+}
+
+func (u *useCases) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	return u.repository.ListRoles(ctx)
+}
+
+func (u *useCases) GetRole(ctx context.Context, id string) (*domain.Role, error) {
+	return u.repository.GetRole(ctx, id)
+}
+
+func (u *useCases) DeleteRole(ctx context.Context, id string) error {
+	if err := u.repository.DeleteRole(ctx, id); err != nil {
+		return err
+	}
+	u.invalidateAllPermissions(ctx)
+	return nil
+}
+
+// CreatePermission valida el formato "<recurso>:<acción>" antes de persistir.
+func (u *useCases) CreatePermission(ctx context.Context, permission *domain.Permission) (string, error) {
+	if permission == nil {
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "permission is required", nil)
+	}
+	resource, action, ok := strings.Cut(strings.TrimSpace(permission.Name), ":")
+	if !ok || resource == "" || action == "" {
+		return "", pkgtypes.NewError(pkgtypes.ErrValidation, "permission name must have the form resource:action", nil)
+	}
+	permission.Name = resource + ":" + action
+	return u.repository.CreatePermission(ctx, permission)
+}
+
+func (u *useCases) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	return u.repository.ListPermissions(ctx)
+}
+
+func (u *useCases) GrantPermission(ctx context.Context, roleID, permissionID string) error {
+	if err := u.repository.GrantPermission(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+	u.invalidateAllPermissions(ctx)
+	return nil
+}
+
+func (u *useCases) RevokePermission(ctx context.Context, roleID, permissionID string) error {
+	if err := u.repository.RevokePermission(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+	u.invalidateAllPermissions(ctx)
+	return nil
+}
+
+// AssignRole asigna un rol a un usuario de la organización de la request.
+func (u *useCases) AssignRole(ctx context.Context, userID, roleID string) error {
+	if err := u.checkRoleAssignment(ctx, userID, roleID); err != nil {
+		return err
+	}
+	if err := u.repository.AssignRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+	u.invalidatePermissions(ctx, userID)
+	return nil
+}
+
+// UnassignRole quita un rol a un usuario de la organización de la request.
+func (u *useCases) UnassignRole(ctx context.Context, userID, roleID string) error {
+	if err := u.checkRoleAssignment(ctx, userID, roleID); err != nil {
+		return err
+	}
+	if err := u.repository.UnassignRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+	u.invalidatePermissions(ctx, userID)
+	return nil
+}
+
+// checkRoleAssignment exige que el usuario sea de la organización de la request
+// y que sólo un administrador de la plataforma asigne o quite roles que otorgan
+// platform:admin (por ejemplo el rol admin, con el comodín).
+func (u *useCases) checkRoleAssignment(ctx context.Context, userID, roleID string) error {
+	if _, err := u.GetUser(ctx, userID); err != nil {
+		return err
+	}
+	role, err := u.repository.GetRole(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(role.Permissions))
+	for _, p := range role.Permissions {
+		names = append(names, p.Name)
+	}
+	if !mdw.HasPermission(names, domain.PlatformAdminPermission) {
+		return nil
+	}
+	callerID, ok := pkgtypes.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, "only platform administrators can manage role "+role.Name, nil)
+	}
+	granted, err := u.ResolvePermissions(ctx, callerID)
+	if err != nil {
+		return err
+	}
+	if !mdw.HasPermission(granted, domain.PlatformAdminPermission) {
+		return pkgtypes.NewError(pkgtypes.ErrAuthorization, "only platform administrators can manage role "+role.Name, nil)
+	}
+	return nil
+}
+
+// ResolvePermissions devuelve los nombres de permisos del usuario. Si Redis no
+// responde se consulta la base de datos directamente.
+func (u *useCases) ResolvePermissions(ctx context.Context, userID string) ([]string, error) {
+	key := u.permissionsKey(ctx, userID)
+	if key != "" {
+		if cached, err := u.cache.Get(ctx, key); err == nil {
+			var permissions []string
+			if err := json.Unmarshal([]byte(cached), &permissions); err == nil {
+				return permissions, nil
+			}
+		} else if !errors.Is(err, redis.Nil) {
+			log.Printf("[RBAC] failed to read cached permissions of %s: %v", userID, err)
+		}
+	}
+
+	permissions, err := u.repository.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		raw, _ := json.Marshal(permissions)
+		if err := u.cache.Set(ctx, key, raw, u.options.PermissionsTTL); err != nil {
+			log.Printf("[RBAC] failed to cache permissions of %s: %v", userID, err)
+		}
+	}
+	return permissions, nil
+}
+
+// SeedRBAC es idempotente y se ejecuta con las migraciones.
+func (u *useCases) SeedRBAC(ctx context.Context, adminEmail string) error {
+	if err := u.repository.EnsurePermissions(ctx, domain.DefaultPermissions); err != nil {
+		return err
+	}
+	adminID, err := u.repository.EnsureRole(ctx, &domain.Role{
+		Name:        domain.AdminRole,
+		Description: "Full access",
+		Permissions: []domain.Permission{{Name: domain.PermissionWildcard}},
+	})
+	if err != nil {
+		return err
+	}
+	if adminEmail == "" {
+		return nil
+	}
+	admin, err := u.repository.GetUserByEmail(ctx, adminEmail)
+	if err != nil {
+		var appErr *pkgtypes.Error
+		if errors.As(err, &appErr) && appErr.Type == pkgtypes.ErrNotFound {
+			log.Printf("[RBAC] admin user %s does not exist yet; skipping role assignment", adminEmail)
+			return nil
+		}
+		return err
+	}
+	return u.AssignRole(ctx, admin.ID, adminID)
+}
+
+// permissionsKey arma la clave de cache del usuario. Incluye una generación que
+// cambia con cada modificación de roles o permisos, así un cambio invalida a todos
+// los usuarios sin recorrer las claves. Devuelve "" si no hay cache disponible.
+func (u *useCases) permissionsKey(ctx context.Context, userID string) string {
+	if u.cache == nil {
+		return ""
+	}
+	generation, err := u.cache.Get(ctx, permissionsKeyPrefix+":generation")
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("[RBAC] failed to read permissions generation: %v", err)
+		return ""
+	}
+	if generation == "" {
+		generation = "0"
+	}
+	return permissionsKeyPrefix + ":" + generation + ":" + userID
+}
+
+func (u *useCases) invalidatePermissions(ctx context.Context, userID string) {
+	if key := u.permissionsKey(ctx, userID); key != "" {
+		if err := u.cache.Delete(ctx, key); err != nil {
+			log.Printf("[RBAC] failed to invalidate permissions of %s: %v", userID, err)
+		}
+	}
+}
+
+func (u *useCases) invalidateAllPermissions(ctx context.Context) {
+	if u.cache == nil {
+		return
+	}
+	generation := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := u.cache.Set(ctx, permissionsKeyPrefix+":generation", generation, 0); err != nil {
+		log.Printf("[RBAC] failed to bump permissions generation: %v", err)
+	}
+}
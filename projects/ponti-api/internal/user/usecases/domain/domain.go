@@ -14,6 +14,7 @@ type User struct {
 	ID             string
 	Credentials    Credentials
 	PersonID       string
+	OrganizationID int64
 	UserType       UserType
 	Roles          []Role
 	LoggedAt       time.Time
@@ -26,11 +27,14 @@ type Credentials struct {
 }
 
 type Role struct {
+	ID          string
 	Name        string
+	Description string
 	Permissions []Permission
 }
 
 type Permission struct {
+	ID          string
 	Name        string
 	Description string
 }
@@ -39,3 +43,19 @@ type Follow struct {
 	FollowerID string // seguidor
 	FolloweeID string // seguido
 }
+
+// Identity vincula una cuenta de un proveedor externo (OAuth2/OIDC) con un usuario.
+type Identity struct {
+	Provider  string
+	Subject   string
+	UserID    string
+	CreatedAt time.Time
+}
+
+// ExternalAccount es lo que informa el proveedor externo sobre quien inició sesión.
+type ExternalAccount struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
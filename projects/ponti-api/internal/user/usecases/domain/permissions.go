@@ -0,0 +1,41 @@
+package domain
+
+// Los permisos siguen el formato "<recurso>:<acción>". Un comodín en la acción
+// ("project:*") o el permiso "*" otorgan todas las acciones del recurso o del sistema.
+const (
+	PermissionWildcard = "*"
+
+	// AdminRole es el rol que se crea al migrar con todos los permisos.
+	AdminRole = "admin"
+
+	// PlatformAdminPermission gestiona lo que comparten todas las organizaciones:
+	// el catálogo de roles y permisos y las propias organizaciones.
+	PlatformAdminPermission = "platform:admin"
+)
+
+// DefaultPermissions es el catálogo de permisos que se siembra al migrar.
+var DefaultPermissions = []Permission{
+	{Name: "customer:read", Description: "List and view customers"},
+	{Name: "customer:write", Description: "Create, update and delete customers"},
+	{Name: "project:read", Description: "List and view projects"},
+	{Name: "project:write", Description: "Create, update and delete projects"},
+	{Name: "project:manage", Description: "Access every project of the organization regardless of membership"},
+	{Name: "field:read", Description: "List and view fields"},
+	{Name: "field:write", Description: "Create, update and delete fields"},
+	{Name: "lot:read", Description: "List and view lots"},
+	{Name: "lot:write", Description: "Create, update and delete lots"},
+	{Name: "crop:read", Description: "List and view crops"},
+	{Name: "crop:write", Description: "Create, update and delete crops"},
+	{Name: "manager:read", Description: "List and view managers"},
+	{Name: "manager:write", Description: "Create, update and delete managers"},
+	{Name: "investor:read", Description: "List and view investors"},
+	{Name: "investor:write", Description: "Create, update and delete investors"},
+	{Name: "rainfall:read", Description: "View rainfall readings and accumulations"},
+	{Name: "rainfall:write", Description: "Record and sync rainfall readings"},
+	{Name: "search:read", Description: "Use the global search"},
+	{Name: "rbac:manage", Description: "Assign roles to the users of the organization"},
+	{Name: PlatformAdminPermission, Description: "Manage organizations and the roles and permissions shared by every organization"},
+	{Name: "user:manage", Description: "View, update and delete any user of the organization"},
+	{Name: "session:manage", Description: "Force the logout of any user of the organization"},
+	{Name: PermissionWildcard, Description: "Every permission"},
+}
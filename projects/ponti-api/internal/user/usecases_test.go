@@ -0,0 +1,493 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/mocks"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user/usecases/domain"
+)
+
+// fakeCache is an in-memory stand-in for the Redis cache.
+type fakeCache struct {
+	data map[string]string
+	ttls map[string]time.Duration
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{data: map[string]string{}, ttls: map[string]time.Duration{}}
+}
+
+func (f *fakeCache) Set(_ context.Context, key string, value any, expiration ...time.Duration) error {
+	if len(expiration) > 0 {
+		f.ttls[key] = expiration[0]
+	}
+	switch v := value.(type) {
+	case []byte:
+		f.data[key] = string(v)
+	default:
+		f.data[key] = fmt.Sprint(v)
+	}
+	return nil
+}
+
+func (f *fakeCache) SetNX(ctx context.Context, key string, value any, _ time.Duration) (bool, error) {
+	if _, ok := f.data[key]; ok {
+		return false, nil
+	}
+	return true, f.Set(ctx, key, value)
+}
+
+func (f *fakeCache) Get(_ context.Context, key string) (string, error) {
+	v, ok := f.data[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	return v, nil
+}
+
+func (f *fakeCache) Delete(_ context.Context, key string) error {
+	delete(f.data, key)
+	return nil
+}
+
+func (f *fakeCache) TTL(context.Context, string) (time.Duration, error) { return 0, nil }
+func (f *fakeCache) Exists(_ context.Context, key string) (bool, error) {
+	_, ok := f.data[key]
+	return ok, nil
+}
+func (f *fakeCache) LPush(context.Context, string, ...any) error       { return nil }
+func (f *fakeCache) LTrim(context.Context, string, int64, int64) error { return nil }
+func (f *fakeCache) Close()                                            {}
+func (f *fakeCache) Client() *redis.Client                             { return nil }
+
+func TestResolvePermissions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		between     func(u UseCases, repo *mocks.MockRepository)
+		wantQueries int
+	}{
+		{
+			name:        "second lookup is served from the cache",
+			between:     func(UseCases, *mocks.MockRepository) {},
+			wantQueries: 1,
+		},
+		{
+			name: "assigning a role invalidates the user",
+			between: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u1").Return(&domain.User{ID: "u1"}, nil)
+				repo.EXPECT().GetRole(gomock.Any(), "r1").Return(&domain.Role{ID: "r1", Name: "agronomist"}, nil)
+				repo.EXPECT().AssignRole(gomock.Any(), "u1", "r1").Return(nil)
+				assert.NoError(t, u.AssignRole(ctx, "u1", "r1"))
+			},
+			wantQueries: 2,
+		},
+		{
+			name: "granting a permission invalidates every user",
+			between: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GrantPermission(gomock.Any(), "r1", "p1").Return(nil)
+				assert.NoError(t, u.GrantPermission(ctx, "r1", "p1"))
+			},
+			wantQueries: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			u := NewUseCases(repo, newFakeCache(), nil, Options{PermissionsTTL: time.Minute})
+
+			repo.EXPECT().
+				GetUserPermissions(gomock.Any(), "u1").
+				Return([]string{"project:read"}, nil).
+				Times(tt.wantQueries)
+
+			got, err := u.ResolvePermissions(ctx, "u1")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"project:read"}, got)
+
+			tt.between(u, repo)
+
+			got, err = u.ResolvePermissions(ctx, "u1")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"project:read"}, got)
+		})
+	}
+}
+
+func TestResolvePermissionsExpire(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		wantTTL time.Duration
+	}{
+		{name: "configured TTL", ttl: time.Minute, wantTTL: time.Minute},
+		{name: "zero TTL falls back to the default", wantTTL: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			cache := newFakeCache()
+			u := NewUseCases(repo, cache, nil, Options{PermissionsTTL: tt.ttl})
+
+			repo.EXPECT().GetUserPermissions(gomock.Any(), "u1").Return([]string{"project:read"}, nil)
+			_, err := u.ResolvePermissions(context.Background(), "u1")
+			assert.NoError(t, err)
+
+			assert.Len(t, cache.ttls, 1)
+			for _, ttl := range cache.ttls {
+				assert.Equal(t, tt.wantTTL, ttl)
+			}
+		})
+	}
+}
+
+// fakeMailer records the emails sent by the use cases.
+type fakeMailer struct {
+	bodies []string
+}
+
+func (f *fakeMailer) SendEmail(_ context.Context, _, _, body string) error {
+	f.bodies = append(f.bodies, body)
+	return nil
+}
+
+func tokenFromBody(t *testing.T, body string) string {
+	t.Helper()
+	_, after, found := strings.Cut(body, "token=")
+	if !assert.True(t, found, "body has no token: %s", body) {
+		return ""
+	}
+	return strings.Fields(after)[0]
+}
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	unverified := &domain.User{ID: "u1", Credentials: domain.Credentials{Email: "a@b.com"}}
+
+	tests := []struct {
+		name string
+		run  func(u UseCases, repo *mocks.MockRepository, mailer *fakeMailer)
+	}{
+		{
+			name: "token from sign-up verifies the email once",
+			run: func(u UseCases, repo *mocks.MockRepository, mailer *fakeMailer) {
+				repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return("u1", nil)
+				_, err := u.CreateUser(ctx, &domain.User{Credentials: domain.Credentials{Email: "a@b.com", Password: "x"}})
+				assert.NoError(t, err)
+				assert.Len(t, mailer.bodies, 1)
+
+				token := tokenFromBody(t, mailer.bodies[0])
+				repo.EXPECT().MarkEmailVerified(gomock.Any(), "u1").Return(nil)
+				assert.NoError(t, u.VerifyEmail(ctx, token))
+				assert.True(t, pkgtypes.IsValidationError(u.VerifyEmail(ctx, token)))
+			},
+		},
+		{
+			name: "resend invalidates the previous token",
+			run: func(u UseCases, repo *mocks.MockRepository, mailer *fakeMailer) {
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(unverified, nil).Times(2)
+				assert.NoError(t, u.ResendVerification(ctx, "a@b.com"))
+				first := tokenFromBody(t, mailer.bodies[0])
+
+				// Pasado el cooldown se puede volver a pedir.
+				assert.NoError(t, u.(*useCases).cache.Delete(ctx, verificationKeyPrefix+":resend:a@b.com"))
+				assert.NoError(t, u.ResendVerification(ctx, "a@b.com"))
+				second := tokenFromBody(t, mailer.bodies[1])
+
+				assert.True(t, pkgtypes.IsValidationError(u.VerifyEmail(ctx, first)))
+				repo.EXPECT().MarkEmailVerified(gomock.Any(), "u1").Return(nil)
+				assert.NoError(t, u.VerifyEmail(ctx, second))
+			},
+		},
+		{
+			name: "resend is rate limited per email",
+			run: func(u UseCases, repo *mocks.MockRepository, mailer *fakeMailer) {
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(unverified, nil)
+				assert.NoError(t, u.ResendVerification(ctx, "a@b.com"))
+
+				err := u.ResendVerification(ctx, "A@b.com")
+				errType, _ := pkgtypes.GetErrorType(err)
+				assert.Equal(t, pkgtypes.ErrTooManyRequests, errType)
+				assert.Len(t, mailer.bodies, 1)
+			},
+		},
+		{
+			name: "unknown email is not revealed",
+			run: func(u UseCases, repo *mocks.MockRepository, mailer *fakeMailer) {
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "x@b.com").
+					Return(nil, pkgtypes.NewError(pkgtypes.ErrNotFound, "not found", nil))
+				assert.NoError(t, u.ResendVerification(ctx, "x@b.com"))
+				assert.Empty(t, mailer.bodies)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			mailer := &fakeMailer{}
+			u := NewUseCases(repo, newFakeCache(), mailer, Options{VerificationURL: "http://localhost/verify"})
+
+			tt.run(u, repo, mailer)
+		})
+	}
+}
+
+func TestResolveExternalAccount(t *testing.T) {
+	ctx := context.Background()
+	linked := &domain.User{ID: "u1", Credentials: domain.Credentials{Email: "a@b.com"}, EmailValidated: true}
+	notFound := pkgtypes.NewError(pkgtypes.ErrNotFound, "not found", nil)
+
+	tests := []struct {
+		name     string
+		account  domain.ExternalAccount
+		setup    func(repo *mocks.MockRepository)
+		wantUser string
+		wantErr  func(error) bool
+	}{
+		{
+			name:    "already linked identity",
+			account: domain.ExternalAccount{Provider: "google", Subject: "ext-1", Email: "other@b.com"},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetIdentity(gomock.Any(), "google", "ext-1").
+					Return(&domain.Identity{Provider: "google", Subject: "ext-1", UserID: "u1"}, nil)
+				repo.EXPECT().GetUser(gomock.Any(), "u1").Return(linked, nil)
+			},
+			wantUser: "u1",
+		},
+		{
+			name:    "verified email links the existing user",
+			account: domain.ExternalAccount{Provider: "google", Subject: "ext-1", Email: "a@b.com", EmailVerified: true},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetIdentity(gomock.Any(), "google", "ext-1").Return(nil, notFound)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(linked, nil)
+				repo.EXPECT().LinkIdentity(gomock.Any(), &domain.Identity{Provider: "google", Subject: "ext-1", UserID: "u1"}).Return(nil)
+				repo.EXPECT().GetUser(gomock.Any(), "u1").Return(linked, nil)
+			},
+			wantUser: "u1",
+		},
+		{
+			name:    "unverified email does not take over the existing user",
+			account: domain.ExternalAccount{Provider: "google", Subject: "ext-1", Email: "a@b.com"},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetIdentity(gomock.Any(), "google", "ext-1").Return(nil, notFound)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "a@b.com").Return(linked, nil)
+			},
+			wantErr: pkgtypes.IsConflict,
+		},
+		{
+			name:    "first login creates the user",
+			account: domain.ExternalAccount{Provider: "google", Subject: "ext-2", Email: "new@b.com", EmailVerified: true},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetIdentity(gomock.Any(), "google", "ext-2").Return(nil, notFound)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "new@b.com").Return(nil, notFound)
+				repo.EXPECT().CreateUserWithIdentity(gomock.Any(), gomock.Any(), &domain.Identity{Provider: "google", Subject: "ext-2"}).
+					DoAndReturn(func(_ context.Context, usr *domain.User, _ *domain.Identity) (string, error) {
+						assert.Equal(t, "new@b.com", usr.Credentials.Email)
+						assert.True(t, usr.EmailValidated)
+						assert.NotEmpty(t, usr.Credentials.Password)
+						return "u2", nil
+					})
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(&domain.User{ID: "u2"}, nil)
+			},
+			wantUser: "u2",
+		},
+		{
+			name:    "provider without email",
+			account: domain.ExternalAccount{Provider: "google", Subject: "ext-3"},
+			setup: func(repo *mocks.MockRepository) {
+				repo.EXPECT().GetIdentity(gomock.Any(), "google", "ext-3").Return(nil, notFound)
+			},
+			wantErr: pkgtypes.IsValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo := mocks.NewMockRepository(ctrl)
+			tt.setup(repo)
+			u := NewUseCases(repo, newFakeCache(), nil, Options{})
+
+			account := tt.account
+			got, err := u.ResolveExternalAccount(ctx, &account)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUser, got.ID)
+		})
+	}
+}
+
+func TestUserTenantIsolation(t *testing.T) {
+	tenant1 := pkgtypes.WithTenantID(context.Background(), 1)
+	other := &domain.User{ID: "u2", OrganizationID: 2}
+	legacy := &domain.User{ID: "u3"} // Sin organización: pertenece a la organización por defecto.
+
+	tests := []struct {
+		name string
+		run  func(u UseCases, repo *mocks.MockRepository)
+	}{
+		{
+			name: "user of another organization is not found",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(other, nil)
+				_, err := u.GetUser(tenant1, "u2")
+				assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "user without organization belongs to the default one",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u3").Return(legacy, nil)
+				got, err := u.GetUser(tenant1, "u3")
+				assert.NoError(t, err)
+				assert.Equal(t, "u3", got.ID)
+			},
+		},
+		{
+			name: "user of another organization cannot be deleted",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(other, nil)
+				err := u.DeleteUser(tenant1, "u2", false)
+				assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "user of another organization cannot be updated",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(other, nil)
+				err := u.UpdateUser(tenant1, &domain.User{ID: "u2", PersonID: "p1"})
+				assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "credentials of another organization's user are invalid",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUserByEmail(gomock.Any(), "b@example.com").
+					Return(&domain.User{ID: "u2", OrganizationID: 2, Credentials: domain.Credentials{Email: "b@example.com"}}, nil)
+				_, err := u.VerifyCredentials(tenant1, "b@example.com", "secret")
+				assert.True(t, pkgtypes.IsAuthenticationError(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "roles of another organization's user cannot be assigned",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(other, nil)
+				err := u.AssignRole(tenant1, "u2", "r1")
+				assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "roles of another organization's user cannot be removed",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().GetUser(gomock.Any(), "u2").Return(other, nil)
+				err := u.UnassignRole(tenant1, "u2", "r1")
+				assert.True(t, pkgtypes.IsNotFound(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "a platform role needs a platform administrator",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				caller := pkgtypes.WithUserID(tenant1, "u1")
+				repo.EXPECT().GetUser(gomock.Any(), "u3").Return(legacy, nil)
+				repo.EXPECT().GetRole(gomock.Any(), "admin").
+					Return(&domain.Role{ID: "admin", Name: domain.AdminRole, Permissions: []domain.Permission{{Name: domain.PermissionWildcard}}}, nil)
+				repo.EXPECT().GetUserPermissions(gomock.Any(), "u1").Return([]string{"rbac:manage"}, nil)
+				err := u.AssignRole(caller, "u3", "admin")
+				assert.True(t, pkgtypes.IsAuthorizationError(err), "unexpected error: %v", err)
+			},
+		},
+		{
+			name: "a platform administrator assigns platform roles",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				caller := pkgtypes.WithUserID(tenant1, "u1")
+				repo.EXPECT().GetUser(gomock.Any(), "u3").Return(legacy, nil)
+				repo.EXPECT().GetRole(gomock.Any(), "admin").
+					Return(&domain.Role{ID: "admin", Name: domain.AdminRole, Permissions: []domain.Permission{{Name: domain.PermissionWildcard}}}, nil)
+				repo.EXPECT().GetUserPermissions(gomock.Any(), "u1").Return([]string{domain.PlatformAdminPermission}, nil)
+				repo.EXPECT().AssignRole(gomock.Any(), "u3", "admin").Return(nil)
+				assert.NoError(t, u.AssignRole(caller, "u3", "admin"))
+			},
+		},
+		{
+			name: "sign-up joins the default organization",
+			run: func(u UseCases, repo *mocks.MockRepository) {
+				repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, usr *domain.User) (string, error) {
+						assert.Equal(t, int64(1), usr.OrganizationID)
+						return "u4", nil
+					})
+				_, err := u.CreateUser(context.Background(), &domain.User{Credentials: domain.Credentials{Email: "a@b.com", Password: "x"}})
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo := mocks.NewMockRepository(ctrl)
+			u := NewUseCases(repo, newFakeCache(), &fakeMailer{}, Options{VerificationURL: "http://localhost/verify"})
+			tt.run(u, repo)
+		})
+	}
+}
+
+func TestUpdateUserOnlyWritesProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := mocks.NewMockRepository(ctrl)
+	u := NewUseCases(repo, newFakeCache(), nil, Options{})
+
+	stored := &domain.User{
+		ID:             "u1",
+		OrganizationID: 1,
+		UserType:       domain.UserTypePerson,
+		Credentials:    domain.Credentials{Email: "a@b.com", Password: "$argon2id$hash"},
+	}
+	repo.EXPECT().GetUser(gomock.Any(), "u1").Return(stored, nil)
+	repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, usr *domain.User) error {
+			assert.Equal(t, "p1", usr.PersonID)
+			assert.Equal(t, "$argon2id$hash", usr.Credentials.Password)
+			assert.Equal(t, "a@b.com", usr.Credentials.Email)
+			assert.False(t, usr.EmailValidated)
+			assert.Equal(t, int64(1), usr.OrganizationID)
+			return nil
+		})
+
+	err := u.UpdateUser(context.Background(), &domain.User{
+		ID:             "u1",
+		PersonID:       "p1",
+		EmailValidated: true,
+		OrganizationID: 2,
+		Credentials:    domain.Credentials{Email: "evil@b.com", Password: "plain"},
+	})
+	assert.NoError(t, err)
+}
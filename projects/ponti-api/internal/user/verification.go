@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	pkgtypes "github.com/alphacodinggroup/ponti-backend/pkg/types"
+)
+
+const verificationKeyPrefix = "ponti-api:verify"
+
+var errInvalidVerificationToken = pkgtypes.NewError(pkgtypes.ErrValidation, "invalid or expired verification token", nil)
+
+// VerifyEmail consume el token y marca el email del usuario como validado.
+// Sólo el último token emitido para el usuario es válido y se puede usar una vez.
+func (u *useCases) VerifyEmail(ctx context.Context, token string) error {
+	if token == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "token is required", nil)
+	}
+	if u.cache == nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "email verification is not configured", nil)
+	}
+
+	hash := hashVerificationToken(token)
+	userID, err := u.cache.Get(ctx, verificationKeyPrefix+":token:"+hash)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return errInvalidVerificationToken
+		}
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to read verification token", err)
+	}
+	current, err := u.cache.Get(ctx, verificationKeyPrefix+":user:"+userID)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to read verification token", err)
+	}
+	if current != hash {
+		// Un reenvío posterior invalida los tokens anteriores.
+		return errInvalidVerificationToken
+	}
+
+	if err := u.cache.Delete(ctx, verificationKeyPrefix+":token:"+hash); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to consume verification token", err)
+	}
+	if err := u.cache.Delete(ctx, verificationKeyPrefix+":user:"+userID); err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to consume verification token", err)
+	}
+	return u.repository.MarkEmailVerified(ctx, userID)
+}
+
+// ResendVerification emite un token nuevo y lo envía por email. Para no revelar qué
+// direcciones están registradas, emails desconocidos o ya verificados no devuelven error.
+func (u *useCases) ResendVerification(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return pkgtypes.NewError(pkgtypes.ErrValidation, "email is required", nil)
+	}
+	if u.cache == nil {
+		return pkgtypes.NewError(pkgtypes.ErrUnavailable, "email verification is not configured", nil)
+	}
+
+	allowed, err := u.cache.SetNX(ctx, verificationKeyPrefix+":resend:"+strings.ToLower(email), 1, u.options.ResendCooldown)
+	if err != nil {
+		return pkgtypes.NewError(pkgtypes.ErrInternal, "failed to check resend rate limit", err)
+	}
+	if !allowed {
+		return pkgtypes.NewErrorWithContext(
+			pkgtypes.ErrTooManyRequests,
+			"verification email was sent recently, try again later",
+			nil,
+			map[string]any{"retry_after_seconds": int(u.options.ResendCooldown.Seconds())},
+		)
+	}
+
+	user, err := u.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if pkgtypes.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailValidated {
+		return nil
+	}
+	return u.sendVerification(ctx, user.ID, user.Credentials.Email)
+}
+
+// sendVerification guarda un token nuevo para el usuario y le envía el link.
+// En Redis sólo se guarda el hash del token.
+func (u *useCases) sendVerification(ctx context.Context, userID, email string) error {
+	if u.cache == nil || u.mailer == nil {
+		return errors.New("email verification is not configured")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return fmt.Errorf("error generating verification token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+	hash := hashVerificationToken(token)
+
+	ttl := u.options.VerificationTTL
+	if err := u.cache.Set(ctx, verificationKeyPrefix+":token:"+hash, userID, ttl); err != nil {
+		return fmt.Errorf("error storing verification token: %w", err)
+	}
+	if err := u.cache.Set(ctx, verificationKeyPrefix+":user:"+userID, hash, ttl); err != nil {
+		return fmt.Errorf("error storing verification token: %w", err)
+	}
+
+	separator := "?"
+	if strings.Contains(u.options.VerificationURL, "?") {
+		separator = "&"
+	}
+	link := u.options.VerificationURL + separator + "token=" + url.QueryEscape(token)
+	body := fmt.Sprintf(
+		"Hola,\n\nPara verificar tu email ingresá al siguiente link:\n\n%s\n\nEl link vence en %s.\n",
+		link, ttl,
+	)
+	return u.mailer.SendEmail(ctx, email, "Verificá tu email", body)
+}
+
+func hashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
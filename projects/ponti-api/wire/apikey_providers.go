@@ -0,0 +1,27 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	apikey "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+)
+
+func ProvideAPIKeyRepository(repo gorm.Repository) (apikey.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return apikey.NewRepository(repo), nil
+}
+
+func ProvideAPIKeyUseCases(repo apikey.Repository, users user.UseCases) apikey.UseCases {
+	return apikey.NewUseCases(repo, users)
+}
+
+func ProvideAPIKeyHandler(server ginsrv.Server, usecases apikey.UseCases, middlewares *mdw.Middlewares) *apikey.Handler {
+	return apikey.NewHandler(server, usecases, middlewares)
+}
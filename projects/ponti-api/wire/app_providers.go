@@ -1,9 +1,27 @@
 package wire
 
 import (
+	"os"
+	"strconv"
+	"time"
+
 	config "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/cmd/config"
 )
 
 func ProvideConfigLoader() (config.Loader, error) {
 	return config.NewConfigLoader()
 }
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,127 @@
+package wire
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	pkgoauth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2"
+	pkgxaouth2 "github.com/alphacodinggroup/ponti-backend/pkg/authe/oauth2/xoauth2"
+	redis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	auth "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
+)
+
+// ProvideJwtKeySet returns the signing key set for JWT_SIGNING_ALG RS256 or
+// ES256, shared between replicas through Redis and encrypted there with
+// JWT_KEYS_ENCRYPTION_KEY. With HS256 (the default) it returns nil and tokens
+// keep using JWT_SECRET_KEY.
+func ProvideJwtKeySet(cache redis.Cache) (*pkgjwt.KeySet, error) {
+	alg := strings.ToUpper(os.Getenv("JWT_SIGNING_ALG"))
+	if alg == "" || alg == pkgjwt.AlgHS256 {
+		return nil, nil
+	}
+	refreshMinutes := envInt("JWT_DEFAULT_REFRESH_EXPIRATION_MINUTES", 7*24*60)
+	retention := envDuration("JWT_KEY_RETENTION", time.Duration(refreshMinutes)*time.Minute)
+	encryptionKey, err := base64.StdEncoding.DecodeString(os.Getenv("JWT_KEYS_ENCRYPTION_KEY"))
+	if err != nil {
+		return nil, fmt.Errorf("JWT_KEYS_ENCRYPTION_KEY must be base64: %w", err)
+	}
+	store, err := auth.NewKeyStore(cache, encryptionKey, retention)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT_KEYS_ENCRYPTION_KEY: %w", err)
+	}
+	keys, err := pkgjwt.NewKeySet(context.Background(), alg, store, pkgjwt.KeySetOptions{
+		Rotation:  envDuration("JWT_KEY_ROTATION_INTERVAL", 30*24*time.Hour),
+		Retention: retention,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT key set: %w", err)
+	}
+	return keys, nil
+}
+
+func ProvideJwtService(keys *pkgjwt.KeySet) (pkgjwt.Service, error) {
+	var (
+		service pkgjwt.Service
+		err     error
+	)
+	if keys != nil {
+		service, err = pkgjwt.BootstrapWithKeys(keys, 0, 0)
+	} else {
+		service, err = pkgjwt.Bootstrap("", 0, 0)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT service: %w", err)
+	}
+	return service, nil
+}
+
+func ProvideAuthTokenStore(cache redis.Cache, jwt pkgjwt.Service) auth.TokenStore {
+	return auth.NewTokenStore(cache, jwt.GetRefreshExpiration())
+}
+
+func ProvideAuthUseCases(users user.UseCases, jwt pkgjwt.Service, store auth.TokenStore, mailer notification.UseCases) (auth.UseCases, error) {
+	tenantClaim := os.Getenv("JWT_TENANT_CLAIM")
+	if tenantClaim == "" {
+		tenantClaim = mdw.DefaultTenantClaim
+	}
+	providers, err := oauthProvidersFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewUseCases(users, jwt, store, mailer, auth.Options{
+		TenantClaim:          tenantClaim,
+		RequireVerifiedEmail: os.Getenv("AUTH_REQUIRE_VERIFIED_EMAIL") == "true",
+		ResetTTL:             envDuration("PASSWORD_RESET_TTL", 15*time.Minute),
+		ResetURL:             os.Getenv("PASSWORD_RESET_URL"),
+		OAuthProviders:       providers,
+		OAuthStateTTL:        envDuration("OAUTH_STATE_TTL", 10*time.Minute),
+	}), nil
+}
+
+// oauthProvidersFromEnv builds one OAuth2 client per name in OAUTH_PROVIDERS,
+// configured with OAUTH_<NAME>_* variables.
+func oauthProvidersFromEnv() (map[string]pkgoauth2.Service, error) {
+	providers := map[string]pkgoauth2.Service{}
+	for _, name := range strings.Split(os.Getenv("OAUTH_PROVIDERS"), ",") {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+		scopes := strings.Split(os.Getenv(prefix+"SCOPES"), ",")
+		if os.Getenv(prefix+"SCOPES") == "" {
+			scopes = []string{"openid", "email", "profile"}
+		}
+		service, err := pkgxaouth2.NewService(&pkgxaouth2.Config{
+			BaseConfig: pkgoauth2.BaseConfig{
+				ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+				ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+				AuthURL:      os.Getenv(prefix + "AUTH_URL"),
+				TokenURL:     os.Getenv(prefix + "TOKEN_URL"),
+				RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+				UserInfoURL:  os.Getenv(prefix + "USERINFO_URL"),
+				Scopes:       scopes,
+				TimeoutSec:   envInt(prefix+"TIMEOUT_SECONDS", 10),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize oauth provider %s: %w", name, err)
+		}
+		providers[name] = service
+	}
+	return providers, nil
+}
+
+func ProvideAuthHandler(server ginsrv.Server, usecases auth.UseCases, middlewares *mdw.Middlewares, keys *pkgjwt.KeySet) *auth.Handler {
+	return auth.NewHandler(server, usecases, middlewares, keys)
+}
@@ -3,10 +3,16 @@ package wire
 import (
 	"fmt"
 
+	redis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	pgdb "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/postgresql/pgxpool"
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
+	pkghealth "github.com/alphacodinggroup/ponti-backend/pkg/health"
 	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	pkgmetrics "github.com/alphacodinggroup/ponti-backend/pkg/metrics"
+	grpcsrv "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
 	ssmtp "github.com/alphacodinggroup/ponti-backend/pkg/notification/smtp"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 )
 
 func ProvideGormRepository() (gorm.Repository, error) {
@@ -14,24 +20,54 @@ func ProvideGormRepository() (gorm.Repository, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Gorm: %w", err)
 	}
+	if err := gorm.RegisterTenantScope(repo.Client()); err != nil {
+		return nil, fmt.Errorf("failed to register tenant scope: %w", err)
+	}
+	if err := pkgmetrics.InstrumentGorm(repo.Client(), "gorm"); err != nil {
+		return nil, fmt.Errorf("failed to instrument Gorm: %w", err)
+	}
+	if err := pkgtracing.InstrumentGorm(repo.Client(), "postgresql"); err != nil {
+		return nil, fmt.Errorf("failed to instrument Gorm tracing: %w", err)
+	}
+	sqlDB, err := repo.Client().DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Gorm connection pool: %w", err)
+	}
+	if err := pkgmetrics.RegisterSQLDB("gorm", sqlDB); err != nil {
+		return nil, err
+	}
 
 	return repo, nil
 }
 
+// ProvideGinServer bootstraps the HTTP server. Its readiness probes report the
+// default health registry, where the pkg clients (gorm, pgx, redis, smtp) register.
 func ProvideGinServer() (ginsrv.Server, error) {
 	isTest := false
-	server, err := ginsrv.Bootstrap("", "", isTest)
+	server, err := ginsrv.Bootstrap("", "", isTest, pkghealth.Default())
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Gin server: %w", err)
 	}
 	return server, nil
 }
 
+// ProvideGrpcServer bootstraps the gRPC server on GRPC_SERVER_HOST:GRPC_SERVER_PORT (9090 by default).
+func ProvideGrpcServer() (grpcsrv.Server, error) {
+	server, err := grpcsrv.Bootstrap("", envInt("GRPC_SERVER_PORT", 9090))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize gRPC server: %w", err)
+	}
+	return server, nil
+}
+
 func ProvidePostgresRepository() (pgdb.Repository, error) {
 	repo, err := pgdb.Bootstrap("", "", "", "", "", "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to bootstrap PostgreSQL repository: %w", err)
 	}
+	if err := pkgmetrics.RegisterPgxPool("postgres", repo.Pool()); err != nil {
+		return nil, err
+	}
 	return repo, nil
 }
 
@@ -41,5 +77,24 @@ func ProvideSmtpService() (ssmtp.Service, error) {
 		return nil, fmt.Errorf("failed to initialize SMTP service: %w", err)
 	}
 
-	return ssmtp, nil
+	return pkgmetrics.InstrumentSMTP(ssmtp), nil
+}
+
+func ProvideRedisCache() (redis.Cache, error) {
+	cache, err := redis.Bootstrap("", "", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis cache: %w", err)
+	}
+	pkgmetrics.InstrumentRedis(cache.Client())
+	pkgtracing.InstrumentRedis(cache.Client())
+
+	return cache, nil
+}
+
+func ProvideSwaggerService() (pkgswagger.Service, error) {
+	service, err := pkgswagger.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Swagger service: %w", err)
+	}
+	return service, nil
 }
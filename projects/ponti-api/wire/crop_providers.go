@@ -24,3 +24,8 @@ func ProvideCropUseCases(repo crop.Repository) crop.UseCases {
 func ProvideCropHandler(server ginsrv.Server, usecases crop.UseCases, middlewares *mdw.Middlewares) *crop.Handler {
 	return crop.NewHandler(server, usecases, middlewares)
 }
+
+// ProvideCropGrpcServer creates the gRPC server for Crop use cases.
+func ProvideCropGrpcServer(usecases crop.UseCases) *crop.GrpcServer {
+	return crop.NewGrpcServer(usecases)
+}
@@ -9,14 +9,15 @@ import (
 
 	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
 	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 )
 
 // ProvideFieldRepository creates a Field repository instance.
-func ProvideFieldRepository(repo gorm.Repository) (field.Repository, error) {
+func ProvideFieldRepository(repo gorm.Repository, ob outbox.Repository) (field.Repository, error) {
 	if repo == nil {
 		return nil, errors.New("gorm repository cannot be nil")
 	}
-	return field.NewRepository(repo), nil
+	return field.NewRepository(repo, ob), nil
 }
 
 // ProvideFieldUseCases wires the Field use cases with repository and Lot service.
@@ -35,3 +36,8 @@ func ProvideFieldHandler(
 ) *field.Handler {
 	return field.NewHandler(server, fieldUC, middlewares)
 }
+
+// ProvideFieldGrpcServer creates the gRPC server for Field use cases.
+func ProvideFieldGrpcServer(usecases field.UseCases) *field.GrpcServer {
+	return field.NewGrpcServer(usecases)
+}
@@ -8,13 +8,14 @@ import (
 	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
 
 	investor "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 )
 
-func ProvideInvestorRepository(repo gorm.Repository) (investor.Repository, error) {
+func ProvideInvestorRepository(repo gorm.Repository, ob outbox.Repository) (investor.Repository, error) {
 	if repo == nil {
 		return nil, errors.New("gorm repository cannot be nil")
 	}
-	return investor.NewRepository(repo), nil
+	return investor.NewRepository(repo, ob), nil
 }
 
 func ProvideInvestorUseCases(repo investor.Repository) investor.UseCases {
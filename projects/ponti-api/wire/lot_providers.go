@@ -9,13 +9,14 @@ import (
 
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop"
 	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 )
 
-func ProvideLotRepository(repo gorm.Repository) (lot.Repository, error) {
+func ProvideLotRepository(repo gorm.Repository, ob outbox.Repository) (lot.Repository, error) {
 	if repo == nil {
 		return nil, errors.New("gorm repository cannot be nil")
 	}
-	return lot.NewRepository(repo), nil
+	return lot.NewRepository(repo, ob), nil
 }
 
 func ProvideLotUseCases(repo lot.Repository, cropUC crop.UseCases) lot.UseCases {
@@ -25,3 +26,8 @@ func ProvideLotUseCases(repo lot.Repository, cropUC crop.UseCases) lot.UseCases
 func ProvideLotHandler(server ginsrv.Server, usecases lot.UseCases, middlewares *mdw.Middlewares) *lot.Handler {
 	return lot.NewHandler(server, usecases, middlewares)
 }
+
+// ProvideLotGrpcServer creates the gRPC server for Lot use cases.
+func ProvideLotGrpcServer(usecases lot.UseCases) *lot.GrpcServer {
+	return lot.NewGrpcServer(usecases)
+}
@@ -1,29 +1,70 @@
 package wire
 
 import (
+	"os"
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
 
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	redis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	pkgmetrics "github.com/alphacodinggroup/ponti-backend/pkg/metrics"
+	pkgtracing "github.com/alphacodinggroup/ponti-backend/pkg/tracing"
 	utils "github.com/alphacodinggroup/ponti-backend/pkg/utils"
+
+	apikey "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey"
+	auth "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
-func ProvideJwtMiddleware() (gin.HandlerFunc, error) {
-	middleware := mdw.Validate(utils.NewConfigFromEnv())
+// ProvideJwtMiddleware verifies tokens that carry a kid against our own key set
+// and rejects revoked tokens (logout, revoked sessions, forced logout).
+func ProvideJwtMiddleware(keys *pkgjwt.KeySet, store auth.TokenStore) (gin.HandlerFunc, error) {
+	cfg := utils.NewConfigFromEnv()
+	if keys != nil {
+		cfg.Keys = keys
+	}
+	cfg.Denylist = auth.NewDenylist(store)
+	middleware := mdw.Validate(cfg)
 	return middleware, nil
 }
 
-func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc) (*mdw.Middlewares, error) {
+func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc, cache redis.Cache, users user.UseCases, apiKeys apikey.UseCases) (*mdw.Middlewares, error) {
+	// RequestID va primero para que todos los logs de la request lleven su ID;
+	// el span de tracing se abre antes del logger para que registre el trace_id.
 	globalMiddlewares := []gin.HandlerFunc{
-		mdw.ErrorHandlingMiddleware(),
+		mdw.RequestID(),
+		pkgtracing.Middleware(),
+		pkgmetrics.Middleware(),
+		// Everything written below, error bodies included, is compressed when the
+		// client accepts it; metrics record the compressed size.
+		mdw.Compression(mdw.CompressionOptions{
+			MinSize: envInt("COMPRESSION_MIN_SIZE", 1024),
+			ExcludedPaths: []string{
+				"/livez",
+				"/readyz",
+				"/metrics",
+			},
+		}),
+		// Errors are answered as problem+json; the internal cause is only shown in dev.
+		mdw.ErrorHandlingMiddleware(mdw.ErrorHandlingOptions{
+			TypeBase:      os.Getenv("PROBLEM_TYPE_BASE_URI"),
+			ExposeDetails: os.Getenv("APP_ENV") == "dev",
+		}),
 		mdw.RequestAndResponseLogger(mdw.HttpLoggingOptions{
 			LogLevel:       "info",
 			IncludeHeaders: true,
 			IncludeBody:    false,
 			ExcludedPaths: []string{
 				"/health",
+				"/livez",
+				"/readyz",
 				"/ping",
-				"/swagger/spec",
-				"/swagger/ui/index.html",
+				"/metrics",
+				"/swagger.json",
+				"/api-docs",
 			},
 		}),
 	}
@@ -32,13 +73,93 @@ func ProvideMiddlewares(jwtMiddleware gin.HandlerFunc) (*mdw.Middlewares, error)
 		mdw.ValidateCredentials(),
 	}
 
+	rateLimits := rateLimitsFromEnv(cache)
+
+	// X-API-Key es una alternativa al JWT para integraciones; completa los mismos datos.
+	// El límite "api" va después de Subject para contar por usuario o API key.
 	protectedMiddlewares := []gin.HandlerFunc{
-		jwtMiddleware,
+		mdw.APIKey(apikey.Authenticator(apiKeys), jwtMiddleware),
+		mdw.Subject(utils.NewConfigFromEnv(), os.Getenv("JWT_SUBJECT_CLAIM")),
+		rateLimits.Policy("api"),
+	}
+
+	idempotentMiddlewares := []gin.HandlerFunc{
+		mdw.Idempotency(cache, mdw.IdempotencyOptions{
+			TTL:       24 * time.Hour,
+			LockTTL:   time.Minute,
+			KeyPrefix: "ponti-api:idempotency",
+		}),
+	}
+
+	// Se aplican después de los protegidos, que validan el JWT.
+	tenantMiddlewares := []gin.HandlerFunc{
+		mdw.Tenant(utils.NewConfigFromEnv(), os.Getenv("JWT_TENANT_CLAIM")),
 	}
 
+	cachePolicies := cachePoliciesFromEnv()
+
+	rbac := mdw.NewRBAC(utils.NewConfigFromEnv(), users, os.Getenv("JWT_SUBJECT_CLAIM"))
+
 	return &mdw.Middlewares{
-		Global:    globalMiddlewares,
-		Validated: validatedMiddlewares,
-		Protected: protectedMiddlewares,
+		Global:     globalMiddlewares,
+		Validated:  validatedMiddlewares,
+		Protected:  protectedMiddlewares,
+		Idempotent: idempotentMiddlewares,
+		Tenant:     tenantMiddlewares,
+
+		RequirePermission:       rbac.RequirePermission,
+		RequireSelfOrPermission: rbac.RequireSelfOrPermission,
+		RateLimit:               rateLimits.Policy,
+		Cache:                   cachePolicies.Policy,
 	}, nil
 }
+
+// rateLimitsFromEnv builds the rate limit policies. RATE_LIMIT_BACKEND=redis
+// shares the counters between replicas; local (the default) keeps them in memory.
+// Each policy reads RATE_LIMIT_<NAME>_LIMIT and RATE_LIMIT_<NAME>_WINDOW.
+func rateLimitsFromEnv(cache redis.Cache) *mdw.RateLimits {
+	var limiter mdw.RateLimiter
+	if os.Getenv("RATE_LIMIT_BACKEND") == "redis" {
+		limiter = mdw.NewRedisRateLimiter(cache, "ponti-api:ratelimit")
+	} else {
+		limiter = mdw.NewLocalRateLimiter()
+	}
+
+	policy := func(name string, limit int, window time.Duration, key mdw.RateLimitKeyFunc) mdw.RateLimitPolicy {
+		prefix := "RATE_LIMIT_" + strings.ToUpper(name) + "_"
+		return mdw.RateLimitPolicy{
+			Name:   name,
+			Limit:  envInt(prefix+"LIMIT", limit),
+			Window: envDuration(prefix+"WINDOW", window),
+			Key:    key,
+		}
+	}
+	return mdw.NewRateLimits(limiter,
+		// Unauthenticated endpoints that send email or create users.
+		policy("public", 20, time.Minute, mdw.KeyByIP),
+		// Login, refresh and password reset.
+		policy("auth", 10, time.Minute, mdw.KeyByIP),
+		// Every protected route, per user or API key.
+		policy("api", 600, time.Minute, mdw.KeyByIdentity),
+	)
+}
+
+// cachePoliciesFromEnv builds the cache policies used by the route groups. Each
+// policy's Cache-Control can be overridden with CACHE_<NAME>_CONTROL.
+func cachePoliciesFromEnv() *mdw.CachePolicies {
+	policy := func(name, cacheControl string, etag bool) mdw.CachePolicy {
+		if value := os.Getenv("CACHE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_CONTROL"); value != "" {
+			cacheControl = value
+		}
+		return mdw.CachePolicy{Name: name, CacheControl: cacheControl, ETag: etag}
+	}
+	return mdw.NewCachePolicies(
+		// Tenant data: clients keep it but revalidate every time, getting a 304
+		// when nothing changed.
+		policy("private", "private, no-cache", true),
+		// Data that is the same for everyone, such as the JWKS.
+		policy("public", "public, max-age=300", true),
+		// Sensitive responses that must never be stored.
+		policy("no-store", "no-store", false),
+	)
+}
@@ -0,0 +1,26 @@
+package wire
+
+import (
+	"errors"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	organization "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization"
+)
+
+func ProvideOrganizationRepository(repo gorm.Repository) (organization.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return organization.NewRepository(repo), nil
+}
+
+func ProvideOrganizationUseCases(repo organization.Repository) organization.UseCases {
+	return organization.NewUseCases(repo)
+}
+
+func ProvideOrganizationHandler(server ginsrv.Server, usecases organization.UseCases, middlewares *mdw.Middlewares) *organization.Handler {
+	return organization.NewHandler(server, usecases, middlewares)
+}
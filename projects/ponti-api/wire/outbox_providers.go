@@ -0,0 +1,72 @@
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	pkginmemory "github.com/alphacodinggroup/ponti-backend/pkg/brokers/inmemory"
+	pkgafka "github.com/alphacodinggroup/ponti-backend/pkg/brokers/kafka"
+	pkgrabbit "github.com/alphacodinggroup/ponti-backend/pkg/brokers/rabbitmq/amqp091/producer"
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	pkgmetrics "github.com/alphacodinggroup/ponti-backend/pkg/metrics"
+
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
+)
+
+// ProvideOutboxRepository creates the Outbox repository instance.
+func ProvideOutboxRepository(repo gorm.Repository) (outbox.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return outbox.NewRepository(repo), nil
+}
+
+// ProvideOutboxPublisher selects the broker from OUTBOX_BROKER (rabbitmq, kafka or local).
+// The local broker only delivers to in-process subscribers, so events are lost
+// outside dev and test: there it must be chosen explicitly.
+func ProvideOutboxPublisher() (outbox.Publisher, error) {
+	switch broker := os.Getenv("OUTBOX_BROKER"); broker {
+	case "rabbitmq":
+		producer, err := pkgrabbit.Bootstrap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize RabbitMQ producer: %w", err)
+		}
+		return outbox.NewRabbitPublisher(pkgmetrics.InstrumentRabbitProducer(producer)), nil
+	case "kafka":
+		service, err := pkgafka.Bootstrap("KAFKA_BROKERS", "KAFKA_GROUP_ID")
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Kafka service: %w", err)
+		}
+		topic := os.Getenv("OUTBOX_KAFKA_TOPIC")
+		if topic == "" {
+			topic = "ponti.events"
+		}
+		return outbox.NewKafkaPublisher(pkgmetrics.InstrumentKafka(service), topic), nil
+	case "":
+		if env := os.Getenv("APP_ENV"); env != "dev" && env != "test" {
+			return nil, fmt.Errorf("OUTBOX_BROKER is required when APP_ENV is %q (rabbitmq, kafka or local)", env)
+		}
+		fallthrough
+	case "local":
+		broker, err := pkginmemory.Bootstrap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize in-memory broker: %w", err)
+		}
+		return outbox.NewLocalPublisher(pkgmetrics.InstrumentInMemory(broker)), nil
+	default:
+		return nil, fmt.Errorf("unsupported OUTBOX_BROKER %q", broker)
+	}
+}
+
+// ProvideOutboxRelay creates the relay that drains the outbox into the broker.
+func ProvideOutboxRelay(repo outbox.Repository, publisher outbox.Publisher) outbox.Relay {
+	return outbox.NewRelay(repo, publisher, outbox.RelayConfig{
+		BatchSize:    envInt("OUTBOX_BATCH_SIZE", 100),
+		PollInterval: envDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		Lease:        envDuration("OUTBOX_LEASE", 30*time.Second),
+		MaxAttempts:  envInt("OUTBOX_MAX_ATTEMPTS", 10),
+		RetryBackoff: envDuration("OUTBOX_RETRY_BACKOFF", time.Second),
+	})
+}
@@ -2,6 +2,8 @@ package wire
 
 import (
 	"errors"
+	"os"
+	"time"
 
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
@@ -12,15 +14,18 @@ import (
 	investor "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/investor"
 	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
 	manager "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager"
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 	project "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project"
+	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
 // ProvideProjectRepository creates a Project repository instance.
-func ProvideProjectRepository(repo gorm.Repository) (project.Repository, error) {
+func ProvideProjectRepository(repo gorm.Repository, ob outbox.Repository) (project.Repository, error) {
 	if repo == nil {
 		return nil, errors.New("gorm repository cannot be nil")
 	}
-	return project.NewRepository(repo), nil
+	return project.NewRepository(repo, ob), nil
 }
 
 // ProvideProjectUseCases wires the Project use cases with its repository and required services.
@@ -31,8 +36,13 @@ func ProvideProjectUseCases(
 	investorUC investor.UseCases,
 	fieldUC field.UseCases,
 	lotUC lot.UseCases,
+	userUC user.UseCases,
+	mailer notification.UseCases,
 ) project.UseCases {
-	return project.NewUseCases(repo, customerUC, managerUC, investorUC, fieldUC, lotUC)
+	return project.NewUseCases(repo, customerUC, managerUC, investorUC, fieldUC, lotUC, userUC, mailer, project.Options{
+		InvitationTTL: envDuration("PROJECT_INVITATION_TTL", 7*24*time.Hour),
+		InvitationURL: os.Getenv("PROJECT_INVITATION_URL"),
+	})
 }
 
 // ProvideProjectHandler creates the HTTP handler for Project endpoints.
@@ -43,3 +53,8 @@ func ProvideProjectHandler(
 ) *project.Handler {
 	return project.NewHandler(server, projUC, middlewares)
 }
+
+// ProvideProjectGrpcServer creates the gRPC server for Project use cases.
+func ProvideProjectGrpcServer(usecases project.UseCases) *project.GrpcServer {
+	return project.NewGrpcServer(usecases)
+}
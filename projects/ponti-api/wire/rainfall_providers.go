@@ -0,0 +1,37 @@
+package wire
+
+import (
+	"errors"
+	"os"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
+	rainfall "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall"
+)
+
+// ProvideRainfallRepository creates a Rainfall repository instance.
+func ProvideRainfallRepository(repo gorm.Repository) (rainfall.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	return rainfall.NewRepository(repo), nil
+}
+
+// ProvideRainfallProvider returns the external source of readings. Only the
+// file-backed stub exists for now; it reads RAINFALL_PROVIDER_FILE.
+func ProvideRainfallProvider() rainfall.Provider {
+	return rainfall.NewFileProvider(os.Getenv("RAINFALL_PROVIDER_FILE"))
+}
+
+// ProvideRainfallUseCases wires the Rainfall use cases with its repository, Field service and provider.
+func ProvideRainfallUseCases(repo rainfall.Repository, fieldUC field.UseCases, provider rainfall.Provider) rainfall.UseCases {
+	return rainfall.NewUseCases(repo, fieldUC, provider)
+}
+
+// ProvideRainfallHandler creates the HTTP handler for Rainfall endpoints.
+func ProvideRainfallHandler(server ginsrv.Server, usecases rainfall.UseCases, middlewares *mdw.Middlewares) *rainfall.Handler {
+	return rainfall.NewHandler(server, usecases, middlewares)
+}
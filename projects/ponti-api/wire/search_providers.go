@@ -0,0 +1,34 @@
+package wire
+
+import (
+	"errors"
+	"fmt"
+
+	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
+	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
+	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+
+	search "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search"
+)
+
+// ProvideSearchRepository creates the global search repository.
+func ProvideSearchRepository(repo gorm.Repository) (search.Repository, error) {
+	if repo == nil {
+		return nil, errors.New("gorm repository cannot be nil")
+	}
+	searchRepo, err := search.NewRepository(repo, envInt("SEARCH_LIMIT_PER_TYPE", 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize search repository: %w", err)
+	}
+	return searchRepo, nil
+}
+
+// ProvideSearchUseCases wires the Search use cases with its repository.
+func ProvideSearchUseCases(repo search.Repository) search.UseCases {
+	return search.NewUseCases(repo)
+}
+
+// ProvideSearchHandler creates the HTTP handler for the global search.
+func ProvideSearchHandler(server ginsrv.Server, usecases search.UseCases, middlewares *mdw.Middlewares) *search.Handler {
+	return search.NewHandler(server, usecases, middlewares)
+}
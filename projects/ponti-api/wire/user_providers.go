@@ -2,11 +2,15 @@ package wire
 
 import (
 	"errors"
+	"os"
+	"time"
 
+	redis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
 	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
 
+	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
 	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
@@ -17,10 +21,20 @@ func ProvideUserRepository(repo gorm.Repository) (user.Repository, error) {
 	return user.NewRepository(repo), nil
 }
 
-func ProvideUserUseCases(repo user.Repository) user.UseCases {
-	return user.NewUseCases(repo)
+func ProvideUserUseCases(repo user.Repository, cache redis.Cache, mailer notification.UseCases) user.UseCases {
+	return user.NewUseCases(repo, cache, mailer, user.Options{
+		PermissionsTTL:  envDuration("RBAC_PERMISSIONS_TTL", 5*time.Minute),
+		VerificationTTL: envDuration("EMAIL_VERIFICATION_TTL", 24*time.Hour),
+		VerificationURL: os.Getenv("EMAIL_VERIFICATION_URL"),
+		ResendCooldown:  envDuration("EMAIL_VERIFICATION_RESEND_COOLDOWN", time.Minute),
+	})
 }
 
 func ProvideUserHandler(server ginsrv.Server, usecases user.UseCases, middlewares *mdw.Middlewares) *user.Handler {
 	return user.NewHandler(server, usecases, middlewares)
 }
+
+// ProvideUserGrpcServer creates the gRPC server for User use cases.
+func ProvideUserGrpcServer(usecases user.UseCases) *user.GrpcServer {
+	return user.NewGrpcServer(usecases)
+}
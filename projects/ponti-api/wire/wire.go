@@ -4,15 +4,21 @@
 package wire
 
 import (
+	pkgjwt "github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	redis "github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
 	gorm "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	pg "github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/postgresql/pgxpool"
+	pkgswagger "github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
 	mdw "github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
 	ginsrv "github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	grpcsrv "github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
 	smtp "github.com/alphacodinggroup/ponti-backend/pkg/notification/smtp"
 	"github.com/google/wire"
 
 	config "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/cmd/config"
 
+	apikey "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey"
+	auth "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth"
 	crop "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop"
 	customer "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer"
 	field "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
@@ -20,19 +26,31 @@ import (
 	lot "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
 	manager "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager"
 	notification "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	organization "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization"
+	outbox "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 	person "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person"
 	project "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project"
+	rainfall "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall"
+	search "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search"
 	user "github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
 type Dependencies struct {
 	ConfigLoader       config.Loader
 	GinServer          ginsrv.Server
+	GrpcServer         grpcsrv.Server
 	GormRepository     gorm.Repository
 	PostgresRepository pg.Repository
 	SmtpService        smtp.Service
+	RedisCache         redis.Cache
+	SwaggerService     pkgswagger.Service
+	JwtKeySet          *pkgjwt.KeySet
 
 	Middlewares *mdw.Middlewares
+	OutboxRelay outbox.Relay
+
+	OrganizationRepository organization.Repository
+	SearchRepository       search.Repository
 
 	PersonHandler       *person.Handler
 	UserHandler         *user.Handler
@@ -44,6 +62,17 @@ type Dependencies struct {
 	InvestorHandler     *investor.Handler
 	LotHandler          *lot.Handler
 	ProjectHandler      *project.Handler
+	RainfallHandler     *rainfall.Handler
+	SearchHandler       *search.Handler
+	OrganizationHandler *organization.Handler
+	AuthHandler         *auth.Handler
+	APIKeyHandler       *apikey.Handler
+
+	UserGrpcServer    *user.GrpcServer
+	CropGrpcServer    *crop.GrpcServer
+	LotGrpcServer     *lot.GrpcServer
+	FieldGrpcServer   *field.GrpcServer
+	ProjectGrpcServer *project.GrpcServer
 
 	PersonUseCases   person.UseCases
 	UserUseCases     user.UseCases
@@ -53,17 +82,31 @@ type Dependencies struct {
 	InvestorUseCases investor.UseCases
 	LotUseCases      lot.UseCases
 	ProjectUseCases  project.UseCases
+	RainfallUseCases rainfall.UseCases
+	SearchUseCases   search.UseCases
+
+	OrganizationUseCases organization.UseCases
+	AuthUseCases         auth.UseCases
+	APIKeyUseCases       apikey.UseCases
 }
 
 func Initialize() (*Dependencies, error) {
 	wire.Build(
 		ProvideConfigLoader,
 		ProvideGinServer,
+		ProvideGrpcServer,
 		ProvideGormRepository,
 		ProvidePostgresRepository,
+		ProvideJwtKeySet,
 		ProvideJwtMiddleware,
 		ProvideMiddlewares,
 		ProvideSmtpService,
+		ProvideRedisCache,
+		ProvideSwaggerService,
+
+		ProvideOutboxRepository,
+		ProvideOutboxPublisher,
+		ProvideOutboxRelay,
 
 		ProvidePersonRepository,
 		ProvidePersonUseCases,
@@ -72,6 +115,7 @@ func Initialize() (*Dependencies, error) {
 		ProvideUserRepository,
 		ProvideUserUseCases,
 		ProvideUserHandler,
+		ProvideUserGrpcServer,
 
 		ProvideNotificationSmtpService,
 		ProvideNotificationUseCases,
@@ -80,6 +124,7 @@ func Initialize() (*Dependencies, error) {
 		ProvideCropRepository,
 		ProvideCropUseCases,
 		ProvideCropHandler,
+		ProvideCropGrpcServer,
 
 		ProvideCustomerRepository,
 		ProvideCustomerUseCases,
@@ -92,6 +137,7 @@ func Initialize() (*Dependencies, error) {
 		ProvideFieldRepository,
 		ProvideFieldUseCases,
 		ProvideFieldHandler,
+		ProvideFieldGrpcServer,
 
 		ProvideInvestorRepository,
 		ProvideInvestorUseCases,
@@ -100,10 +146,34 @@ func Initialize() (*Dependencies, error) {
 		ProvideLotRepository,
 		ProvideLotUseCases,
 		ProvideLotHandler,
+		ProvideLotGrpcServer,
 
 		ProvideProjectRepository,
 		ProvideProjectUseCases,
 		ProvideProjectHandler,
+		ProvideProjectGrpcServer,
+
+		ProvideRainfallRepository,
+		ProvideRainfallProvider,
+		ProvideRainfallUseCases,
+		ProvideRainfallHandler,
+
+		ProvideSearchRepository,
+		ProvideSearchUseCases,
+		ProvideSearchHandler,
+
+		ProvideOrganizationRepository,
+		ProvideOrganizationUseCases,
+		ProvideOrganizationHandler,
+
+		ProvideJwtService,
+		ProvideAuthTokenStore,
+		ProvideAuthUseCases,
+		ProvideAuthHandler,
+
+		ProvideAPIKeyRepository,
+		ProvideAPIKeyUseCases,
+		ProvideAPIKeyHandler,
 
 		wire.Struct(new(Dependencies), "*"),
 	)
@@ -7,12 +7,18 @@
 package wire
 
 import (
+	"github.com/alphacodinggroup/ponti-backend/pkg/authe/jwt/v5"
+	"github.com/alphacodinggroup/ponti-backend/pkg/databases/cache/redis/v8"
 	"github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/gorm"
 	"github.com/alphacodinggroup/ponti-backend/pkg/databases/sql/postgresql/pgxpool"
+	"github.com/alphacodinggroup/ponti-backend/pkg/doc/swagger"
 	"github.com/alphacodinggroup/ponti-backend/pkg/http/middlewares/gin"
 	"github.com/alphacodinggroup/ponti-backend/pkg/http/servers/gin"
+	"github.com/alphacodinggroup/ponti-backend/pkg/microservices/grpc/server"
 	"github.com/alphacodinggroup/ponti-backend/pkg/notification/smtp"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/cmd/config"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/apikey"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/auth"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/crop"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/customer"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/field"
@@ -20,8 +26,12 @@ import (
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/lot"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/manager"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/notification"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/organization"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/outbox"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/person"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/project"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/rainfall"
+	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/search"
 	"github.com/alphacodinggroup/ponti-backend/projects/ponti-api/internal/user"
 )
 
@@ -36,6 +46,10 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
+	pkggrpcserverServer, err := ProvideGrpcServer()
+	if err != nil {
+		return nil, err
+	}
 	repository, err := ProvideGormRepository()
 	if err != nil {
 		return nil, err
@@ -48,31 +62,53 @@ func Initialize() (*Dependencies, error) {
 	if err != nil {
 		return nil, err
 	}
-	handlerFunc, err := ProvideJwtMiddleware()
+	cache, err := ProvideRedisCache()
 	if err != nil {
 		return nil, err
 	}
-	middlewares, err := ProvideMiddlewares(handlerFunc)
+	pkgswaggerService, err := ProvideSwaggerService()
 	if err != nil {
 		return nil, err
 	}
-	personRepository, err := ProvidePersonRepository(pkgpostgresqlRepository)
+	keySet, err := ProvideJwtKeySet(cache)
+	if err != nil {
+		return nil, err
+	}
+	pkgjwtService, err := ProvideJwtService(keySet)
+	if err != nil {
+		return nil, err
+	}
+	tokenStore := ProvideAuthTokenStore(cache, pkgjwtService)
+	handlerFunc, err := ProvideJwtMiddleware(keySet, tokenStore)
 	if err != nil {
 		return nil, err
 	}
-	useCases := ProvidePersonUseCases(personRepository)
-	handler := ProvidePersonHandler(server, useCases, middlewares)
 	userRepository, err := ProvideUserRepository(repository)
 	if err != nil {
 		return nil, err
 	}
-	userUseCases := ProvideUserUseCases(userRepository)
-	userHandler := ProvideUserHandler(server, userUseCases, middlewares)
 	smtpService, err := ProvideNotificationSmtpService(service)
 	if err != nil {
 		return nil, err
 	}
 	notificationUseCases := ProvideNotificationUseCases(smtpService)
+	userUseCases := ProvideUserUseCases(userRepository, cache, notificationUseCases)
+	apikeyRepository, err := ProvideAPIKeyRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	apikeyUseCases := ProvideAPIKeyUseCases(apikeyRepository, userUseCases)
+	middlewares, err := ProvideMiddlewares(handlerFunc, cache, userUseCases, apikeyUseCases)
+	if err != nil {
+		return nil, err
+	}
+	personRepository, err := ProvidePersonRepository(pkgpostgresqlRepository)
+	if err != nil {
+		return nil, err
+	}
+	useCases := ProvidePersonUseCases(personRepository)
+	handler := ProvidePersonHandler(server, useCases, middlewares)
+	userHandler := ProvideUserHandler(server, userUseCases, middlewares)
 	notificationHandler := ProvideNotificationHandler(server, notificationUseCases, middlewares)
 	cropRepository, err := ProvideCropRepository(repository)
 	if err != nil {
@@ -92,55 +128,116 @@ func Initialize() (*Dependencies, error) {
 	}
 	managerUseCases := ProvideManagerUseCases(managerRepository)
 	managerHandler := ProvideManagerHandler(server, managerUseCases, middlewares)
-	fieldRepository, err := ProvideFieldRepository(repository)
+	outboxRepository, err := ProvideOutboxRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	fieldRepository, err := ProvideFieldRepository(repository, outboxRepository)
 	if err != nil {
 		return nil, err
 	}
-	lotRepository, err := ProvideLotRepository(repository)
+	lotRepository, err := ProvideLotRepository(repository, outboxRepository)
 	if err != nil {
 		return nil, err
 	}
 	lotUseCases := ProvideLotUseCases(lotRepository, cropUseCases)
 	fieldUseCases := ProvideFieldUseCases(fieldRepository, lotUseCases)
 	fieldHandler := ProvideFieldHandler(server, fieldUseCases, middlewares)
-	investorRepository, err := ProvideInvestorRepository(repository)
+	investorRepository, err := ProvideInvestorRepository(repository, outboxRepository)
 	if err != nil {
 		return nil, err
 	}
 	investorUseCases := ProvideInvestorUseCases(investorRepository)
 	investorHandler := ProvideInvestorHandler(server, investorUseCases, middlewares)
 	lotHandler := ProvideLotHandler(server, lotUseCases, middlewares)
-	projectRepository, err := ProvideProjectRepository(repository)
+	projectRepository, err := ProvideProjectRepository(repository, outboxRepository)
 	if err != nil {
 		return nil, err
 	}
-	projectUseCases := ProvideProjectUseCases(projectRepository, customerUseCases, managerUseCases, investorUseCases, fieldUseCases, lotUseCases)
+	projectUseCases := ProvideProjectUseCases(projectRepository, customerUseCases, managerUseCases, investorUseCases, fieldUseCases, lotUseCases, userUseCases, notificationUseCases)
 	projectHandler := ProvideProjectHandler(server, projectUseCases, middlewares)
+	rainfallRepository, err := ProvideRainfallRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	rainfallProvider := ProvideRainfallProvider()
+	rainfallUseCases := ProvideRainfallUseCases(rainfallRepository, fieldUseCases, rainfallProvider)
+	rainfallHandler := ProvideRainfallHandler(server, rainfallUseCases, middlewares)
+	searchRepository, err := ProvideSearchRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	searchUseCases := ProvideSearchUseCases(searchRepository)
+	searchHandler := ProvideSearchHandler(server, searchUseCases, middlewares)
+	organizationRepository, err := ProvideOrganizationRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	organizationUseCases := ProvideOrganizationUseCases(organizationRepository)
+	organizationHandler := ProvideOrganizationHandler(server, organizationUseCases, middlewares)
+	authUseCases, err := ProvideAuthUseCases(userUseCases, pkgjwtService, tokenStore, notificationUseCases)
+	if err != nil {
+		return nil, err
+	}
+	authHandler := ProvideAuthHandler(server, authUseCases, middlewares, keySet)
+	apikeyHandler := ProvideAPIKeyHandler(server, apikeyUseCases, middlewares)
+	userGrpcServer := ProvideUserGrpcServer(userUseCases)
+	cropGrpcServer := ProvideCropGrpcServer(cropUseCases)
+	lotGrpcServer := ProvideLotGrpcServer(lotUseCases)
+	fieldGrpcServer := ProvideFieldGrpcServer(fieldUseCases)
+	projectGrpcServer := ProvideProjectGrpcServer(projectUseCases)
+	publisher, err := ProvideOutboxPublisher()
+	if err != nil {
+		return nil, err
+	}
+	relay := ProvideOutboxRelay(outboxRepository, publisher)
 	dependencies := &Dependencies{
-		ConfigLoader:        loader,
-		GinServer:           server,
-		GormRepository:      repository,
-		PostgresRepository:  pkgpostgresqlRepository,
-		SmtpService:         service,
-		Middlewares:         middlewares,
-		PersonHandler:       handler,
-		UserHandler:         userHandler,
-		NotificationHandler: notificationHandler,
-		CropHandler:         cropHandler,
-		CustomerHandler:     customerHandler,
-		ManagerHandler:      managerHandler,
-		FieldHandler:        fieldHandler,
-		InvestorHandler:     investorHandler,
-		LotHandler:          lotHandler,
-		ProjectHandler:      projectHandler,
-		PersonUseCases:      useCases,
-		UserUseCases:        userUseCases,
-		CropUseCases:        cropUseCases,
-		CustomerUseCases:    customerUseCases,
-		FieldUseCases:       fieldUseCases,
-		InvestorUseCases:    investorUseCases,
-		LotUseCases:         lotUseCases,
-		ProjectUseCases:     projectUseCases,
+		ConfigLoader:           loader,
+		GinServer:              server,
+		GrpcServer:             pkggrpcserverServer,
+		GormRepository:         repository,
+		PostgresRepository:     pkgpostgresqlRepository,
+		SmtpService:            service,
+		RedisCache:             cache,
+		SwaggerService:         pkgswaggerService,
+		JwtKeySet:              keySet,
+		Middlewares:            middlewares,
+		OutboxRelay:            relay,
+		OrganizationRepository: organizationRepository,
+		SearchRepository:       searchRepository,
+		PersonHandler:          handler,
+		UserHandler:            userHandler,
+		NotificationHandler:    notificationHandler,
+		CropHandler:            cropHandler,
+		CustomerHandler:        customerHandler,
+		ManagerHandler:         managerHandler,
+		FieldHandler:           fieldHandler,
+		InvestorHandler:        investorHandler,
+		LotHandler:             lotHandler,
+		ProjectHandler:         projectHandler,
+		RainfallHandler:        rainfallHandler,
+		SearchHandler:          searchHandler,
+		OrganizationHandler:    organizationHandler,
+		AuthHandler:            authHandler,
+		APIKeyHandler:          apikeyHandler,
+		UserGrpcServer:         userGrpcServer,
+		CropGrpcServer:         cropGrpcServer,
+		LotGrpcServer:          lotGrpcServer,
+		FieldGrpcServer:        fieldGrpcServer,
+		ProjectGrpcServer:      projectGrpcServer,
+		PersonUseCases:         useCases,
+		UserUseCases:           userUseCases,
+		CropUseCases:           cropUseCases,
+		CustomerUseCases:       customerUseCases,
+		FieldUseCases:          fieldUseCases,
+		InvestorUseCases:       investorUseCases,
+		LotUseCases:            lotUseCases,
+		ProjectUseCases:        projectUseCases,
+		RainfallUseCases:       rainfallUseCases,
+		SearchUseCases:         searchUseCases,
+		OrganizationUseCases:   organizationUseCases,
+		AuthUseCases:           authUseCases,
+		APIKeyUseCases:         apikeyUseCases,
 	}
 	return dependencies, nil
 }
@@ -150,11 +247,19 @@ func Initialize() (*Dependencies, error) {
 type Dependencies struct {
 	ConfigLoader       config.Loader
 	GinServer          pkggin.Server
+	GrpcServer         pkggrpcserver.Server
 	GormRepository     pkggorm.Repository
 	PostgresRepository pkgpostgresql.Repository
 	SmtpService        pkgsmtp.Service
+	RedisCache         pkgredis.Cache
+	SwaggerService     pkgswagger.Service
+	JwtKeySet          *pkgjwt.KeySet
 
 	Middlewares *pkgmwr.Middlewares
+	OutboxRelay outbox.Relay
+
+	OrganizationRepository organization.Repository
+	SearchRepository       search.Repository
 
 	PersonHandler       *person.Handler
 	UserHandler         *user.Handler
@@ -166,6 +271,17 @@ type Dependencies struct {
 	InvestorHandler     *investor.Handler
 	LotHandler          *lot.Handler
 	ProjectHandler      *project.Handler
+	RainfallHandler     *rainfall.Handler
+	SearchHandler       *search.Handler
+	OrganizationHandler *organization.Handler
+	AuthHandler         *auth.Handler
+	APIKeyHandler       *apikey.Handler
+
+	UserGrpcServer    *user.GrpcServer
+	CropGrpcServer    *crop.GrpcServer
+	LotGrpcServer     *lot.GrpcServer
+	FieldGrpcServer   *field.GrpcServer
+	ProjectGrpcServer *project.GrpcServer
 
 	PersonUseCases   person.UseCases
 	UserUseCases     user.UseCases
@@ -175,4 +291,10 @@ type Dependencies struct {
 	InvestorUseCases investor.UseCases
 	LotUseCases      lot.UseCases
 	ProjectUseCases  project.UseCases
+	RainfallUseCases rainfall.UseCases
+	SearchUseCases   search.UseCases
+
+	OrganizationUseCases organization.UseCases
+	AuthUseCases         auth.UseCases
+	APIKeyUseCases       apikey.UseCases
 }